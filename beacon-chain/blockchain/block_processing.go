@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -56,6 +58,8 @@ func (b *BlockFailedProcessingErr) Error() string {
 // 4. Process and cleanup any block operations, such as attestations and deposits, which would need to be
 //    either included or flushed from the beacon node's runtime.
 func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock) (*pb.BeaconState, error) {
+	// The events are sent once the lock is released, as receiving them may block.
+	defer c.sendChainEvents()
 	c.receiveBlockLock.Lock()
 	defer c.receiveBlockLock.Unlock()
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ReceiveBlock")
@@ -68,7 +72,7 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock)
 	if parent == nil {
 		return nil, errors.New("parent does not exist in DB")
 	}
-	beaconState, err := c.beaconDB.HistoricalStateFromSlot(ctx, parent.Slot)
+	beaconState, err := c.postState(ctx, parentRoot, parent.Slot)
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
 	}
@@ -125,10 +129,16 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock)
 		}
 		beaconState.LatestBlock = block
 		if !bytes.Equal(block.StateRootHash32, stateRoot[:]) {
-			return nil, fmt.Errorf("beacon state root is not equal to block state root: %#x != %#x", stateRoot, block.StateRootHash32)
+			return nil, &BlockFailedProcessingErr{
+				fmt.Errorf("beacon state root is not equal to block state root: %#x != %#x", stateRoot, block.StateRootHash32),
+			}
 		}
 	}
 
+	// Keep the post-state in memory so that processing the block's children, or
+	// switching the head to it, does not require regenerating it.
+	c.stateCache.AddState(blockRoot, beaconState)
+
 	// We process the block's contained deposits, attestations, and other operations
 	// and that may need to be stored or deleted from the beacon node's persistent storage.
 	if err := c.CleanupBlockOperations(ctx, block); err != nil {
@@ -139,6 +149,16 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock)
 	return beaconState, nil
 }
 
+// postState returns the state resulting from processing the block with the given
+// root, from the state cache if available and from the historical states in the DB
+// otherwise.
+func (c *ChainService) postState(ctx context.Context, blockRoot [32]byte, slot uint64) (*pb.BeaconState, error) {
+	if beaconState := c.stateCache.StateByRoot(blockRoot); beaconState != nil {
+		return beaconState, nil
+	}
+	return c.beaconDB.HistoricalStateFromSlot(ctx, slot)
+}
+
 // ApplyBlockStateTransition runs the Ethereum 2.0 state transition function
 // to produce a new beacon state and also accounts for skip slots occurring.
 //
@@ -200,7 +220,10 @@ func (c *ChainService) VerifyBlockValidity(
 		return fmt.Errorf("cannot process a genesis block: received block with slot %d",
 			block.Slot-params.BeaconConfig().GenesisSlot)
 	}
-	powBlockFetcher := c.web3Service.Client().BlockByHash
+	var powBlockFetcher func(ctx context.Context, hash common.Hash) (*gethTypes.Block, error)
+	if !c.noPOWChain {
+		powBlockFetcher = c.web3Service.Client().BlockByHash
+	}
 	if err := b.IsValidBlock(ctx, beaconState, block,
 		c.beaconDB.HasBlock, powBlockFetcher, c.genesisTime); err != nil {
 		return fmt.Errorf("block does not fulfill pre-processing conditions %v", err)
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -760,6 +761,37 @@ func TestIsBlockReadyForProcessing_ValidBlock(t *testing.T) {
 	}
 }
 
+func TestPostState_UsesStateCache(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	chainService := setupBeaconChain(t, db, nil)
+
+	historicalState := &pb.BeaconState{Slot: params.BeaconConfig().GenesisSlot + 5}
+	if err := db.SaveHistoricalState(ctx, historicalState); err != nil {
+		t.Fatal(err)
+	}
+	forkRoot := [32]byte{'A'}
+	forkState := &pb.BeaconState{Slot: params.BeaconConfig().GenesisSlot + 5, ValidatorBalances: []uint64{1}}
+	chainService.stateCache.AddState(forkRoot, forkState)
+
+	beaconState, err := chainService.postState(ctx, forkRoot, forkState.Slot)
+	if err != nil {
+		t.Fatalf("Could not get post state: %v", err)
+	}
+	if !proto.Equal(beaconState, forkState) {
+		t.Errorf("Expected cached state %v, received %v", forkState, beaconState)
+	}
+
+	beaconState, err = chainService.postState(ctx, [32]byte{'B'}, historicalState.Slot)
+	if err != nil {
+		t.Fatalf("Could not get post state: %v", err)
+	}
+	if !proto.Equal(beaconState, historicalState) {
+		t.Errorf("Expected historical state %v, received %v", historicalState, beaconState)
+	}
+}
+
 func TestDeleteValidatorIdx_DeleteWorks(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
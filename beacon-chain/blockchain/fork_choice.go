@@ -1,16 +1,15 @@
 package blockchain
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -24,7 +23,6 @@ var (
 		Help: "The number of chain reorganization events that have happened in the fork choice rule",
 	})
 )
-var blkAncestorCache = cache.NewBlockAncestorCache()
 
 // ForkChoice interface defines the methods for applying fork choice rule
 // operations to the blockchain.
@@ -61,8 +59,12 @@ func (c *ChainService) updateFFGCheckPts(ctx context.Context, state *pb.BeaconSt
 			}
 		}
 
-		// Fetch justified state from historical states db.
-		newJustifiedState, err := c.beaconDB.HistoricalStateFromSlot(ctx, newJustifiedBlock.Slot)
+		newJustifiedRoot, err := hashutil.HashBeaconBlock(newJustifiedBlock)
+		if err != nil {
+			return err
+		}
+		// Fetch justified state from the state cache or historical states db.
+		newJustifiedState, err := c.postState(ctx, newJustifiedRoot, newJustifiedBlock.Slot)
 		if err != nil {
 			return err
 		}
@@ -72,6 +74,11 @@ func (c *ChainService) updateFFGCheckPts(ctx context.Context, state *pb.BeaconSt
 		if err := c.beaconDB.SaveJustifiedState(newJustifiedState); err != nil {
 			return err
 		}
+		c.queueChainEvent(&pbrpc.ChainEvent{
+			Type:            pbrpc.ChainEventType_JUSTIFIED,
+			Checkpoint:      &pbrpc.BlockRoot{Slot: newJustifiedBlock.Slot, Root: newJustifiedRoot[:]},
+			CheckpointEpoch: state.JustifiedEpoch,
+		})
 	}
 
 	lastFinalizedSlot := helpers.StartSlot(state.FinalizedEpoch)
@@ -99,9 +106,13 @@ func (c *ChainService) updateFFGCheckPts(ctx context.Context, state *pb.BeaconSt
 			}
 		}
 
+		newFinalizedRoot, err := hashutil.HashBeaconBlock(newFinalizedBlock)
+		if err != nil {
+			return err
+		}
 		// Generate the new finalized state with using new finalized block and
 		// save it.
-		newFinalizedState, err := c.beaconDB.HistoricalStateFromSlot(ctx, lastFinalizedSlot)
+		newFinalizedState, err := c.postState(ctx, newFinalizedRoot, lastFinalizedSlot)
 		if err != nil {
 			return err
 		}
@@ -111,6 +122,18 @@ func (c *ChainService) updateFFGCheckPts(ctx context.Context, state *pb.BeaconSt
 		if err := c.beaconDB.SaveFinalizedState(newFinalizedState); err != nil {
 			return err
 		}
+		// Blocks which do not descend from the new finalized block can be dropped
+		// from the fork choice store.
+		if c.forkChoiceStore.HasNode(newFinalizedRoot) {
+			if err := c.forkChoiceStore.Prune(newFinalizedRoot); err != nil {
+				return err
+			}
+		}
+		c.queueChainEvent(&pbrpc.ChainEvent{
+			Type:            pbrpc.ChainEventType_FINALIZED,
+			Checkpoint:      &pbrpc.BlockRoot{Slot: newFinalizedBlock.Slot, Root: newFinalizedRoot[:]},
+			CheckpointEpoch: state.FinalizedEpoch,
+		})
 	}
 	return nil
 }
@@ -124,6 +147,8 @@ func (c *ChainService) ApplyForkChoiceRule(
 	block *pb.BeaconBlock,
 	postState *pb.BeaconState,
 ) error {
+	// The events are sent once the locks are released, as receiving them may block.
+	defer c.sendChainEvents()
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ApplyForkChoiceRule")
 	defer span.End()
 	log.Info("Applying LMD-GHOST Fork Choice Rule")
@@ -141,6 +166,13 @@ func (c *ChainService) ApplyForkChoiceRule(
 		return fmt.Errorf("could not retrieve justified head: %v", err)
 	}
 
+	if _, err := c.setForkChoiceRoot(justifiedHead); err != nil {
+		return err
+	}
+	if err := c.insertForkChoiceBlock(block); err != nil {
+		return err
+	}
+
 	newHead, err := c.lmdGhost(ctx, justifiedHead, justifiedState, attestationTargets)
 	if err != nil {
 		return fmt.Errorf("could not run fork choice: %v", err)
@@ -169,7 +201,7 @@ func (c *ChainService) ApplyForkChoiceRule(
 			currentHead.Slot-params.BeaconConfig().GenesisSlot, newHead.Slot-params.BeaconConfig().GenesisSlot)
 
 		// Only regenerate head state if there was a reorg.
-		newState, err = c.beaconDB.HistoricalStateFromSlot(ctx, newHead.Slot)
+		newState, err = c.postState(ctx, newHeadRoot, newHead.Slot)
 		if err != nil {
 			return fmt.Errorf("could not gen state: %v", err)
 		}
@@ -182,7 +214,7 @@ func (c *ChainService) ApplyForkChoiceRule(
 
 	// If we receive forked blocks.
 	if newHead.Slot != newState.Slot {
-		newState, err = c.beaconDB.HistoricalStateFromSlot(ctx, newHead.Slot)
+		newState, err = c.postState(ctx, newHeadRoot, newHead.Slot)
 		if err != nil {
 			return fmt.Errorf("could not gen state: %v", err)
 		}
@@ -191,12 +223,23 @@ func (c *ChainService) ApplyForkChoiceRule(
 	if err := c.beaconDB.UpdateChainHead(ctx, newHead, newState); err != nil {
 		return fmt.Errorf("failed to update chain: %v", err)
 	}
-	h, err := hashutil.HashBeaconBlock(newHead)
+	currentHeadRoot, err := hashutil.HashBeaconBlock(currentHead)
 	if err != nil {
-		return fmt.Errorf("could not hash head: %v", err)
+		return fmt.Errorf("could not hash current head: %v", err)
+	}
+	if newHeadRoot != currentHeadRoot {
+		if !isDescendant {
+			if err := c.queueReorgEvent(currentHead, newHead); err != nil {
+				log.WithError(err).Warn("Could not send reorg event")
+			}
+		}
+		c.queueChainEvent(&pbrpc.ChainEvent{
+			Type: pbrpc.ChainEventType_HEAD,
+			Head: &pbrpc.BlockRoot{Slot: newHead.Slot, Root: newHeadRoot[:]},
+		})
 	}
 	log.WithFields(logrus.Fields{
-		"headRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(h[:])),
+		"headRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(newHeadRoot[:])),
 		"headSlot":  newHead.Slot - params.BeaconConfig().GenesisSlot,
 		"stateSlot": newState.Slot - params.BeaconConfig().GenesisSlot,
 	}).Info("Chain head block and state updated")
@@ -206,6 +249,9 @@ func (c *ChainService) ApplyForkChoiceRule(
 
 // lmdGhost applies the Latest Message Driven, Greediest Heaviest Observed Sub-Tree
 // fork-choice rule defined in the Ethereum Serenity specification for the beacon chain.
+// The block tree and its vote counts are kept in the fork choice store, which only
+// applies the changes of the vote targets since the last run instead of counting
+// the votes of every child block from the db.
 //
 // Spec pseudocode definition:
 //	def lmd_ghost(store: Store, start_state: BeaconState, start_block: BeaconBlock) -> BeaconBlock:
@@ -238,80 +284,129 @@ func (c *ChainService) lmdGhost(
 	startState *pb.BeaconState,
 	voteTargets map[uint64]*pb.AttestationTarget,
 ) (*pb.BeaconBlock, error) {
-	highestSlot := c.beaconDB.HighestBlockSlot()
-	head := startBlock
-	for {
-		children, err := c.blockChildren(ctx, head, highestSlot)
-		if err != nil {
-			return nil, fmt.Errorf("could not fetch block children: %v", err)
-		}
-		if len(children) == 0 {
-			return head, nil
-		}
-		maxChild := children[0]
-
-		maxChildVotes, err := VoteCount(maxChild, startState, voteTargets, c.beaconDB)
-		if err != nil {
-			return nil, fmt.Errorf("unable to determine vote count for block: %v", err)
-		}
-		for i := 1; i < len(children); i++ {
-			candidateChildVotes, err := VoteCount(children[i], startState, voteTargets, c.beaconDB)
+	startRoot, err := c.setForkChoiceRoot(startBlock)
+	if err != nil {
+		return nil, err
+	}
+	balances := make(map[uint64]uint64, len(voteTargets))
+	for validatorIndex, target := range voteTargets {
+		targetRoot := bytesutil.ToBytes32(target.BlockRoot)
+		if !c.forkChoiceStore.HasNode(targetRoot) {
+			targetBlock, err := c.beaconDB.Block(targetRoot)
 			if err != nil {
-				return nil, fmt.Errorf("unable to determine vote count for block: %v", err)
+				return nil, fmt.Errorf("could not get target block: %v", err)
 			}
-			maxChildRoot, err := hashutil.HashBeaconBlock(maxChild)
-			if err != nil {
-				return nil, err
+			if targetBlock == nil {
+				continue
 			}
-			candidateChildRoot, err := hashutil.HashBeaconBlock(children[i])
-			if err != nil {
+			if err := c.insertForkChoiceBlock(targetBlock); err != nil {
 				return nil, err
 			}
-			if candidateChildVotes > maxChildVotes ||
-				(candidateChildVotes == maxChildVotes && bytesutil.LowerThan(maxChildRoot[:], candidateChildRoot[:])) {
-				maxChild = children[i]
-			}
 		}
-		head = maxChild
+		c.forkChoiceStore.ProcessAttestation(validatorIndex, targetRoot)
+		balances[validatorIndex] = helpers.EffectiveBalance(startState, validatorIndex)
+	}
+
+	headRoot, err := c.forkChoiceStore.Head(startRoot, balances)
+	if err != nil {
+		return nil, fmt.Errorf("could not get head from fork choice store: %v", err)
+	}
+	head, err := c.beaconDB.Block(headRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get head block: %v", err)
 	}
+	if head == nil {
+		return nil, fmt.Errorf("head block %#x does not exist in db", headRoot)
+	}
+	return head, nil
 }
 
-// blockChildren returns the child blocks of the given block up to a given
-// highest slot.
-//
-// ex:
-//       /- C - E
-// A - B - D - F
-//       \- G
-// Input: B. Output: [C, D, G]
-//
-// Spec pseudocode definition:
-//	get_children(store: Store, block: BeaconBlock) -> List[BeaconBlock]
-//		returns the child blocks of the given block.
-func (c *ChainService) blockChildren(ctx context.Context, block *pb.BeaconBlock, highestSlot uint64) ([]*pb.BeaconBlock, error) {
-	var children []*pb.BeaconBlock
+// setForkChoiceRoot makes sure the fork choice store contains the given block and
+// returns its root. The block is inserted along with its missing ancestors if it
+// descends from the root of the store, otherwise the block tree is rebuilt from the
+// finalized block, keeping the latest messages of the validators.
+func (c *ChainService) setForkChoiceRoot(block *pb.BeaconBlock) ([32]byte, error) {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not hash block: %v", err)
+	}
+	if err := c.insertForkChoiceBlock(block); err != nil {
+		return [32]byte{}, err
+	}
+	if c.forkChoiceStore.HasNode(root) {
+		return root, nil
+	}
 
-	currentRoot, err := hashutil.HashBeaconBlock(block)
+	log.WithField("slot", block.Slot-params.BeaconConfig().GenesisSlot).Debug(
+		"Block does not descend from the fork choice store root, rebuilding the block tree from the finalized block")
+	finalizedBlock, err := c.beaconDB.FinalizedBlock()
 	if err != nil {
-		return nil, fmt.Errorf("could not tree hash incoming block: %v", err)
+		return [32]byte{}, fmt.Errorf("could not retrieve finalized block: %v", err)
+	}
+	c.forkChoiceStore.Reset()
+	if err := c.insertForkChoiceBlock(finalizedBlock); err != nil {
+		return [32]byte{}, err
+	}
+	if err := c.insertForkChoiceBlock(block); err != nil {
+		return [32]byte{}, err
+	}
+	if c.forkChoiceStore.HasNode(root) {
+		return root, nil
 	}
-	startSlot := block.Slot + 1
-	for i := startSlot; i <= highestSlot; i++ {
-		block, err := c.beaconDB.BlockBySlot(ctx, i)
+
+	// The block does not descend from the finalized block either, start a new block tree from it.
+	c.forkChoiceStore.Reset()
+	if err := c.forkChoiceStore.ProcessBlock(block.Slot, root, bytesutil.ToBytes32(block.ParentRootHash32)); err != nil {
+		return [32]byte{}, fmt.Errorf("could not insert block in fork choice store: %v", err)
+	}
+	return root, nil
+}
+
+// insertForkChoiceBlock inserts the block into the fork choice store along with
+// its ancestors from the db which are missing from it. Blocks which do not
+// descend from the root of the store can never become the head and are ignored.
+//
+// ex: with A as the root of the store holding A - B
+//   A - B - C - D
+// Input: D. Inserted: [C, D]
+func (c *ChainService) insertForkChoiceBlock(block *pb.BeaconBlock) error {
+	_, storeRootSlot, ok := c.forkChoiceStore.Root()
+	var missing []*pb.BeaconBlock
+	var missingRoots [][32]byte
+	for {
+		root, err := hashutil.HashBeaconBlock(block)
 		if err != nil {
-			return nil, fmt.Errorf("could not get block by slot: %v", err)
+			return fmt.Errorf("could not hash block: %v", err)
+		}
+		if c.forkChoiceStore.HasNode(root) {
+			break
+		}
+		// The first block inserted in an empty store becomes its root.
+		if !ok {
+			missing = append(missing, block)
+			missingRoots = append(missingRoots, root)
+			break
+		}
+		if block.Slot <= storeRootSlot {
+			return nil
+		}
+		missing = append(missing, block)
+		missingRoots = append(missingRoots, root)
+		block, err = c.beaconDB.Block(bytesutil.ToBytes32(block.ParentRootHash32))
+		if err != nil {
+			return fmt.Errorf("could not get parent block: %v", err)
 		}
-		// Continue if there's a skip block.
 		if block == nil {
-			continue
+			return nil
 		}
-
-		parentRoot := bytesutil.ToBytes32(block.ParentRootHash32)
-		if currentRoot == parentRoot {
-			children = append(children, block)
+	}
+	for i := len(missing) - 1; i >= 0; i-- {
+		parentRoot := bytesutil.ToBytes32(missing[i].ParentRootHash32)
+		if err := c.forkChoiceStore.ProcessBlock(missing[i].Slot, missingRoots[i], parentRoot); err != nil {
+			return fmt.Errorf("could not insert block in fork choice store: %v", err)
 		}
 	}
-	return children, nil
+	return nil
 }
 
 // isDescendant checks if the new head block is a descendant block of the current head.
@@ -335,6 +430,67 @@ func (c *ChainService) isDescendant(currentHead *pb.BeaconBlock, newHead *pb.Bea
 	return false, nil
 }
 
+// queueReorgEvent queues the notification to the chain event feed subscribers
+// that the chain head switched from the old head to the new head, which is not
+// one of its descendants.
+func (c *ChainService) queueReorgEvent(oldHead *pb.BeaconBlock, newHead *pb.BeaconBlock) error {
+	oldHeadRoot, err := hashutil.HashBeaconBlock(oldHead)
+	if err != nil {
+		return fmt.Errorf("could not hash old head: %v", err)
+	}
+	newHeadRoot, err := hashutil.HashBeaconBlock(newHead)
+	if err != nil {
+		return fmt.Errorf("could not hash new head: %v", err)
+	}
+	ancestor, ancestorRoot, err := c.commonAncestor(oldHead, newHead)
+	if err != nil {
+		return fmt.Errorf("could not find common ancestor: %v", err)
+	}
+	c.queueChainEvent(&pbrpc.ChainEvent{
+		Type:           pbrpc.ChainEventType_REORG,
+		Head:           &pbrpc.BlockRoot{Slot: newHead.Slot, Root: newHeadRoot[:]},
+		OldHead:        &pbrpc.BlockRoot{Slot: oldHead.Slot, Root: oldHeadRoot[:]},
+		CommonAncestor: &pbrpc.BlockRoot{Slot: ancestor.Slot, Root: ancestorRoot[:]},
+		ReorgDepth:     oldHead.Slot - ancestor.Slot,
+	})
+	return nil
+}
+
+// commonAncestor returns the most recent block which both given blocks descend
+// from or are equal to, along with its root.
+//
+// ex:
+//       /- C - E
+// A - B - D - F
+// Input: E, F. Output: B
+func (c *ChainService) commonAncestor(block1 *pb.BeaconBlock, block2 *pb.BeaconBlock) (*pb.BeaconBlock, [32]byte, error) {
+	root1, err := hashutil.HashBeaconBlock(block1)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	root2, err := hashutil.HashBeaconBlock(block2)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	for root1 != root2 {
+		// Step back from the most recent of the two blocks.
+		if block1.Slot >= block2.Slot {
+			root1 = bytesutil.ToBytes32(block1.ParentRootHash32)
+			block1, err = c.beaconDB.Block(root1)
+		} else {
+			root2 = bytesutil.ToBytes32(block2.ParentRootHash32)
+			block2, err = c.beaconDB.Block(root2)
+		}
+		if err != nil {
+			return nil, [32]byte{}, fmt.Errorf("could not get parent block: %v", err)
+		}
+		if block1 == nil || block2 == nil {
+			return nil, [32]byte{}, errors.New("parent block does not exist in db")
+		}
+	}
+	return block1, root1, nil
+}
+
 // attestationTargets retrieves the list of attestation targets since last finalized epoch,
 // each attestation target consists of validator index and its attestation target (i.e. the block
 // which the validator attested to)
@@ -353,118 +509,3 @@ func (c *ChainService) attestationTargets(state *pb.BeaconState) (map[uint64]*pb
 	}
 	return attestationTargets, nil
 }
-
-// VoteCount determines the number of votes on a beacon block by counting the number
-// of target blocks that have such beacon block as a common ancestor.
-//
-// Spec pseudocode definition:
-//  def get_vote_count(block: BeaconBlock) -> int:
-//        return sum(
-//            get_effective_balance(start_state.validator_balances[validator_index]) // FORK_CHOICE_BALANCE_INCREMENT
-//            for validator_index, target in attestation_targets
-//            if get_ancestor(store, target, block.slot) == block
-//        )
-func VoteCount(block *pb.BeaconBlock, state *pb.BeaconState, targets map[uint64]*pb.AttestationTarget, beaconDB *db.BeaconDB) (int, error) {
-	balances := 0
-	var ancestorRoot []byte
-	var err error
-
-	blockRoot, err := hashutil.HashBeaconBlock(block)
-	if err != nil {
-		return 0, err
-	}
-
-	for validatorIndex, target := range targets {
-		ancestorRoot, err = cachedAncestor(target, block.Slot, beaconDB)
-		if err != nil {
-			return 0, err
-		}
-		// This covers the following case, we start at B5, and want to process B6 and B7
-		// B6 can be processed, B7 can not be processed because it's pointed to the
-		// block older than current block 5.
-		// B4 - B5 - B6
-		//   \ - - - - - B7
-		if ancestorRoot == nil {
-			continue
-		}
-
-		if bytes.Equal(blockRoot[:], ancestorRoot) {
-			balances += int(helpers.EffectiveBalance(state, validatorIndex))
-		}
-	}
-	return balances, nil
-}
-
-// BlockAncestor obtains the ancestor at of a block at a certain slot.
-//
-// Spec pseudocode definition:
-//  def get_ancestor(store: Store, block: BeaconBlock, slot: Slot) -> BeaconBlock:
-//    """
-//    Get the ancestor of ``block`` with slot number ``slot``; return ``None`` if not found.
-//    """
-//    if block.slot == slot:
-//        return block
-//    elif block.slot < slot:
-//        return None
-//    else:
-//        return get_ancestor(store, store.get_parent(block), slot)
-func BlockAncestor(targetBlock *pb.AttestationTarget, slot uint64, beaconDB *db.BeaconDB) ([]byte, error) {
-	if targetBlock.Slot == slot {
-		return targetBlock.BlockRoot[:], nil
-	}
-	if targetBlock.Slot < slot {
-		return nil, nil
-	}
-	parentRoot := bytesutil.ToBytes32(targetBlock.ParentRoot)
-	parent, err := beaconDB.Block(parentRoot)
-	if err != nil {
-		return nil, fmt.Errorf("could not get parent block: %v", err)
-	}
-	if parent == nil {
-		return nil, fmt.Errorf("parent block does not exist: %v", err)
-	}
-	newTarget := &pb.AttestationTarget{
-		Slot:       parent.Slot,
-		BlockRoot:  parentRoot[:],
-		ParentRoot: parent.ParentRootHash32,
-	}
-	return BlockAncestor(newTarget, slot, beaconDB)
-}
-
-// cachedAncestor retrieves the cached ancestor target from block ancestor cache,
-// if it's not there it looks up the block tree get it and cache it.
-func cachedAncestor(target *pb.AttestationTarget, height uint64, beaconDB *db.BeaconDB) ([]byte, error) {
-	// check if the ancestor block of from a given block height was cached.
-	cachedAncestorInfo, err := blkAncestorCache.AncestorBySlot(target.BlockRoot, height)
-	if err != nil {
-		return nil, nil
-	}
-	if cachedAncestorInfo != nil {
-		return cachedAncestorInfo.Target.BlockRoot, nil
-	}
-
-	ancestorRoot, err := BlockAncestor(target, height, beaconDB)
-	if err != nil {
-		return nil, err
-	}
-	ancestor, err := beaconDB.Block(bytesutil.ToBytes32(ancestorRoot))
-	if err != nil {
-		return nil, err
-	}
-	if ancestor == nil {
-		return nil, nil
-	}
-	ancestorTarget := &pb.AttestationTarget{
-		Slot:       ancestor.Slot,
-		BlockRoot:  ancestorRoot,
-		ParentRoot: ancestor.ParentRootHash32,
-	}
-	if err := blkAncestorCache.AddBlockAncestor(&cache.AncestorInfo{
-		Height: height,
-		Hash:   target.BlockRoot,
-		Target: ancestorTarget,
-	}); err != nil {
-		return nil, err
-	}
-	return ancestorRoot, nil
-}
@@ -10,8 +10,8 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
-	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
@@ -128,93 +129,128 @@ func TestApplyForkChoice_SetsCanonicalHead(t *testing.T) {
 	}
 }
 
-func TestVoteCount_ParentDoesNotExistNoVoteCount(t *testing.T) {
-	beaconDB := internal.SetupDB(t)
-	defer internal.TeardownDB(t, beaconDB)
-	genesisBlock := b.NewGenesisBlock([]byte("stateroot"))
-	if err := beaconDB.SaveBlock(genesisBlock); err != nil {
-		t.Fatal(err)
+func TestApplyForkChoice_SendsHeadEvent(t *testing.T) {
+	deposits, _ := setupInitialDeposits(t, 5)
+	beaconState, err := state.GenesisBeaconState(deposits, 0, nil)
+	if err != nil {
+		t.Fatalf("Cannot create genesis beacon state: %v", err)
 	}
-	potentialHead := &pb.BeaconBlock{
-		ParentRootHash32: []byte{'A'}, // We give a bogus parent root hash.
+	beaconDb := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDb)
+	attsService := attestation.NewAttestationService(
+		context.Background(),
+		&attestation.Config{BeaconDB: beaconDb})
+	chainService := setupBeaconChain(t, beaconDb, attsService)
+	if err := beaconDb.InitializeState(context.Background(), uint64(time.Now().Unix()), deposits, &pb.Eth1Data{}); err != nil {
+		t.Fatalf("Could not initialize beacon state to disk: %v", err)
 	}
-	if err := beaconDB.SaveBlock(potentialHead); err != nil {
+	genesis, err := beaconDb.ChainHead()
+	if err != nil {
 		t.Fatal(err)
 	}
-	headRoot, err := hashutil.HashBeaconBlock(potentialHead)
+	genesisRoot, err := hashutil.HashBeaconBlock(genesis)
 	if err != nil {
 		t.Fatal(err)
 	}
-
-	voteTargets := make(map[uint64]*pb.AttestationTarget)
-	voteTargets[0] = &pb.AttestationTarget{
-		Slot:       potentialHead.Slot,
-		BlockRoot:  headRoot[:],
-		ParentRoot: potentialHead.ParentRootHash32,
-	}
-	count, err := VoteCount(genesisBlock, &pb.BeaconState{}, voteTargets, beaconDB)
-	if err != nil {
-		t.Fatalf("Could not get vote count: %v", err)
+	if err := beaconDb.SaveJustifiedBlock(genesis); err != nil {
+		t.Fatal(err)
 	}
-	if count != 0 {
-		t.Errorf("Wanted vote count 0, got: %d", count)
+	if err := beaconDb.SaveJustifiedState(beaconState); err != nil {
+		t.Fatal(err)
 	}
-}
 
-func TestVoteCount_IncreaseCountCorrectly(t *testing.T) {
-	beaconDB := internal.SetupDB(t)
-	defer internal.TeardownDB(t, beaconDB)
-	genesisBlock := b.NewGenesisBlock([]byte("stateroot"))
-	genesisRoot, err := hashutil.HashBeaconBlock(genesisBlock)
+	block := &pb.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 1,
+		ParentRootHash32: genesisRoot[:],
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(block)
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err := beaconDB.SaveBlock(genesisBlock); err != nil {
+	if err := beaconDb.SaveBlock(block); err != nil {
 		t.Fatal(err)
 	}
 
-	potentialHead := &pb.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 5,
-		ParentRootHash32: genesisRoot[:],
+	events := make(chan *pbrpc.ChainEvent, 1)
+	sub := chainService.ChainEventFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+	postState := proto.Clone(beaconState).(*pb.BeaconState)
+	postState.Slot = block.Slot
+	if err := chainService.ApplyForkChoiceRule(context.Background(), block, postState); err != nil {
+		t.Fatalf("Could not apply fork choice rule: %v", err)
 	}
-	headRoot1, err := hashutil.HashBeaconBlock(potentialHead)
-	if err != nil {
-		t.Fatal(err)
+
+	want := &pbrpc.ChainEvent{
+		Type: pbrpc.ChainEventType_HEAD,
+		Head: &pbrpc.BlockRoot{Slot: block.Slot, Root: blockRoot[:]},
+	}
+	if ev := <-events; !proto.Equal(ev, want) {
+		t.Errorf("Wanted event %v, received %v", want, ev)
 	}
+}
 
-	potentialHead2 := &pb.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 6,
-		ParentRootHash32: genesisRoot[:],
+func TestQueueReorgEvent_ChainSplit(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	chainService := setupBeaconChain(t, beaconDB, nil)
+
+	// Construct the following chain:
+	//       /- C - E
+	// A - B - D - - - F
+	blockA := &pb.BeaconBlock{Slot: 1}
+	rootA, _ := hashutil.HashBeaconBlock(blockA)
+	blockB := &pb.BeaconBlock{Slot: 2, ParentRootHash32: rootA[:]}
+	rootB, _ := hashutil.HashBeaconBlock(blockB)
+	blockC := &pb.BeaconBlock{Slot: 3, ParentRootHash32: rootB[:]}
+	rootC, _ := hashutil.HashBeaconBlock(blockC)
+	blockD := &pb.BeaconBlock{Slot: 4, ParentRootHash32: rootB[:]}
+	rootD, _ := hashutil.HashBeaconBlock(blockD)
+	blockE := &pb.BeaconBlock{Slot: 5, ParentRootHash32: rootC[:]}
+	rootE, _ := hashutil.HashBeaconBlock(blockE)
+	blockF := &pb.BeaconBlock{Slot: 7, ParentRootHash32: rootD[:]}
+	rootF, _ := hashutil.HashBeaconBlock(blockF)
+	for _, block := range []*pb.BeaconBlock{blockA, blockB, blockC, blockD, blockE, blockF} {
+		if err := beaconDB.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
 	}
-	headRoot2, err := hashutil.HashBeaconBlock(potentialHead2)
+
+	ancestor, ancestorRoot, err := chainService.commonAncestor(blockF, blockB)
 	if err != nil {
-		t.Fatal(err)
-	}
-	// We store these potential heads in the DB.
-	if err := beaconDB.SaveBlock(potentialHead); err != nil {
-		t.Fatal(err)
+		t.Fatalf("Could not get common ancestor: %v", err)
 	}
-	if err := beaconDB.SaveBlock(potentialHead2); err != nil {
-		t.Fatal(err)
+	if ancestorRoot != rootB || !proto.Equal(ancestor, blockB) {
+		t.Errorf("Expected common ancestor %#x, received %#x", rootB, ancestorRoot)
 	}
-	beaconState := &pb.BeaconState{ValidatorBalances: []uint64{1e9, 1e9}}
-	voteTargets := make(map[uint64]*pb.AttestationTarget)
-	voteTargets[0] = &pb.AttestationTarget{
-		Slot:       potentialHead.Slot,
-		BlockRoot:  headRoot1[:],
-		ParentRoot: potentialHead.ParentRootHash32,
+
+	events := make(chan *pbrpc.ChainEvent, 1)
+	sub := chainService.ChainEventFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+	if err := chainService.queueReorgEvent(blockE, blockF); err != nil {
+		t.Fatalf("Could not queue reorg event: %v", err)
 	}
-	voteTargets[1] = &pb.AttestationTarget{
-		Slot:       potentialHead2.Slot,
-		BlockRoot:  headRoot2[:],
-		ParentRoot: potentialHead2.ParentRootHash32,
+	chainService.sendChainEvents()
+	want := &pbrpc.ChainEvent{
+		Type:           pbrpc.ChainEventType_REORG,
+		Head:           &pbrpc.BlockRoot{Slot: blockF.Slot, Root: rootF[:]},
+		OldHead:        &pbrpc.BlockRoot{Slot: blockE.Slot, Root: rootE[:]},
+		CommonAncestor: &pbrpc.BlockRoot{Slot: blockB.Slot, Root: rootB[:]},
+		ReorgDepth:     3,
 	}
-	count, err := VoteCount(genesisBlock, beaconState, voteTargets, beaconDB)
-	if err != nil {
-		t.Fatalf("Could not fetch vote balances: %v", err)
+	if ev := <-events; !proto.Equal(ev, want) {
+		t.Errorf("Wanted event %v, received %v", want, ev)
 	}
-	if count != 2e9 {
-		t.Errorf("Expected total balances 2e9, received %d", count)
+}
+
+func TestCommonAncestor_MissingParent(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	chainService := setupBeaconChain(t, beaconDB, nil)
+
+	block1 := &pb.BeaconBlock{Slot: 1, ParentRootHash32: []byte{'A'}}
+	block2 := &pb.BeaconBlock{Slot: 2, ParentRootHash32: []byte{'B'}}
+	if _, _, err := chainService.commonAncestor(block1, block2); err == nil {
+		t.Error("Expected blocks without common ancestor to fail")
 	}
 }
 
@@ -271,19 +307,14 @@ func TestAttestationTargets_RetrieveWorks(t *testing.T) {
 	}
 }
 
-func TestBlockChildren_2InARow(t *testing.T) {
+func TestInsertForkChoiceBlock_InsertsMissingAncestors(t *testing.T) {
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
-	ctx := context.Background()
 
 	chainService := setupBeaconChain(t, beaconDB, nil)
 
-	beaconState := &pb.BeaconState{
-		Slot: 3,
-	}
-
-	// Construct the following chain:
-	// B1 <- B2 <- B3  (State is slot 3)
+	// Construct the following chain, with only B1 in the fork choice store:
+	// B1 <- B2 <- (skip) <- B4
 	block1 := &pb.BeaconBlock{
 		Slot:             1,
 		ParentRootHash32: []byte{'A'},
@@ -292,13 +323,6 @@ func TestBlockChildren_2InARow(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Could not hash block: %v", err)
 	}
-	if err = chainService.beaconDB.SaveBlock(block1); err != nil {
-		t.Fatalf("Could not save block: %v", err)
-	}
-	if err = chainService.beaconDB.UpdateChainHead(ctx, block1, beaconState); err != nil {
-		t.Fatalf("Could update chain head: %v", err)
-	}
-
 	block2 := &pb.BeaconBlock{
 		Slot:             2,
 		ParentRootHash32: root1[:],
@@ -307,51 +331,43 @@ func TestBlockChildren_2InARow(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Could not hash block: %v", err)
 	}
-	if err = chainService.beaconDB.SaveBlock(block2); err != nil {
-		t.Fatalf("Could not save block: %v", err)
-	}
-	if err = chainService.beaconDB.UpdateChainHead(ctx, block2, beaconState); err != nil {
-		t.Fatalf("Could update chain head: %v", err)
-	}
-
-	block3 := &pb.BeaconBlock{
-		Slot:             3,
+	block4 := &pb.BeaconBlock{
+		Slot:             4,
 		ParentRootHash32: root2[:],
 	}
-	if err = chainService.beaconDB.SaveBlock(block3); err != nil {
-		t.Fatalf("Could not save block: %v", err)
+	root4, err := hashutil.HashBeaconBlock(block4)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
 	}
-	if err = chainService.beaconDB.UpdateChainHead(ctx, block3, beaconState); err != nil {
-		t.Fatalf("Could update chain head: %v", err)
+	for _, block := range []*pb.BeaconBlock{block1, block2, block4} {
+		if err := chainService.beaconDB.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
 	}
-
-	childrenBlock, err := chainService.blockChildren(ctx, block1, beaconState.Slot)
-	if err != nil {
-		t.Fatalf("Could not get block children: %v", err)
+	if _, err := chainService.setForkChoiceRoot(block1); err != nil {
+		t.Fatal(err)
 	}
 
-	// When we input block B1, we should get B2 back.
-	wanted := []*pb.BeaconBlock{block2}
-	if !reflect.DeepEqual(wanted, childrenBlock) {
-		t.Errorf("Wrong children block received")
+	if err := chainService.insertForkChoiceBlock(block4); err != nil {
+		t.Fatalf("Could not insert block: %v", err)
+	}
+	if !chainService.forkChoiceStore.HasNode(root2) {
+		t.Error("Expected missing ancestor of the block to be inserted")
+	}
+	if !chainService.forkChoiceStore.HasNode(root4) {
+		t.Error("Expected block to be inserted")
 	}
 }
 
-func TestBlockChildren_ChainSplits(t *testing.T) {
+func TestInsertForkChoiceBlock_IgnoresNonDescendants(t *testing.T) {
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
-	ctx := context.Background()
 
 	chainService := setupBeaconChain(t, beaconDB, nil)
 
-	beaconState := &pb.BeaconState{
-		Slot: 10,
-	}
-
-	// Construct the following chain:
-	//     /- B2
-	// B1 <- B3 (State is slot 10)
-	//      \- B4
+	// Construct the following chain, with only B2 in the fork choice store:
+	// B1 <- B2
+	//   \- B3
 	block1 := &pb.BeaconBlock{
 		Slot:             1,
 		ParentRootHash32: []byte{'A'},
@@ -360,71 +376,44 @@ func TestBlockChildren_ChainSplits(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Could not hash block: %v", err)
 	}
-	if err = chainService.beaconDB.SaveBlock(block1); err != nil {
-		t.Fatalf("Could not save block: %v", err)
-	}
-	if err = chainService.beaconDB.UpdateChainHead(ctx, block1, beaconState); err != nil {
-		t.Fatalf("Could update chain head: %v", err)
-	}
-
 	block2 := &pb.BeaconBlock{
 		Slot:             2,
 		ParentRootHash32: root1[:],
 	}
-	if err = chainService.beaconDB.SaveBlock(block2); err != nil {
-		t.Fatalf("Could not save block: %v", err)
-	}
-	if err = chainService.beaconDB.UpdateChainHead(ctx, block2, beaconState); err != nil {
-		t.Fatalf("Could update chain head: %v", err)
-	}
-
 	block3 := &pb.BeaconBlock{
 		Slot:             3,
 		ParentRootHash32: root1[:],
 	}
-	if err = chainService.beaconDB.SaveBlock(block3); err != nil {
-		t.Fatalf("Could not save block: %v", err)
-	}
-	if err = chainService.beaconDB.UpdateChainHead(ctx, block3, beaconState); err != nil {
-		t.Fatalf("Could update chain head: %v", err)
-	}
-
-	block4 := &pb.BeaconBlock{
-		Slot:             4,
-		ParentRootHash32: root1[:],
+	root3, err := hashutil.HashBeaconBlock(block3)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
 	}
-	if err = chainService.beaconDB.SaveBlock(block4); err != nil {
-		t.Fatalf("Could not save block: %v", err)
+	for _, block := range []*pb.BeaconBlock{block1, block2, block3} {
+		if err := chainService.beaconDB.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
 	}
-	if err = chainService.beaconDB.UpdateChainHead(ctx, block4, beaconState); err != nil {
-		t.Fatalf("Could update chain head: %v", err)
+	if _, err := chainService.setForkChoiceRoot(block2); err != nil {
+		t.Fatal(err)
 	}
 
-	childrenBlock, err := chainService.blockChildren(ctx, block1, beaconState.Slot)
-	if err != nil {
-		t.Fatalf("Could not get block children: %v", err)
+	if err := chainService.insertForkChoiceBlock(block3); err != nil {
+		t.Fatalf("Could not insert block: %v", err)
 	}
-
-	// When we input block B1, we should get B2, B3 and B4 back.
-	wanted := []*pb.BeaconBlock{block2, block3, block4}
-	if !reflect.DeepEqual(wanted, childrenBlock) {
-		t.Errorf("Wrong children block received")
+	if chainService.forkChoiceStore.HasNode(root1) || chainService.forkChoiceStore.HasNode(root3) {
+		t.Error("Expected blocks not descending from the store root to be ignored")
 	}
 }
 
-func TestBlockChildren_SkipSlots(t *testing.T) {
+func TestSetForkChoiceRoot_RebuildsFromFinalizedBlock(t *testing.T) {
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
-	ctx := context.Background()
 
 	chainService := setupBeaconChain(t, beaconDB, nil)
 
-	beaconState := &pb.BeaconState{
-		Slot: 10,
-	}
-
-	// Construct the following chain:
-	// B1 <- B5 <- B9 (State is slot 10)
+	// Construct the following chain, with B1 finalized and only B2 in the fork choice store:
+	// B1 <- B2
+	//   \- B3
 	block1 := &pb.BeaconBlock{
 		Slot:             1,
 		ParentRootHash32: []byte{'A'},
@@ -433,48 +422,49 @@ func TestBlockChildren_SkipSlots(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Could not hash block: %v", err)
 	}
-	if err = chainService.beaconDB.SaveBlock(block1); err != nil {
-		t.Fatalf("Could not save block: %v", err)
-	}
-	if err = chainService.beaconDB.UpdateChainHead(ctx, block1, beaconState); err != nil {
-		t.Fatalf("Could update chain head: %v", err)
+	block2 := &pb.BeaconBlock{
+		Slot:             2,
+		ParentRootHash32: root1[:],
 	}
-
-	block5 := &pb.BeaconBlock{
-		Slot:             5,
+	block3 := &pb.BeaconBlock{
+		Slot:             3,
 		ParentRootHash32: root1[:],
 	}
-	root2, err := hashutil.HashBeaconBlock(block5)
-	if err != nil {
-		t.Fatalf("Could not hash block: %v", err)
+	for _, block := range []*pb.BeaconBlock{block1, block2, block3} {
+		if err := chainService.beaconDB.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
 	}
-	if err = chainService.beaconDB.SaveBlock(block5); err != nil {
-		t.Fatalf("Could not save block: %v", err)
+	if err := chainService.beaconDB.SaveFinalizedBlock(block1); err != nil {
+		t.Fatal(err)
 	}
-	if err = chainService.beaconDB.UpdateChainHead(ctx, block5, beaconState); err != nil {
-		t.Fatalf("Could update chain head: %v", err)
+	root2, err := chainService.setForkChoiceRoot(block2)
+	if err != nil {
+		t.Fatal(err)
 	}
+	chainService.forkChoiceStore.ProcessAttestation(0, root2)
 
-	block9 := &pb.BeaconBlock{
-		Slot:             9,
-		ParentRootHash32: root2[:],
+	root3, err := chainService.setForkChoiceRoot(block3)
+	if err != nil {
+		t.Fatal(err)
 	}
-	if err = chainService.beaconDB.SaveBlock(block9); err != nil {
-		t.Fatalf("Could not save block: %v", err)
+	if storeRoot, _, _ := chainService.forkChoiceStore.Root(); storeRoot != root1 {
+		t.Errorf("Expected store root %#x, received %#x", root1, storeRoot)
 	}
-	if err = chainService.beaconDB.UpdateChainHead(ctx, block9, beaconState); err != nil {
-		t.Fatalf("Could update chain head: %v", err)
+	if !chainService.forkChoiceStore.HasNode(root3) {
+		t.Error("Expected justified block to be inserted")
 	}
 
-	childrenBlock, err := chainService.blockChildren(ctx, block1, beaconState.Slot)
+	// The vote for B2 is applied again once B2 is back in the store.
+	if err := chainService.insertForkChoiceBlock(block2); err != nil {
+		t.Fatalf("Could not insert block: %v", err)
+	}
+	head, err := chainService.forkChoiceStore.Head(root1, map[uint64]uint64{0: 10})
 	if err != nil {
-		t.Fatalf("Could not get block children: %v", err)
+		t.Fatalf("Could not get head: %v", err)
 	}
-
-	// When we input block B1, we should get B5.
-	wanted := []*pb.BeaconBlock{block5}
-	if !reflect.DeepEqual(wanted, childrenBlock) {
-		t.Errorf("Wrong children block received")
+	if head != root2 {
+		t.Errorf("Expected head %#x, received %#x", root2, head)
 	}
 }
 
@@ -720,6 +710,16 @@ func TestLMDGhost_3WayChainSplitsEqualVotes(t *testing.T) {
 		t.Fatalf("Could update chain head: %v", err)
 	}
 
+	// Blocks without votes only get to the fork choice store through block processing.
+	if _, err := chainService.setForkChoiceRoot(block1); err != nil {
+		t.Fatal(err)
+	}
+	for _, block := range []*pb.BeaconBlock{block2, block3, block4} {
+		if err := chainService.insertForkChoiceBlock(block); err != nil {
+			t.Fatalf("Could not insert block: %v", err)
+		}
+	}
+
 	head, err := chainService.lmdGhost(ctx, block1, beaconState, nil)
 	if err != nil {
 		t.Fatalf("Could not run LMD GHOST: %v", err)
@@ -1614,125 +1614,3 @@ func setupFFGTest(t *testing.T) ([32]byte, *pb.BeaconBlock, *pb.BeaconState, []*
 	return gBlockRoot, gBlock, gState, privKeys
 }
 
-func TestVoteCount_CacheEnabledAndMiss(t *testing.T) {
-	beaconDB := internal.SetupDB(t)
-	defer internal.TeardownDB(t, beaconDB)
-	genesisBlock := b.NewGenesisBlock([]byte("stateroot"))
-	genesisRoot, err := hashutil.HashBeaconBlock(genesisBlock)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if err := beaconDB.SaveBlock(genesisBlock); err != nil {
-		t.Fatal(err)
-	}
-
-	potentialHead := &pb.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 5,
-		ParentRootHash32: genesisRoot[:],
-	}
-	pHeadHash, err := hashutil.HashBeaconBlock(potentialHead)
-	if err != nil {
-		t.Fatal(err)
-	}
-	potentialHead2 := &pb.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 6,
-		ParentRootHash32: genesisRoot[:],
-	}
-	pHeadHash2, err := hashutil.HashBeaconBlock(potentialHead2)
-	if err != nil {
-		t.Fatal(err)
-	}
-	// We store these potential heads in the DB.
-	if err := beaconDB.SaveBlock(potentialHead); err != nil {
-		t.Fatal(err)
-	}
-	if err := beaconDB.SaveBlock(potentialHead2); err != nil {
-		t.Fatal(err)
-	}
-	beaconState := &pb.BeaconState{ValidatorBalances: []uint64{1e9, 1e9}}
-	voteTargets := make(map[uint64]*pb.AttestationTarget)
-	voteTargets[0] = &pb.AttestationTarget{
-		Slot:       potentialHead.Slot,
-		BlockRoot:  pHeadHash[:],
-		ParentRoot: potentialHead.ParentRootHash32,
-	}
-	voteTargets[1] = &pb.AttestationTarget{
-		Slot:       potentialHead2.Slot,
-		BlockRoot:  pHeadHash2[:],
-		ParentRoot: potentialHead2.ParentRootHash32,
-	}
-	count, err := VoteCount(genesisBlock, beaconState, voteTargets, beaconDB)
-	if err != nil {
-		t.Fatalf("Could not fetch vote balances: %v", err)
-	}
-	if count != 2e9 {
-		t.Errorf("Expected total balances 2e9, received %d", count)
-	}
-
-	// Verify block ancestor was correctly cached.
-	h, _ := hashutil.HashBeaconBlock(potentialHead)
-	cachedInfo, err := blkAncestorCache.AncestorBySlot(h[:], genesisBlock.Slot)
-	if err != nil {
-		t.Fatal(err)
-	}
-	// Verify the cached block ancestor is genesis block.
-	if bytesutil.ToBytes32(cachedInfo.Target.BlockRoot) != genesisRoot {
-		t.Error("could not retrieve the correct ancestor block")
-	}
-}
-
-func TestVoteCount_CacheEnabledAndHit(t *testing.T) {
-	genesisBlock := b.NewGenesisBlock([]byte("stateroot"))
-	genesisRoot, err := hashutil.HashBeaconBlock(genesisBlock)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	potentialHead := &pb.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 5,
-		ParentRootHash32: genesisRoot[:],
-	}
-	pHeadHash, _ := hashutil.HashBeaconBlock(potentialHead)
-	potentialHead2 := &pb.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 6,
-		ParentRootHash32: genesisRoot[:],
-	}
-	pHeadHash2, _ := hashutil.HashBeaconBlock(potentialHead2)
-
-	beaconState := &pb.BeaconState{ValidatorBalances: []uint64{1e9, 1e9}}
-	voteTargets := make(map[uint64]*pb.AttestationTarget)
-	voteTargets[0] = &pb.AttestationTarget{
-		Slot:       potentialHead.Slot,
-		BlockRoot:  pHeadHash[:],
-		ParentRoot: potentialHead.ParentRootHash32,
-	}
-	voteTargets[1] = &pb.AttestationTarget{
-		Slot:       potentialHead2.Slot,
-		BlockRoot:  pHeadHash2[:],
-		ParentRoot: potentialHead2.ParentRootHash32,
-	}
-
-	aInfo := &cache.AncestorInfo{
-		Target: &pb.AttestationTarget{
-			Slot:       genesisBlock.Slot,
-			BlockRoot:  genesisRoot[:],
-			ParentRoot: genesisBlock.ParentRootHash32,
-		},
-	}
-	// Presave cached ancestor blocks before running vote count.
-	if err := blkAncestorCache.AddBlockAncestor(aInfo); err != nil {
-		t.Fatal(err)
-	}
-	aInfo.Target.BlockRoot = pHeadHash2[:]
-	if err := blkAncestorCache.AddBlockAncestor(aInfo); err != nil {
-		t.Fatal(err)
-	}
-
-	count, err := VoteCount(genesisBlock, beaconState, voteTargets, nil)
-	if err != nil {
-		t.Fatalf("Could not fetch vote balances: %v", err)
-	}
-	if count != 2e9 {
-		t.Errorf("Expected total balances 2e9, received %d", count)
-	}
-}
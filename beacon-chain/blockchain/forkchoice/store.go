@@ -0,0 +1,275 @@
+// Package forkchoice defines an in-memory block tree used to run the LMD GHOST
+// fork choice rule without walking the database. Blocks are kept in a flat array
+// in insertion order, so that parents always come before their children, and
+// the weight of every block is updated incrementally from the changes in the
+// latest messages of the validators.
+package forkchoice
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// ErrUnknownParent is returned when a block is inserted into a non-empty store
+// which does not hold its parent.
+var ErrUnknownParent = errors.New("parent block is not in the fork choice store")
+
+const noIndex = -1
+
+// node is a block in the fork choice store.
+type node struct {
+	slot           uint64
+	root           [32]byte
+	parent         int
+	weight         uint64
+	bestChild      int
+	bestDescendant int
+}
+
+// vote is the latest message of a validator. The current root and balance are the
+// ones already applied to the weights of the store, while the next root is the
+// latest one received.
+type vote struct {
+	currentRoot [32]byte
+	nextRoot    [32]byte
+	balance     uint64
+	applied     bool
+}
+
+// Store holds the block tree rooted at the last finalized block along with the
+// latest messages of the validators.
+type Store struct {
+	lock    sync.RWMutex
+	nodes   []*node
+	indices map[[32]byte]int
+	votes   map[uint64]*vote
+}
+
+// NewStore creates an empty fork choice store.
+func NewStore() *Store {
+	return &Store{
+		indices: make(map[[32]byte]int),
+		votes:   make(map[uint64]*vote),
+	}
+}
+
+// Reset empties the store of its blocks. The latest messages of the validators are
+// kept, and applied again to the blocks inserted afterwards on the next call to Head.
+func (s *Store) Reset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.nodes = nil
+	s.indices = make(map[[32]byte]int)
+	for _, v := range s.votes {
+		v.applied = false
+	}
+}
+
+// Root returns the root and slot of the oldest block of the store, which every
+// other block descends from. The boolean is false if the store is empty.
+func (s *Store) Root() ([32]byte, uint64, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if len(s.nodes) == 0 {
+		return [32]byte{}, 0, false
+	}
+	return s.nodes[0].root, s.nodes[0].slot, true
+}
+
+// HasNode returns true if the block is in the store.
+func (s *Store) HasNode(root [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.indices[root]
+	return ok
+}
+
+// Weight returns the sum of the balances of the validators whose latest message
+// is the block or one of its descendants, as of the last call to Head.
+func (s *Store) Weight(root [32]byte) (uint64, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	idx, ok := s.indices[root]
+	if !ok {
+		return 0, fmt.Errorf("block %#x is not in the fork choice store", root)
+	}
+	return s.nodes[idx].weight, nil
+}
+
+// ProcessBlock inserts a block into the store. The first block inserted into an
+// empty store becomes its root, any other block must have its parent in the store.
+func (s *Store) ProcessBlock(slot uint64, root [32]byte, parentRoot [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.indices[root]; ok {
+		return nil
+	}
+	parent := noIndex
+	if len(s.nodes) > 0 {
+		idx, ok := s.indices[parentRoot]
+		if !ok {
+			return ErrUnknownParent
+		}
+		parent = idx
+	}
+	s.indices[root] = len(s.nodes)
+	s.nodes = append(s.nodes, &node{
+		slot:           slot,
+		root:           root,
+		parent:         parent,
+		bestChild:      noIndex,
+		bestDescendant: noIndex,
+	})
+	return nil
+}
+
+// ProcessAttestation records the block as the latest message of the validator. The
+// vote is only applied to the weights of the store on the next call to Head, and a
+// vote for a block which is not in the store yet is applied once the block is inserted.
+func (s *Store) ProcessAttestation(validatorIdx uint64, root [32]byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	v, ok := s.votes[validatorIdx]
+	if !ok {
+		v = &vote{}
+		s.votes[validatorIdx] = v
+	}
+	v.nextRoot = root
+}
+
+// Head applies the vote and balance changes since its last call to the weights of
+// the blocks and returns the head of the chain starting from the justified block:
+// the descendant reached by always following the child with the highest weight,
+// ties being broken in favor of the higher block root.
+func (s *Store) Head(justifiedRoot [32]byte, balances map[uint64]uint64) ([32]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	justifiedIdx, ok := s.indices[justifiedRoot]
+	if !ok {
+		return [32]byte{}, fmt.Errorf("justified block %#x is not in the fork choice store", justifiedRoot)
+	}
+
+	s.applyDeltas(s.computeDeltas(balances))
+
+	bestDescendant := s.nodes[justifiedIdx].bestDescendant
+	if bestDescendant == noIndex {
+		return justifiedRoot, nil
+	}
+	return s.nodes[bestDescendant].root, nil
+}
+
+// computeDeltas returns the weight change of every block caused by the validators
+// whose latest message or balance changed, and marks these changes as applied. The
+// latest messages for blocks which are not in the store stay pending.
+func (s *Store) computeDeltas(balances map[uint64]uint64) []int64 {
+	deltas := make([]int64, len(s.nodes))
+	for validatorIdx, v := range s.votes {
+		newBalance := balances[validatorIdx]
+		nextIdx, known := s.indices[v.nextRoot]
+		if v.applied && known && v.currentRoot == v.nextRoot && v.balance == newBalance {
+			continue
+		}
+		if v.applied {
+			if idx, ok := s.indices[v.currentRoot]; ok {
+				deltas[idx] -= int64(v.balance)
+			}
+			v.applied = false
+		}
+		if !known {
+			continue
+		}
+		deltas[nextIdx] += int64(newBalance)
+		v.currentRoot = v.nextRoot
+		v.balance = newBalance
+		v.applied = true
+	}
+	return deltas
+}
+
+// applyDeltas adds the weight changes to the blocks and their ancestors, then
+// updates the best child and best descendant of every block. Children come after
+// their parents in the store, so walking it backwards visits every child before
+// its parent.
+func (s *Store) applyDeltas(deltas []int64) {
+	for i := len(s.nodes) - 1; i >= 0; i-- {
+		n := s.nodes[i]
+		n.weight = uint64(int64(n.weight) + deltas[i])
+		n.bestChild = noIndex
+		n.bestDescendant = noIndex
+		if n.parent != noIndex {
+			deltas[n.parent] += deltas[i]
+		}
+	}
+	// Every block is compared with the best child found so far among its siblings.
+	for i := 1; i < len(s.nodes); i++ {
+		parent := s.nodes[s.nodes[i].parent]
+		if parent.bestChild == noIndex || s.isHeavier(i, parent.bestChild) {
+			parent.bestChild = i
+		}
+	}
+	for i := len(s.nodes) - 1; i >= 0; i-- {
+		n := s.nodes[i]
+		if n.bestChild == noIndex {
+			continue
+		}
+		n.bestDescendant = n.bestChild
+		if s.nodes[n.bestChild].bestDescendant != noIndex {
+			n.bestDescendant = s.nodes[n.bestChild].bestDescendant
+		}
+	}
+}
+
+// isHeavier returns true if the first block has a higher weight than the second
+// one, or the same weight and a higher root as defined by bytesutil.LowerThan.
+func (s *Store) isHeavier(idx1 int, idx2 int) bool {
+	n1 := s.nodes[idx1]
+	n2 := s.nodes[idx2]
+	if n1.weight != n2.weight {
+		return n1.weight > n2.weight
+	}
+	return bytesutil.LowerThan(n2.root[:], n1.root[:])
+}
+
+// Prune removes every block which is not the finalized block or one of its
+// descendants, making the finalized block the new root of the store.
+func (s *Store) Prune(finalizedRoot [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	finalizedIdx, ok := s.indices[finalizedRoot]
+	if !ok {
+		return fmt.Errorf("finalized block %#x is not in the fork choice store", finalizedRoot)
+	}
+	if finalizedIdx == 0 {
+		return nil
+	}
+
+	// Descendants of the finalized block come after it in the store.
+	newIndices := make(map[[32]byte]int)
+	var newNodes []*node
+	for i := finalizedIdx; i < len(s.nodes); i++ {
+		n := s.nodes[i]
+		parent := noIndex
+		if i != finalizedIdx {
+			newParent, ok := newIndices[s.nodes[n.parent].root]
+			if !ok {
+				continue
+			}
+			parent = newParent
+		}
+		newIndices[n.root] = len(newNodes)
+		newNodes = append(newNodes, &node{
+			slot:   n.slot,
+			root:   n.root,
+			parent: parent,
+			weight: n.weight,
+		})
+	}
+	s.nodes = newNodes
+	s.indices = newIndices
+	// Rebuild the best children and descendants with the new indices.
+	s.applyDeltas(make([]int64, len(s.nodes)))
+	return nil
+}
@@ -0,0 +1,274 @@
+package forkchoice
+
+import (
+	"testing"
+)
+
+func root(b byte) [32]byte {
+	return [32]byte{b}
+}
+
+// buildStore constructs the following block tree:
+//        /- B2 - B4
+//   B1 -
+//        \- B3 - B5 - B6
+func buildStore(t *testing.T) *Store {
+	s := NewStore()
+	blocks := []struct {
+		slot   uint64
+		root   [32]byte
+		parent [32]byte
+	}{
+		{1, root(1), [32]byte{}},
+		{2, root(2), root(1)},
+		{3, root(3), root(1)},
+		{4, root(4), root(2)},
+		{5, root(5), root(3)},
+		{6, root(6), root(5)},
+	}
+	for _, b := range blocks {
+		if err := s.ProcessBlock(b.slot, b.root, b.parent); err != nil {
+			t.Fatalf("Could not process block: %v", err)
+		}
+	}
+	return s
+}
+
+func TestProcessBlock_UnknownParent(t *testing.T) {
+	s := buildStore(t)
+	if err := s.ProcessBlock(7, root(7), root(8)); err != ErrUnknownParent {
+		t.Errorf("Expected %v, received %v", ErrUnknownParent, err)
+	}
+	if s.HasNode(root(7)) {
+		t.Error("Expected block with unknown parent not to be inserted")
+	}
+}
+
+func TestProcessBlock_FirstBlockIsRoot(t *testing.T) {
+	s := NewStore()
+	if _, _, ok := s.Root(); ok {
+		t.Error("Expected empty store to have no root")
+	}
+	if err := s.ProcessBlock(5, root(5), root(4)); err != nil {
+		t.Fatalf("Could not process block: %v", err)
+	}
+	r, slot, ok := s.Root()
+	if !ok || r != root(5) || slot != 5 {
+		t.Errorf("Expected root %#x at slot 5, received %#x at slot %d", root(5), r, slot)
+	}
+}
+
+func TestHead_FollowsHeaviestBranch(t *testing.T) {
+	s := buildStore(t)
+	s.ProcessAttestation(0, root(4))
+	s.ProcessAttestation(1, root(6))
+	s.ProcessAttestation(2, root(5))
+
+	head, err := s.Head(root(1), map[uint64]uint64{0: 10, 1: 10, 2: 10})
+	if err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+	if head != root(6) {
+		t.Errorf("Expected head %#x, received %#x", root(6), head)
+	}
+	weight, err := s.Weight(root(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if weight != 20 {
+		t.Errorf("Expected weight 20, received %d", weight)
+	}
+}
+
+func TestHead_AppliesVoteChanges(t *testing.T) {
+	s := buildStore(t)
+	balances := map[uint64]uint64{0: 10, 1: 10, 2: 10}
+	s.ProcessAttestation(0, root(4))
+	s.ProcessAttestation(1, root(4))
+	s.ProcessAttestation(2, root(6))
+	head, err := s.Head(root(1), balances)
+	if err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+	if head != root(4) {
+		t.Errorf("Expected head %#x, received %#x", root(4), head)
+	}
+
+	// Validator 1 moves its vote to the other branch.
+	s.ProcessAttestation(1, root(6))
+	head, err = s.Head(root(1), balances)
+	if err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+	if head != root(6) {
+		t.Errorf("Expected head %#x, received %#x", root(6), head)
+	}
+	for r, want := range map[[32]byte]uint64{root(1): 30, root(2): 10, root(4): 10, root(3): 20, root(6): 20} {
+		weight, err := s.Weight(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if weight != want {
+			t.Errorf("Expected weight %d for block %#x, received %d", want, r, weight)
+		}
+	}
+}
+
+func TestHead_AppliesBalanceChanges(t *testing.T) {
+	s := buildStore(t)
+	s.ProcessAttestation(0, root(4))
+	s.ProcessAttestation(1, root(6))
+	if _, err := s.Head(root(1), map[uint64]uint64{0: 20, 1: 10}); err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+
+	head, err := s.Head(root(1), map[uint64]uint64{0: 5, 1: 10})
+	if err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+	if head != root(6) {
+		t.Errorf("Expected head %#x, received %#x", root(6), head)
+	}
+	weight, err := s.Weight(root(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if weight != 15 {
+		t.Errorf("Expected weight 15, received %d", weight)
+	}
+}
+
+func TestHead_KeepsVotesForUnknownBlocks(t *testing.T) {
+	s := buildStore(t)
+	s.ProcessAttestation(0, root(2))
+	s.ProcessAttestation(0, root(7))
+	balances := map[uint64]uint64{0: 10}
+	head, err := s.Head(root(1), balances)
+	if err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+	// The latest message of the validator is not in the store yet, so B2 lost its vote.
+	if head != root(6) {
+		t.Errorf("Expected head %#x, received %#x", root(6), head)
+	}
+
+	if err := s.ProcessBlock(7, root(7), root(4)); err != nil {
+		t.Fatalf("Could not process block: %v", err)
+	}
+	head, err = s.Head(root(1), balances)
+	if err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+	if head != root(7) {
+		t.Errorf("Expected head %#x, received %#x", root(7), head)
+	}
+}
+
+func TestReset_KeepsVotes(t *testing.T) {
+	s := buildStore(t)
+	s.ProcessAttestation(0, root(4))
+	balances := map[uint64]uint64{0: 10}
+	if _, err := s.Head(root(1), balances); err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+
+	s.Reset()
+	if s.HasNode(root(1)) {
+		t.Error("Expected blocks to be removed")
+	}
+	// Without the vote, the tie between B4 and B5 would be broken in favor of B5.
+	for _, r := range [][32]byte{root(2), root(4), root(5)} {
+		if err := s.ProcessBlock(uint64(r[0]), r, root(2)); err != nil {
+			t.Fatalf("Could not process block: %v", err)
+		}
+	}
+	head, err := s.Head(root(2), balances)
+	if err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+	if head != root(4) {
+		t.Errorf("Expected head %#x, received %#x", root(4), head)
+	}
+	weight, err := s.Weight(root(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if weight != 10 {
+		t.Errorf("Expected weight 10, received %d", weight)
+	}
+}
+
+func TestHead_StartsFromJustifiedBlock(t *testing.T) {
+	s := buildStore(t)
+	s.ProcessAttestation(0, root(4))
+	s.ProcessAttestation(1, root(4))
+	head, err := s.Head(root(3), map[uint64]uint64{0: 10, 1: 10})
+	if err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+	if head != root(6) {
+		t.Errorf("Expected head %#x, received %#x", root(6), head)
+	}
+
+	if _, err := s.Head(root(9), nil); err == nil {
+		t.Error("Expected unknown justified block to fail")
+	}
+}
+
+func TestHead_TieBrokenByHigherRoot(t *testing.T) {
+	s := buildStore(t)
+	head, err := s.Head(root(1), nil)
+	if err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+	if head != root(6) {
+		t.Errorf("Expected head %#x, received %#x", root(6), head)
+	}
+}
+
+func TestPrune_RemovesNonDescendants(t *testing.T) {
+	s := buildStore(t)
+	s.ProcessAttestation(0, root(4))
+	s.ProcessAttestation(1, root(6))
+	if _, err := s.Head(root(1), map[uint64]uint64{0: 10, 1: 10}); err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+
+	if err := s.Prune(root(3)); err != nil {
+		t.Fatalf("Could not prune store: %v", err)
+	}
+	for _, r := range [][32]byte{root(1), root(2), root(4)} {
+		if s.HasNode(r) {
+			t.Errorf("Expected block %#x to be pruned", r)
+		}
+	}
+	r, _, _ := s.Root()
+	if r != root(3) {
+		t.Errorf("Expected root %#x, received %#x", root(3), r)
+	}
+
+	// Votes moving away from pruned blocks are still applied.
+	s.ProcessAttestation(0, root(5))
+	if err := s.ProcessBlock(7, root(7), root(5)); err != nil {
+		t.Fatalf("Could not process block: %v", err)
+	}
+	s.ProcessAttestation(1, root(7))
+	head, err := s.Head(root(3), map[uint64]uint64{0: 10, 1: 10})
+	if err != nil {
+		t.Fatalf("Could not get head: %v", err)
+	}
+	if head != root(7) {
+		t.Errorf("Expected head %#x, received %#x", root(7), head)
+	}
+	weight, err := s.Weight(root(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if weight != 20 {
+		t.Errorf("Expected weight 20, received %d", weight)
+	}
+
+	if err := s.Prune(root(9)); err == nil {
+		t.Error("Expected unknown finalized block to fail")
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/forkchoice"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
@@ -45,6 +47,9 @@ type ChainService struct {
 	opsPoolService       operations.OperationFeeds
 	chainStartChan       chan time.Time
 	canonicalBlockFeed   *event.Feed
+	chainEventFeed       *event.Feed
+	pendingEvents        []*pbrpc.ChainEvent
+	pendingEventsLock    sync.Mutex
 	genesisTime          time.Time
 	finalizedEpoch       uint64
 	stateInitializedFeed *event.Feed
@@ -52,6 +57,10 @@ type ChainService struct {
 	canonicalBlocks      map[uint64][]byte
 	canonicalBlocksLock  sync.RWMutex
 	receiveBlockLock     sync.Mutex
+	forkChoiceStore      *forkchoice.Store
+	stateCache           *cache.StateCache
+	genesisState         *pb.BeaconState
+	noPOWChain           bool
 }
 
 // Config options for the service.
@@ -63,6 +72,13 @@ type Config struct {
 	OpsPoolService operations.OperationFeeds
 	DevMode        bool
 	P2p            p2p.Broadcaster
+	// GenesisState starts the beacon chain from this state instead of waiting for
+	// the ChainStart log of the deposit contract.
+	GenesisState *pb.BeaconState
+	// NoPOWChain runs the beacon chain without an ETH1.0 chain, as a chain started
+	// from an interop genesis state does. Blocks are then not checked against the
+	// ETH1.0 block referenced by the state.
+	NoPOWChain bool
 }
 
 // NewChainService instantiates a new service instance that will
@@ -77,10 +93,15 @@ func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
 		opsPoolService:       cfg.OpsPoolService,
 		attsService:          cfg.AttsService,
 		canonicalBlockFeed:   new(event.Feed),
+		chainEventFeed:       new(event.Feed),
 		chainStartChan:       make(chan time.Time),
 		stateInitializedFeed: new(event.Feed),
 		p2p:                  cfg.P2p,
 		canonicalBlocks:      make(map[uint64][]byte),
+		forkChoiceStore:      forkchoice.NewStore(),
+		stateCache:           cache.NewStateCache(),
+		genesisState:         cfg.GenesisState,
+		noPOWChain:           cfg.NoPOWChain,
 	}, nil
 }
 
@@ -95,6 +116,15 @@ func (c *ChainService) Start() {
 		log.Info("Beacon chain data already exists, starting service")
 		c.genesisTime = time.Unix(int64(beaconState.GenesisTime), 0)
 		c.finalizedEpoch = beaconState.FinalizedEpoch
+	} else if c.genesisState != nil {
+		beaconState, err := c.initializeBeaconChainFromGenesisState(c.genesisState)
+		if err != nil {
+			log.Fatalf("Could not initialize beacon chain: %v", err)
+		}
+		c.finalizedEpoch = beaconState.FinalizedEpoch
+		c.stateInitializedFeed.Send(c.genesisTime)
+	} else if c.noPOWChain {
+		log.Fatal("Cannot start the beacon chain without a genesis state when running without a POW chain")
 	} else {
 		log.Info("Waiting for ChainStart log from the Validator Deposit Contract to start the beacon chain...")
 		if c.web3Service == nil {
@@ -141,6 +171,26 @@ func (c *ChainService) initializeBeaconChain(genesisTime time.Time, deposits []*
 	if err := c.beaconDB.InitializeState(c.ctx, unixTime, deposits, eth1data); err != nil {
 		return nil, fmt.Errorf("could not initialize beacon state to disk: %v", err)
 	}
+	return c.saveGenesisData(ctx)
+}
+
+// initializeBeaconChainFromGenesisState initializes the state and genesis block of
+// the beacon chain to persistent storage from a genesis state built without the
+// ETH1.0 Deposit Contract, such as an interop genesis state.
+func (c *ChainService) initializeBeaconChainFromGenesisState(genesisState *pb.BeaconState) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(context.Background(), "beacon-chain.ChainService.initializeBeaconChainFromGenesisState")
+	defer span.End()
+	log.WithField("genesisTime", genesisState.GenesisTime).Info("Starting the beacon chain from the genesis state")
+	c.genesisTime = time.Unix(int64(genesisState.GenesisTime), 0)
+	if err := c.beaconDB.InitializeGenesisState(c.ctx, genesisState); err != nil {
+		return nil, fmt.Errorf("could not initialize beacon state to disk: %v", err)
+	}
+	return c.saveGenesisData(ctx)
+}
+
+// saveGenesisData saves the genesis block of the initialized head state as the
+// justified and finalized block, along with the state.
+func (c *ChainService) saveGenesisData(ctx context.Context) (*pb.BeaconState, error) {
 	beaconState, err := c.beaconDB.HeadState(c.ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not attempt fetch beacon state: %v", err)
@@ -207,6 +257,34 @@ func (c *ChainService) CanonicalBlockFeed() *event.Feed {
 	return c.canonicalBlockFeed
 }
 
+// ChainEventFeed returns a feed that is written to whenever the fork choice rule
+// changes the chain head or a new justified or finalized block is saved. Events
+// are sent as *pbrpc.ChainEvent.
+func (c *ChainService) ChainEventFeed() *event.Feed {
+	return c.chainEventFeed
+}
+
+// queueChainEvent adds an event to be sent to the chain event feed by the next
+// call to sendChainEvents.
+func (c *ChainService) queueChainEvent(ev *pbrpc.ChainEvent) {
+	c.pendingEventsLock.Lock()
+	defer c.pendingEventsLock.Unlock()
+	c.pendingEvents = append(c.pendingEvents, ev)
+}
+
+// sendChainEvents sends the queued events to the chain event feed. It must be
+// called without holding the chain service locks, as the feed blocks until every
+// subscriber received the events.
+func (c *ChainService) sendChainEvents() {
+	c.pendingEventsLock.Lock()
+	events := c.pendingEvents
+	c.pendingEvents = nil
+	c.pendingEventsLock.Unlock()
+	for _, ev := range events {
+		c.chainEventFeed.Send(ev)
+	}
+}
+
 // StateInitializedFeed returns a feed that is written to
 // when the beacon state is first initialized.
 func (c *ChainService) StateInitializedFeed() *event.Feed {
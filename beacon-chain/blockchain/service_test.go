@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
@@ -28,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -61,6 +63,14 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return nil
 }
 
+func (ms *mockOperationService) IncomingProposerSlashingFeed() *event.Feed {
+	return nil
+}
+
+func (ms *mockOperationService) IncomingAttesterSlashingFeed() *event.Feed {
+	return nil
+}
+
 type mockClient struct{}
 
 func (m *mockClient) SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error) {
@@ -332,6 +342,114 @@ func TestChainStartStop_Initialized(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Beacon chain data already exists, starting service")
 }
 
+func TestChainStartStop_GenesisState(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	chainService := setupBeaconChain(t, db, nil)
+	genesisState, err := state.InteropGenesisState(8, uint64(time.Now().Unix()))
+	if err != nil {
+		t.Fatalf("Could not build interop genesis state: %v", err)
+	}
+	chainService.genesisState = genesisState
+	chainService.Start()
+
+	beaconState, err := db.HeadState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconState == nil || len(beaconState.ValidatorRegistry) != len(genesisState.ValidatorRegistry) {
+		t.Fatal("Expected the head state to be the genesis state")
+	}
+	if chainService.genesisTime.Unix() != int64(genesisState.GenesisTime) {
+		t.Errorf("Expected genesis time %d, received %d", genesisState.GenesisTime, chainService.genesisTime.Unix())
+	}
+	finalizedBlock, err := db.FinalizedBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if finalizedBlock == nil || finalizedBlock.Slot != params.BeaconConfig().GenesisSlot {
+		t.Error("Expected the genesis block to be finalized")
+	}
+	if err := chainService.Stop(); err != nil {
+		t.Fatalf("Unable to stop chain service: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Starting the beacon chain from the genesis state")
+	testutil.AssertLogsDoNotContain(t, hook, "Waiting for ChainStart log")
+}
+
+func TestNoPOWChain_ProcessesBlockFromGenesisState(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	numValidators := params.BeaconConfig().SlotsPerEpoch
+	genesisTime := uint64(time.Now().Unix()) - 2*params.BeaconConfig().SecondsPerSlot
+	genesisState, err := state.InteropGenesisState(numValidators, genesisTime)
+	if err != nil {
+		t.Fatalf("Could not build interop genesis state: %v", err)
+	}
+	chainService, err := NewChainService(ctx, &Config{
+		BeaconDB:       db,
+		OpsPoolService: &mockOperationService{},
+		AttsService:    attestation.NewAttestationService(ctx, &attestation.Config{BeaconDB: db}),
+		P2p:            &mockBroadcaster{},
+		GenesisState:   genesisState,
+		NoPOWChain:     true,
+	})
+	if err != nil {
+		t.Fatalf("Unable to setup chain service: %v", err)
+	}
+	chainService.Start()
+
+	beaconState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parentRoot, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := keystore.InteropKeys(0, numValidators)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKeys := make([]*bls.SecretKey, len(keys))
+	for i, key := range keys {
+		privKeys[i] = key.SecretKey
+	}
+	revealState := proto.Clone(beaconState).(*pb.BeaconState)
+	revealState.Slot++
+	block := &pb.BeaconBlock{
+		Slot:             revealState.Slot,
+		ParentRootHash32: parentRoot[:],
+		RandaoReveal:     createRandaoReveal(t, revealState, privKeys),
+		Eth1Data:         beaconState.LatestEth1Data,
+		Body:             &pb.BeaconBlockBody{},
+	}
+	initBlockStateRoot(t, block, chainService)
+
+	if err := chainService.VerifyBlockValidity(ctx, block, beaconState); err != nil {
+		t.Fatalf("Expected the block to be valid without a POW chain: %v", err)
+	}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chainService.ReceiveBlock(ctx, block); err != nil {
+		t.Fatalf("Block failed processing: %v", err)
+	}
+	if err := chainService.Stop(); err != nil {
+		t.Fatalf("Unable to stop chain service: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Finished processing beacon block")
+}
+
 func TestRecentCanonicalRoots_CanFilter(t *testing.T) {
 	service := setupBeaconChain(t, nil, nil)
 	blks := map[uint64][]byte{
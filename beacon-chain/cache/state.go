@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var (
+	// maxStateCacheSize is the epoch length, so that the post-states of the blocks
+	// of recent forks are kept in memory.
+	maxStateCacheSize = int(params.BeaconConfig().SlotsPerEpoch)
+
+	// Metrics
+	stateCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "state_cache_miss",
+		Help: "The number of post-state requests that aren't present in the cache.",
+	})
+	stateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "state_cache_hit",
+		Help: "The number of post-state requests that are present in the cache.",
+	})
+	stateCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "state_cache_size",
+		Help: "The number of post-states in the state cache",
+	})
+)
+
+// StateCache is a least recently used cache of the beacon states resulting from
+// processing recent blocks, keyed by block root. States are copied when added and
+// retrieved, so that callers can modify them without corrupting the cache.
+type StateCache struct {
+	stateCache *lru.Cache
+}
+
+// NewStateCache creates a new state cache for storing/accessing post-states from
+// memory.
+func NewStateCache() *StateCache {
+	// #nosec G104 lru.New only returns an error for a non-positive size.
+	stateCache, _ := lru.New(maxStateCacheSize)
+	return &StateCache{
+		stateCache: stateCache,
+	}
+}
+
+// StateByRoot fetches a copy of the post-state of the block with the given root.
+// Returns nil if the state is not in the cache.
+func (s *StateCache) StateByRoot(blockRoot [32]byte) *pb.BeaconState {
+	obj, exists := s.stateCache.Get(blockRoot)
+	if !exists {
+		stateCacheMiss.Inc()
+		return nil
+	}
+	stateCacheHit.Inc()
+	return proto.Clone(obj.(*pb.BeaconState)).(*pb.BeaconState)
+}
+
+// AddState adds a copy of the post-state of the block with the given root to the
+// cache, evicting the least recently used state if the cache is full.
+func (s *StateCache) AddState(blockRoot [32]byte, beaconState *pb.BeaconState) {
+	s.stateCache.Add(blockRoot, proto.Clone(beaconState).(*pb.BeaconState))
+	stateCacheSize.Set(float64(s.stateCache.Len()))
+}
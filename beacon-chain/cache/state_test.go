@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestStateCache_StateByRoot(t *testing.T) {
+	cache := NewStateCache()
+
+	root := [32]byte{'A'}
+	if s := cache.StateByRoot(root); s != nil {
+		t.Errorf("Expected state not to exist in empty cache, received %v", s)
+	}
+
+	beaconState := &pb.BeaconState{Slot: 10}
+	cache.AddState(root, beaconState)
+	s := cache.StateByRoot(root)
+	if !proto.Equal(s, beaconState) {
+		t.Errorf("Expected state %v, received %v", beaconState, s)
+	}
+}
+
+func TestStateCache_CopiesStates(t *testing.T) {
+	cache := NewStateCache()
+
+	root := [32]byte{'A'}
+	beaconState := &pb.BeaconState{Slot: 10}
+	cache.AddState(root, beaconState)
+	beaconState.Slot = 11
+
+	s := cache.StateByRoot(root)
+	if s.Slot != 10 {
+		t.Errorf("Expected state added to the cache not to be modified, received slot %d", s.Slot)
+	}
+	s.Slot = 12
+	if s := cache.StateByRoot(root); s.Slot != 10 {
+		t.Errorf("Expected state retrieved from the cache not to be modified, received slot %d", s.Slot)
+	}
+}
+
+func TestStateCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewStateCache()
+
+	for i := 0; i < maxStateCacheSize; i++ {
+		cache.AddState([32]byte{byte(i)}, &pb.BeaconState{Slot: uint64(i)})
+	}
+	// Using the first state makes the second one the least recently used.
+	if s := cache.StateByRoot([32]byte{0}); s == nil {
+		t.Fatal("Expected state to be in the cache")
+	}
+	cache.AddState([32]byte{byte(maxStateCacheSize)}, &pb.BeaconState{})
+
+	if s := cache.StateByRoot([32]byte{1}); s != nil {
+		t.Error("Expected least recently used state to be evicted")
+	}
+	if s := cache.StateByRoot([32]byte{0}); s == nil {
+		t.Error("Expected recently used state to remain in the cache")
+	}
+}
@@ -186,6 +186,27 @@ func ProcessProposerSlashings(
 	return beaconState, nil
 }
 
+// VerifyProposerSlashing checks that a proposer slashing can be applied to the given
+// beacon state, which means its proposal data is slashable and the proposer
+// has not been slashed yet.
+func VerifyProposerSlashing(
+	beaconState *pb.BeaconState,
+	slashing *pb.ProposerSlashing,
+	verifySignatures bool,
+) error {
+	if slashing.ProposerIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return fmt.Errorf("proposer index %d does not exist in the registry", slashing.ProposerIndex)
+	}
+	if err := verifyProposerSlashing(slashing, verifySignatures); err != nil {
+		return err
+	}
+	proposer := beaconState.ValidatorRegistry[slashing.ProposerIndex]
+	if proposer.SlashedEpoch <= helpers.CurrentEpoch(beaconState) {
+		return fmt.Errorf("proposer index %d has already been slashed", slashing.ProposerIndex)
+	}
+	return nil
+}
+
 func verifyProposerSlashing(
 	slashing *pb.ProposerSlashing,
 	verifySignatures bool,
@@ -210,8 +231,8 @@ func verifyProposerSlashing(
 	if shard1 != shard2 {
 		return fmt.Errorf("slashing proposal data shards do not match: %d, %d", shard1, shard2)
 	}
-	if !bytes.Equal(root1, root2) {
-		return fmt.Errorf("slashing proposal data block roots do not match: %#x, %#x", root1, root2)
+	if bytes.Equal(root1, root2) {
+		return fmt.Errorf("slashing proposal data block roots should not match: %#x", root1)
 	}
 	if verifySignatures {
 		// TODO(#258): Verify BLS according to the specification in the "Proposer Slashings"
@@ -273,6 +294,25 @@ func ProcessAttesterSlashings(
 	return beaconState, nil
 }
 
+// VerifyAttesterSlashing checks that an attester slashing can be applied to the given
+// beacon state, which means its slashable attestations are a double vote or a
+// surround vote and at least one of the validators in both of them has not been
+// slashed yet.
+func VerifyAttesterSlashing(beaconState *pb.BeaconState, slashing *pb.AttesterSlashing, verifySignatures bool) error {
+	for _, att := range []*pb.SlashableAttestation{slashing.SlashableAttestation_1, slashing.SlashableAttestation_2} {
+		for _, idx := range att.ValidatorIndices {
+			if idx >= uint64(len(beaconState.ValidatorRegistry)) {
+				return fmt.Errorf("validator index %d does not exist in the registry", idx)
+			}
+		}
+	}
+	if err := verifyAttesterSlashing(slashing, verifySignatures); err != nil {
+		return err
+	}
+	_, err := attesterSlashableIndices(beaconState, slashing)
+	return err
+}
+
 func verifyAttesterSlashing(slashing *pb.AttesterSlashing, verifySignatures bool) error {
 	slashableAttestation1 := slashing.SlashableAttestation_1
 	slashableAttestation2 := slashing.SlashableAttestation_2
@@ -328,9 +368,11 @@ func attesterSlashableIndices(beaconState *pb.BeaconState, slashing *pb.Attester
 }
 
 func verifySlashableAttestation(att *pb.SlashableAttestation, verifySignatures bool) error {
+	// Custody bits are not used in phase 0, so the custody bitfield of a
+	// slashable attestation must be all 0s.
 	emptyCustody := make([]byte, len(att.CustodyBitfield))
-	if bytes.Equal(att.CustodyBitfield, emptyCustody) {
-		return errors.New("custody bit field can't all be 0s")
+	if !bytes.Equal(att.CustodyBitfield, emptyCustody) {
+		return errors.New("custody bit field must be all 0s in phase 0")
 	}
 	if len(att.ValidatorIndices) == 0 {
 		return errors.New("empty validator indices")
@@ -676,7 +718,7 @@ func ProcessValidatorExits(
 
 	validatorRegistry := beaconState.ValidatorRegistry
 	for idx, exit := range exits {
-		if err := verifyExit(beaconState, exit, verifySignatures); err != nil {
+		if err := VerifyExit(beaconState, exit, verifySignatures); err != nil {
 			return nil, fmt.Errorf("could not verify exit #%d: %v", idx, err)
 		}
 		beaconState = v.InitiateValidatorExit(beaconState, exit.ValidatorIndex)
@@ -685,7 +727,11 @@ func ProcessValidatorExits(
 	return beaconState, nil
 }
 
-func verifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySignatures bool) error {
+// VerifyExit checks that a voluntary exit can be applied to the given beacon state.
+func VerifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySignatures bool) error {
+	if exit.ValidatorIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return fmt.Errorf("validator index %d does not exist in the registry", exit.ValidatorIndex)
+	}
 	validator := beaconState.ValidatorRegistry[exit.ValidatorIndex]
 	currentEpoch := helpers.CurrentEpoch(beaconState)
 	entryExitEffectEpoch := helpers.EntryExitEffectEpoch(currentEpoch)
@@ -296,7 +296,7 @@ func TestProcessProposerSlashings_UnmatchedShards(t *testing.T) {
 	}
 }
 
-func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
+func TestProcessProposerSlashings_MatchingBlockRoots(t *testing.T) {
 	registry := []*pb.Validator{}
 	currentSlot := uint64(0)
 	slashings := []*pb.ProposerSlashing{
@@ -310,7 +310,7 @@ func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           0,
-				BlockRootHash32: []byte{1, 1, 0},
+				BlockRootHash32: []byte{0, 1, 0},
 			},
 		},
 	}
@@ -325,8 +325,8 @@ func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
 		},
 	}
 	want := fmt.Sprintf(
-		"slashing proposal data block roots do not match: %#x, %#x",
-		[]byte{0, 1, 0}, []byte{1, 1, 0},
+		"slashing proposal data block roots should not match: %#x",
+		[]byte{0, 1, 0},
 	)
 
 	if _, err := blocks.ProcessProposerSlashings(
@@ -366,7 +366,7 @@ func TestProcessProposerSlashings_AppliesCorrectStatus(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            params.BeaconConfig().GenesisSlot + 1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -428,7 +428,7 @@ func TestProcessAttesterSlashings_ThresholdReached(t *testing.T) {
 	}
 }
 
-func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
+func TestProcessAttesterSlashings_NonEmptyCustodyFields(t *testing.T) {
 	slashings := []*pb.AttesterSlashing{
 		{
 			SlashableAttestation_1: &pb.SlashableAttestation{
@@ -436,20 +436,16 @@ func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
 					Slot:  5,
 					Shard: 4,
 				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
+				ValidatorIndices: []uint64{1},
+				CustodyBitfield:  []byte{0xFF},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data: &pb.AttestationData{
 					Slot:  5,
 					Shard: 3,
 				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
+				ValidatorIndices: []uint64{1},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -465,7 +461,7 @@ func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
 			AttesterSlashings: slashings,
 		},
 	}
-	want := fmt.Sprint("custody bit field can't all be 0")
+	want := fmt.Sprint("custody bit field must be all 0s")
 
 	if _, err := blocks.ProcessAttesterSlashings(
 		beaconState,
@@ -475,7 +471,7 @@ func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
 		t.Errorf("Expected %s, received %v", want, err)
 	}
 
-	// Perform the same check for SlashableVoteData_2.
+	// Perform the same check for SlashableAttestation_2.
 	slashings = []*pb.AttesterSlashing{
 		{
 			SlashableAttestation_1: &pb.SlashableAttestation{
@@ -483,20 +479,16 @@ func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
 					Slot:  5,
 					Shard: 4,
 				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
+				ValidatorIndices: []uint64{1},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data: &pb.AttestationData{
 					Slot:  5,
 					Shard: 3,
 				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
+				ValidatorIndices: []uint64{1},
+				CustodyBitfield:  []byte{0xFF},
 			},
 		},
 	}
@@ -527,12 +519,12 @@ func TestProcessAttesterSlashings_UnmatchedAttestations(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{2},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -577,12 +569,12 @@ func TestProcessAttesterSlashings_EmptyVoteIndexIntersection(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{9, 10, 11, 12, 13, 14, 15, 16},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -638,12 +630,12 @@ func TestProcessAttesterSlashings_AppliesCorrectStatus(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -684,6 +676,73 @@ func TestProcessAttesterSlashings_AppliesCorrectStatus(t *testing.T) {
 	}
 }
 
+func TestVerifyProposerSlashing(t *testing.T) {
+	validators := []*pb.Validator{
+		{SlashedEpoch: params.BeaconConfig().FarFutureEpoch},
+		{SlashedEpoch: params.BeaconConfig().GenesisEpoch},
+	}
+	beaconState := &pb.BeaconState{
+		ValidatorRegistry: validators,
+		Slot:              params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch,
+	}
+	slashing := func(proposerIdx uint64) *pb.ProposerSlashing {
+		return &pb.ProposerSlashing{
+			ProposerIndex:  proposerIdx,
+			ProposalData_1: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{'A'}},
+			ProposalData_2: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{'B'}},
+		}
+	}
+
+	if err := blocks.VerifyProposerSlashing(beaconState, slashing(0), false); err != nil {
+		t.Errorf("Expected proposer slashing to verify, received %v", err)
+	}
+	want := "proposer index 1 has already been slashed"
+	if err := blocks.VerifyProposerSlashing(beaconState, slashing(1), false); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	want = "proposer index 2 does not exist"
+	if err := blocks.VerifyProposerSlashing(beaconState, slashing(2), false); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestVerifyAttesterSlashing(t *testing.T) {
+	validators := []*pb.Validator{
+		{SlashedEpoch: params.BeaconConfig().FarFutureEpoch},
+		{SlashedEpoch: params.BeaconConfig().GenesisEpoch},
+	}
+	beaconState := &pb.BeaconState{
+		ValidatorRegistry: validators,
+		Slot:              params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch,
+	}
+	slashing := func(indices []uint64) *pb.AttesterSlashing {
+		return &pb.AttesterSlashing{
+			SlashableAttestation_1: &pb.SlashableAttestation{
+				Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: 1},
+				ValidatorIndices: indices,
+				CustodyBitfield:  []byte{0x00},
+			},
+			SlashableAttestation_2: &pb.SlashableAttestation{
+				Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: 0},
+				ValidatorIndices: indices,
+				CustodyBitfield:  []byte{0x00},
+			},
+		}
+	}
+
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing([]uint64{0, 1}), false); err != nil {
+		t.Errorf("Expected attester slashing to verify, received %v", err)
+	}
+	want := "expected a non-empty list of slashable indices"
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing([]uint64{1}), false); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	want = "validator index 2 does not exist"
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing([]uint64{0, 2}), false); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
 func TestProcessBlockAttestations_ThresholdReached(t *testing.T) {
 	attestations := make([]*pb.Attestation, params.BeaconConfig().MaxAttestations+1)
 	block := &pb.BeaconBlock{
@@ -1678,3 +1737,16 @@ func TestProcessValidatorExits_AppliesCorrectStatus(t *testing.T) {
 		t.Error("Expected validator status to change, remained INITIAL")
 	}
 }
+
+func TestVerifyExit_InvalidValidatorIndex(t *testing.T) {
+	beaconState := &pb.BeaconState{
+		ValidatorRegistry: []*pb.Validator{{ExitEpoch: params.BeaconConfig().FarFutureEpoch}},
+		Slot:              params.BeaconConfig().GenesisSlot,
+	}
+	exit := &pb.VoluntaryExit{ValidatorIndex: 1, Epoch: params.BeaconConfig().GenesisEpoch}
+
+	want := "validator index 1 does not exist"
+	if err := blocks.VerifyExit(beaconState, exit, false); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
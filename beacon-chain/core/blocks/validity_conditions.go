@@ -41,17 +41,19 @@ func IsValidBlock(
 		return fmt.Errorf("unprocessed parent block as it is not saved in the db: %#x", parentRoot)
 	}
 
-	h := common.BytesToHash(state.LatestEth1Data.BlockHash32)
-	powBlock, err := GetPOWBlock(ctx, h)
-	if err != nil {
-		return fmt.Errorf("unable to retrieve POW chain reference block: %v", err)
-	}
-
 	// Pre-Processing Condition 2:
 	// The block pointed to by the state in state.processed_pow_receipt_root has
-	// been processed in the ETH 1.0 chain.
-	if powBlock == nil {
-		return fmt.Errorf("proof-of-Work chain reference in state does not exist: %#x", state.LatestEth1Data.BlockHash32)
+	// been processed in the ETH 1.0 chain. It is not checked by nodes running
+	// without an ETH 1.0 chain, which pass no GetPOWBlock.
+	if GetPOWBlock != nil {
+		h := common.BytesToHash(state.LatestEth1Data.BlockHash32)
+		powBlock, err := GetPOWBlock(ctx, h)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve POW chain reference block: %v", err)
+		}
+		if powBlock == nil {
+			return fmt.Errorf("proof-of-Work chain reference in state does not exist: %#x", state.LatestEth1Data.BlockHash32)
+		}
 	}
 
 	// Pre-Processing Condition 4:
@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// AggregateAttestation merges two attestations for the same attestation data into
+// one attestation carrying the participation bits and signatures of both. The
+// attestations must not share any participant, as the aggregate signature would
+// otherwise count that participant's signature twice.
+func AggregateAttestation(a1 *pb.Attestation, a2 *pb.Attestation) (*pb.Attestation, error) {
+	if !proto.Equal(a1.Data, a2.Data) {
+		return nil, fmt.Errorf("attestation data does not match: %v, %v", a1.Data, a2.Data)
+	}
+	if bitutil.BitfieldsOverlap(a1.AggregationBitfield, a2.AggregationBitfield) {
+		return nil, fmt.Errorf("aggregation bitfields overlap: %#x, %#x",
+			a1.AggregationBitfield, a2.AggregationBitfield)
+	}
+	aggregationBitfield, err := bitutil.OrBitfields(a1.AggregationBitfield, a2.AggregationBitfield)
+	if err != nil {
+		return nil, fmt.Errorf("could not aggregate participation bitfields: %v", err)
+	}
+	custodyBitfield, err := bitutil.OrBitfields(a1.CustodyBitfield, a2.CustodyBitfield)
+	if err != nil {
+		return nil, fmt.Errorf("could not aggregate custody bitfields: %v", err)
+	}
+	aggregateSig, err := aggregateSignature(a1.AggregateSignature, a2.AggregateSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Attestation{
+		AggregationBitfield: aggregationBitfield,
+		Data:                a1.Data,
+		CustodyBitfield:     custodyBitfield,
+		AggregateSignature:  aggregateSig,
+	}, nil
+}
+
+// aggregateSignature aggregates two BLS signatures. Validators do not sign their
+// attestations yet and send the same placeholder instead, which is kept as is, so
+// that the bitfields of unsigned attestations can still be merged.
+// TODO(#1366): Remove the placeholder once attestations are signed.
+func aggregateSignature(sig1 []byte, sig2 []byte) ([]byte, error) {
+	s1, err1 := bls.SignatureFromBytes(sig1)
+	s2, err2 := bls.SignatureFromBytes(sig2)
+	if err1 != nil && err2 != nil && bytes.Equal(sig1, sig2) {
+		return sig1, nil
+	}
+	if err1 != nil {
+		return nil, fmt.Errorf("could not deserialize signature: %v", err1)
+	}
+	if err2 != nil {
+		return nil, fmt.Errorf("could not deserialize signature: %v", err2)
+	}
+	return bls.AggregateSignatures([]*bls.Signature{s1, s2}).Marshal(), nil
+}
@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+func TestAggregateAttestation_OK(t *testing.T) {
+	data := &pb.AttestationData{Slot: 5, Shard: 1}
+	msg := []byte("attestation")
+	var sigs []*bls.Signature
+	var atts []*pb.Attestation
+	for i, bitfield := range [][]byte{{0x80}, {0x01}} {
+		priv, err := bls.RandKey(strings.NewReader(strings.Repeat(string(rune('a'+i)), 64)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig := priv.Sign(msg, 0)
+		sigs = append(sigs, sig)
+		atts = append(atts, &pb.Attestation{
+			AggregationBitfield: bitfield,
+			Data:                data,
+			CustodyBitfield:     []byte{0x00},
+			AggregateSignature:  sig.Marshal(),
+		})
+	}
+
+	aggregated, err := AggregateAttestation(atts[0], atts[1])
+	if err != nil {
+		t.Fatalf("Could not aggregate attestations: %v", err)
+	}
+	if !bytes.Equal(aggregated.AggregationBitfield, []byte{0x81}) {
+		t.Errorf("Expected aggregation bitfield %#x, received %#x", []byte{0x81}, aggregated.AggregationBitfield)
+	}
+	wantSig := bls.AggregateSignatures(sigs).Marshal()
+	if !bytes.Equal(aggregated.AggregateSignature, wantSig) {
+		t.Errorf("Expected aggregate signature %#x, received %#x", wantSig, aggregated.AggregateSignature)
+	}
+}
+
+func TestAggregateAttestation_PlaceholderSignatures(t *testing.T) {
+	data := &pb.AttestationData{Slot: 5, Shard: 1}
+	a1 := &pb.Attestation{Data: data, AggregationBitfield: []byte{0x80}, AggregateSignature: []byte("signed")}
+	a2 := &pb.Attestation{Data: data, AggregationBitfield: []byte{0x01}, AggregateSignature: []byte("signed")}
+
+	aggregated, err := AggregateAttestation(a1, a2)
+	if err != nil {
+		t.Fatalf("Could not aggregate attestations: %v", err)
+	}
+	if !bytes.Equal(aggregated.AggregationBitfield, []byte{0x81}) {
+		t.Errorf("Expected aggregation bitfield %#x, received %#x", []byte{0x81}, aggregated.AggregationBitfield)
+	}
+	if !bytes.Equal(aggregated.AggregateSignature, []byte("signed")) {
+		t.Errorf("Expected the placeholder signature to be kept, received %#x", aggregated.AggregateSignature)
+	}
+
+	// A placeholder cannot be aggregated with a real signature.
+	priv, err := bls.RandKey(strings.NewReader(strings.Repeat("a", 64)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a2.AggregateSignature = priv.Sign([]byte("attestation"), 0).Marshal()
+	want := "could not deserialize signature"
+	if _, err := AggregateAttestation(a1, a2); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestAggregateAttestation_OverlappingBitfields(t *testing.T) {
+	data := &pb.AttestationData{Slot: 5}
+	a1 := &pb.Attestation{Data: data, AggregationBitfield: []byte{0x81}, CustodyBitfield: []byte{0x00}}
+	a2 := &pb.Attestation{Data: data, AggregationBitfield: []byte{0x01}, CustodyBitfield: []byte{0x00}}
+
+	want := "aggregation bitfields overlap"
+	if _, err := AggregateAttestation(a1, a2); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestAggregateAttestation_DifferentData(t *testing.T) {
+	a1 := &pb.Attestation{Data: &pb.AttestationData{Slot: 5}, AggregationBitfield: []byte{0x80}}
+	a2 := &pb.Attestation{Data: &pb.AttestationData{Slot: 6}, AggregationBitfield: []byte{0x01}}
+
+	want := "attestation data does not match"
+	if _, err := AggregateAttestation(a1, a2); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
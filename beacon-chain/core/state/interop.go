@@ -0,0 +1,71 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// InteropDeposits builds a deposit of the maximum deposit amount for each of the
+// keys, as if they were made to the deposit contract in order, along with the
+// eth1 data of the deposit trie of these deposits. Each deposit carries its Merkle
+// proof against the deposit root of the eth1 data.
+func InteropDeposits(keys []*keystore.Key, genesisTime uint64) ([]*pb.Deposit, *pb.Eth1Data, error) {
+	depositData := make([][]byte, len(keys))
+	for i, key := range keys {
+		depositInput, err := keystore.DepositInput(key, key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not generate deposit input: %v", err)
+		}
+		depositData[i], err = helpers.EncodeDepositData(
+			depositInput,
+			params.BeaconConfig().MaxDepositAmount,
+			int64(genesisTime),
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not encode deposit data: %v", err)
+		}
+	}
+
+	depositTrie, err := trieutil.GenerateTrieFromItems(depositData, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not generate deposit trie: %v", err)
+	}
+	deposits := make([]*pb.Deposit, len(keys))
+	for i := range deposits {
+		proof, err := depositTrie.MerkleProof(i)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not generate merkle proof for deposit %d: %v", i, err)
+		}
+		deposits[i] = &pb.Deposit{
+			MerkleProofHash32S: proof,
+			MerkleTreeIndex:    uint64(i),
+			DepositData:        depositData[i],
+		}
+	}
+	depositRoot := depositTrie.Root()
+	eth1Data := &pb.Eth1Data{
+		DepositRootHash32: depositRoot[:],
+		BlockHash32:       params.BeaconConfig().ZeroHash[:],
+	}
+	return deposits, eth1Data, nil
+}
+
+// InteropGenesisState builds the genesis state of a chain of validatorCount interop
+// validators, whose keys are derived from their index by keystore.InteropKeys,
+// without any deposit contract.
+func InteropGenesisState(validatorCount uint64, genesisTime uint64) (*pb.BeaconState, error) {
+	keys, err := keystore.InteropKeys(0, validatorCount)
+	if err != nil {
+		return nil, err
+	}
+	deposits, eth1Data, err := InteropDeposits(keys, genesisTime)
+	if err != nil {
+		return nil, err
+	}
+	return GenesisBeaconState(deposits, genesisTime, eth1Data)
+}
@@ -0,0 +1,64 @@
+package state_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestInteropDeposits_VerifyAgainstDepositRoot(t *testing.T) {
+	keys, err := keystore.InteropKeys(0, 4)
+	if err != nil {
+		t.Fatalf("Could not derive interop keys: %v", err)
+	}
+	deposits, eth1Data, err := state.InteropDeposits(keys, 1000)
+	if err != nil {
+		t.Fatalf("Could not build interop deposits: %v", err)
+	}
+	for i, deposit := range deposits {
+		if deposit.MerkleTreeIndex != uint64(i) {
+			t.Errorf("Expected deposit %d to have merkle tree index %d, received %d", i, i, deposit.MerkleTreeIndex)
+		}
+		if !trieutil.VerifyMerkleProof(eth1Data.DepositRootHash32, deposit.DepositData, i, deposit.MerkleProofHash32S) {
+			t.Errorf("Expected the merkle proof of deposit %d to verify against the deposit root", i)
+		}
+		depositInput, err := helpers.DecodeDepositInput(deposit.DepositData)
+		if err != nil {
+			t.Fatalf("Could not decode deposit input: %v", err)
+		}
+		if !bytes.Equal(depositInput.Pubkey, keys[i].PublicKey.Marshal()) {
+			t.Errorf("Expected deposit %d to be made with the key of validator %d", i, i)
+		}
+	}
+}
+
+func TestInteropGenesisState_ActivatesInteropValidators(t *testing.T) {
+	genesisTime := uint64(1000)
+	beaconState, err := state.InteropGenesisState(4, genesisTime)
+	if err != nil {
+		t.Fatalf("Could not build interop genesis state: %v", err)
+	}
+	if beaconState.GenesisTime != genesisTime {
+		t.Errorf("Expected genesis time %d, received %d", genesisTime, beaconState.GenesisTime)
+	}
+	keys, err := keystore.InteropKeys(0, 4)
+	if err != nil {
+		t.Fatalf("Could not derive interop keys: %v", err)
+	}
+	if len(beaconState.ValidatorRegistry) != len(keys) {
+		t.Fatalf("Expected %d validators, received %d", len(keys), len(beaconState.ValidatorRegistry))
+	}
+	for i, validator := range beaconState.ValidatorRegistry {
+		if !bytes.Equal(validator.Pubkey, keys[i].PublicKey.Marshal()) {
+			t.Errorf("Expected validator %d to have the interop key of index %d", i, i)
+		}
+		if validator.ActivationEpoch != params.BeaconConfig().GenesisEpoch {
+			t.Errorf("Expected validator %d to be active at genesis, activation epoch %d", i, validator.ActivationEpoch)
+		}
+	}
+}
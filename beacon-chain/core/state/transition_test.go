@@ -123,7 +123,7 @@ func TestProcessBlock_IncorrectAttesterSlashing(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -168,7 +168,7 @@ func TestProcessBlock_IncorrectProcessBlockAttestations(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -185,12 +185,12 @@ func TestProcessBlock_IncorrectProcessBlockAttestations(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -237,7 +237,7 @@ func TestProcessBlock_IncorrectProcessExits(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            params.BeaconConfig().GenesisSlot + 1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -254,12 +254,12 @@ func TestProcessBlock_IncorrectProcessExits(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -330,7 +330,7 @@ func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -347,12 +347,12 @@ func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
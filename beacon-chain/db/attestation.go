@@ -24,8 +24,22 @@ func (db *BeaconDB) SaveAttestation(ctx context.Context, attestation *pb.Attesta
 
 	return db.update(func(tx *bolt.Tx) error {
 		a := tx.Bucket(attestationBucket)
+		if err := a.Put(hash[:], encodedAtt); err != nil {
+			return err
+		}
 
-		return a.Put(hash[:], encodedAtt)
+		if attestation.Data == nil {
+			return nil
+		}
+		dataRoot, err := hashutil.HashProto(attestation.Data)
+		if err != nil {
+			return err
+		}
+		d, err := tx.Bucket(attestationDataBucket).CreateBucketIfNotExists(dataRoot[:])
+		if err != nil {
+			return err
+		}
+		return d.Put(hash[:], []byte{})
 	})
 }
 
@@ -55,8 +69,29 @@ func (db *BeaconDB) DeleteAttestation(attestation *pb.Attestation) error {
 
 	return db.update(func(tx *bolt.Tx) error {
 		a := tx.Bucket(attestationBucket)
+		if err := a.Delete(hash[:]); err != nil {
+			return err
+		}
 
-		return a.Delete(hash[:])
+		if attestation.Data == nil {
+			return nil
+		}
+		dataRoot, err := hashutil.HashProto(attestation.Data)
+		if err != nil {
+			return err
+		}
+		dataBucket := tx.Bucket(attestationDataBucket)
+		d := dataBucket.Bucket(dataRoot[:])
+		if d == nil {
+			return nil
+		}
+		if err := d.Delete(hash[:]); err != nil {
+			return err
+		}
+		if k, _ := d.Cursor().First(); k == nil {
+			return dataBucket.DeleteBucket(dataRoot[:])
+		}
+		return nil
 	})
 }
 
@@ -102,6 +137,34 @@ func (db *BeaconDB) Attestations() ([]*pb.Attestation, error) {
 	return attestations, err
 }
 
+// AttestationsByDataRoot retrieves the attestation records whose attestation data
+// has the given hash.
+func (db *BeaconDB) AttestationsByDataRoot(dataRoot [32]byte) ([]*pb.Attestation, error) {
+	var attestations []*pb.Attestation
+	err := db.view(func(tx *bolt.Tx) error {
+		d := tx.Bucket(attestationDataBucket).Bucket(dataRoot[:])
+		if d == nil {
+			return nil
+		}
+		a := tx.Bucket(attestationBucket)
+
+		return d.ForEach(func(k, _ []byte) error {
+			enc := a.Get(k)
+			if enc == nil {
+				return nil
+			}
+			attestation, err := createAttestation(enc)
+			if err != nil {
+				return err
+			}
+			attestations = append(attestations, attestation)
+			return nil
+		})
+	})
+
+	return attestations, err
+}
+
 // AttestationTarget retrieves an attestation target record from the db using its hash.
 func (db *BeaconDB) AttestationTarget(hash [32]byte) (*pb.AttestationTarget, error) {
 	var attTgt *pb.AttestationTarget
@@ -118,6 +118,50 @@ func TestDeleteAttestation_OK(t *testing.T) {
 	}
 }
 
+func TestAttestationsByDataRoot_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	data1 := &pb.AttestationData{Slot: 1}
+	data2 := &pb.AttestationData{Slot: 2}
+	att1 := &pb.Attestation{Data: data1, AggregationBitfield: []byte{0x80}}
+	att2 := &pb.Attestation{Data: data1, AggregationBitfield: []byte{0x40}}
+	att3 := &pb.Attestation{Data: data2, AggregationBitfield: []byte{0x80}}
+	for _, att := range []*pb.Attestation{att1, att2, att3} {
+		if err := db.SaveAttestation(context.Background(), att); err != nil {
+			t.Fatalf("Could not save attestation: %v", err)
+		}
+	}
+
+	dataRoot, err := hashutil.HashProto(data1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	atts, err := db.AttestationsByDataRoot(dataRoot)
+	if err != nil {
+		t.Fatalf("Could not retrieve attestations: %v", err)
+	}
+	if len(atts) != 2 {
+		t.Fatalf("Expected 2 attestations with the data, received %d", len(atts))
+	}
+	for _, att := range atts {
+		if !proto.Equal(att.Data, data1) {
+			t.Errorf("Expected attestation data %v, received %v", data1, att.Data)
+		}
+	}
+
+	if err := db.DeleteAttestation(att1); err != nil {
+		t.Fatalf("Could not delete attestation: %v", err)
+	}
+	atts, err = db.AttestationsByDataRoot(dataRoot)
+	if err != nil {
+		t.Fatalf("Could not retrieve attestations: %v", err)
+	}
+	if len(atts) != 1 || !proto.Equal(atts[0], att2) {
+		t.Errorf("Expected only the remaining attestation, received %v", atts)
+	}
+}
+
 func TestNilAttestation_OK(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
@@ -192,6 +192,37 @@ func (db *BeaconDB) FinalizedBlock() (*pb.BeaconBlock, error) {
 	return block, err
 }
 
+// SaveBackfillBlock saves the lowest block of the contiguous chain of blocks stored
+// below the checkpoint the node was started from.
+func (db *BeaconDB) SaveBackfillBlock(block *pb.BeaconBlock) error {
+	return db.update(func(tx *bolt.Tx) error {
+		enc, err := proto.Marshal(block)
+		if err != nil {
+			return fmt.Errorf("failed to encode block: %v", err)
+		}
+		chainInfo := tx.Bucket(chainInfoBucket)
+		return chainInfo.Put(backfillBlockLookupKey, enc)
+	})
+}
+
+// BackfillBlock retrieves the lowest block backfilled below the checkpoint the node
+// was started from. Returns nil if the node was not started from a checkpoint.
+func (db *BeaconDB) BackfillBlock() (*pb.BeaconBlock, error) {
+	var block *pb.BeaconBlock
+	err := db.view(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		encBlock := chainInfo.Get(backfillBlockLookupKey)
+		if encBlock == nil {
+			return nil
+		}
+
+		var err error
+		block, err = createBlock(encBlock)
+		return err
+	})
+	return block, err
+}
+
 // ChainHead returns the head of the main chain.
 func (db *BeaconDB) ChainHead() (*pb.BeaconBlock, error) {
 	var block *pb.BeaconBlock
@@ -31,3 +31,153 @@ func TestBeaconDB_HasExit(t *testing.T) {
 		t.Fatal("Expected HasExit to return true")
 	}
 }
+
+func TestBeaconDB_HasProposerSlashing(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	slashing := &pb.ProposerSlashing{
+		ProposerIndex:  5,
+		ProposalData_1: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{'A'}},
+		ProposalData_2: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{'B'}},
+	}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatalf("could not hash proposer slashing: %v", err)
+	}
+
+	if db.HasProposerSlashing(hash) {
+		t.Fatal("Expected HasProposerSlashing to return false")
+	}
+
+	if err := db.SaveProposerSlashing(context.Background(), slashing); err != nil {
+		t.Fatalf("Failed to save proposer slashing: %v", err)
+	}
+	if !db.HasProposerSlashing(hash) {
+		t.Fatal("Expected HasProposerSlashing to return true")
+	}
+}
+
+func TestBeaconDB_HasAttesterSlashing(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	slashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{
+			ValidatorIndices: []uint64{1, 2},
+			Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: 1},
+		},
+		SlashableAttestation_2: &pb.SlashableAttestation{
+			ValidatorIndices: []uint64{2, 3},
+			Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: 0},
+		},
+	}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatalf("could not hash attester slashing: %v", err)
+	}
+
+	if db.HasAttesterSlashing(hash) {
+		t.Fatal("Expected HasAttesterSlashing to return false")
+	}
+
+	if err := db.SaveAttesterSlashing(context.Background(), slashing); err != nil {
+		t.Fatalf("Failed to save attester slashing: %v", err)
+	}
+	if !db.HasAttesterSlashing(hash) {
+		t.Fatal("Expected HasAttesterSlashing to return true")
+	}
+}
+
+func TestBeaconDB_RetrieveAndDeleteExits(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	exits := []*pb.VoluntaryExit{{Epoch: 1, ValidatorIndex: 1}, {Epoch: 2, ValidatorIndex: 2}}
+	for _, exit := range exits {
+		if err := db.SaveExit(context.Background(), exit); err != nil {
+			t.Fatalf("Failed to save exit request: %v", err)
+		}
+	}
+	retrieved, err := db.Exits()
+	if err != nil {
+		t.Fatalf("Could not retrieve exit requests: %v", err)
+	}
+	if len(retrieved) != len(exits) {
+		t.Fatalf("Expected %d exit requests, received %d", len(exits), len(retrieved))
+	}
+
+	if err := db.DeleteExit(exits[0]); err != nil {
+		t.Fatalf("Could not delete exit request: %v", err)
+	}
+	hash, err := hashutil.HashProto(exits[0])
+	if err != nil {
+		t.Fatalf("could not hash exit request: %v", err)
+	}
+	if db.HasExit(hash) {
+		t.Error("Expected exit request to be deleted")
+	}
+}
+
+func TestBeaconDB_RetrieveAndDeleteProposerSlashings(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	slashings := []*pb.ProposerSlashing{{ProposerIndex: 1}, {ProposerIndex: 2}}
+	for _, slashing := range slashings {
+		if err := db.SaveProposerSlashing(context.Background(), slashing); err != nil {
+			t.Fatalf("Failed to save proposer slashing: %v", err)
+		}
+	}
+	retrieved, err := db.ProposerSlashings()
+	if err != nil {
+		t.Fatalf("Could not retrieve proposer slashings: %v", err)
+	}
+	if len(retrieved) != len(slashings) {
+		t.Fatalf("Expected %d proposer slashings, received %d", len(slashings), len(retrieved))
+	}
+
+	if err := db.DeleteProposerSlashing(slashings[0]); err != nil {
+		t.Fatalf("Could not delete proposer slashing: %v", err)
+	}
+	hash, err := hashutil.HashProto(slashings[0])
+	if err != nil {
+		t.Fatalf("could not hash proposer slashing: %v", err)
+	}
+	if db.HasProposerSlashing(hash) {
+		t.Error("Expected proposer slashing to be deleted")
+	}
+}
+
+func TestBeaconDB_RetrieveAndDeleteAttesterSlashings(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	slashings := []*pb.AttesterSlashing{
+		{SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{1}}},
+		{SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{2}}},
+	}
+	for _, slashing := range slashings {
+		if err := db.SaveAttesterSlashing(context.Background(), slashing); err != nil {
+			t.Fatalf("Failed to save attester slashing: %v", err)
+		}
+	}
+	retrieved, err := db.AttesterSlashings()
+	if err != nil {
+		t.Fatalf("Could not retrieve attester slashings: %v", err)
+	}
+	if len(retrieved) != len(slashings) {
+		t.Fatalf("Expected %d attester slashings, received %d", len(slashings), len(retrieved))
+	}
+
+	if err := db.DeleteAttesterSlashing(slashings[0]); err != nil {
+		t.Fatalf("Could not delete attester slashing: %v", err)
+	}
+	hash, err := hashutil.HashProto(slashings[0])
+	if err != nil {
+		t.Fatalf("could not hash attester slashing: %v", err)
+	}
+	if db.HasAttesterSlashing(hash) {
+		t.Error("Expected attester slashing to be deleted")
+	}
+}
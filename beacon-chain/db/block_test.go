@@ -343,6 +343,33 @@ func TestFinalizedBlock_CanSaveRetrieve(t *testing.T) {
 	}
 }
 
+func TestBackfillBlock_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	block, err := db.BackfillBlock()
+	if err != nil {
+		t.Fatalf("could not get backfill block: %v", err)
+	}
+	if block != nil {
+		t.Errorf("Expected no backfill block, received %v", block)
+	}
+
+	block1 := &pb.BeaconBlock{
+		Slot: 22,
+	}
+	if err := db.SaveBackfillBlock(block1); err != nil {
+		t.Fatalf("could not save backfill block: %v", err)
+	}
+	block, err = db.BackfillBlock()
+	if err != nil {
+		t.Fatalf("could not get backfill block: %v", err)
+	}
+	if !proto.Equal(block, block1) {
+		t.Errorf("Expected backfill block %v, received %v", block1, block)
+	}
+}
+
 func TestHasBlock_returnsTrue(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
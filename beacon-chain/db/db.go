@@ -2,6 +2,7 @@ package db
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"sync"
@@ -85,12 +86,19 @@ func NewDB(dirPath string) (*BeaconDB, error) {
 	db := &BeaconDB{db: boltDB, DatabasePath: dirPath}
 
 	if err := db.update(func(tx *bolt.Tx) error {
-		return createBuckets(tx, blockBucket, attestationBucket, attestationTargetBucket, mainChainBucket,
-			histStateBucket, chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket)
+		return createBuckets(tx, blockBucket, attestationBucket, attestationTargetBucket, attestationDataBucket, mainChainBucket,
+			histStateBucket, chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket,
+			proposerSlashingsBucket, attesterSlashingsBucket, proposalHistoryBucket, attestationHistoryBucket,
+			slashableAttestationBucket, depositsBucket, pendingDepositsBucket, chainstartPubkeysBucket,
+			depositTrieBucket, powchainBucket)
 	}); err != nil {
 		return nil, err
 	}
 
+	if err := db.loadDeposits(); err != nil {
+		return nil, fmt.Errorf("could not load deposits: %v", err)
+	}
+
 	return db, err
 }
 
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"sort"
 
+	"github.com/boltdb/bolt"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -35,7 +36,11 @@ func (db *BeaconDB) InsertDeposit(ctx context.Context, d *pb.Deposit, blockNum *
 	}
 	db.depositsLock.Lock()
 	defer db.depositsLock.Unlock()
-	db.deposits = append(db.deposits, &depositContainer{deposit: d, block: blockNum})
+	ctnr := &depositContainer{deposit: d, block: blockNum}
+	if err := db.saveDepositContainer(depositsBucket, ctnr); err != nil {
+		log.Errorf("Could not persist deposit: %v", err)
+	}
+	db.deposits = append(db.deposits, ctnr)
 	historicalDepositsCount.Inc()
 }
 
@@ -45,6 +50,11 @@ func (db *BeaconDB) MarkPubkeyForChainstart(ctx context.Context, pubkey string)
 	defer span.End()
 	db.chainstartPubkeysLock.Lock()
 	defer db.chainstartPubkeysLock.Unlock()
+	if err := db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainstartPubkeysBucket).Put([]byte(pubkey), []byte{1})
+	}); err != nil {
+		log.Errorf("Could not persist chainstart pubkey: %v", err)
+	}
 	db.chainstartPubkeys[pubkey] = true
 }
 
@@ -107,3 +117,50 @@ func (db *BeaconDB) DepositByPubkey(ctx context.Context, pubKey []byte) (*pb.Dep
 	}
 	return deposit, blockNum
 }
+
+// RemoveDepositsAfter removes the deposits and pending deposits which were included
+// in proof of work blocks after the given block number, such as the deposits of
+// blocks orphaned by a reorg of the proof of work chain.
+func (db *BeaconDB) RemoveDepositsAfter(ctx context.Context, blockNum *big.Int) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RemoveDepositsAfter")
+	defer span.End()
+	db.depositsLock.Lock()
+	defer db.depositsLock.Unlock()
+
+	deposits, removedDeposits := splitDepositsAfter(db.deposits, blockNum)
+	pendingDeposits, removedPendingDeposits := splitDepositsAfter(db.pendingDeposits, blockNum)
+	if err := db.update(func(tx *bolt.Tx) error {
+		for _, index := range removedDeposits {
+			if err := tx.Bucket(depositsBucket).Delete(encodeDepositIndex(index)); err != nil {
+				return err
+			}
+		}
+		for _, index := range removedPendingDeposits {
+			if err := tx.Bucket(pendingDepositsBucket).Delete(encodeDepositIndex(index)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	db.deposits = deposits
+	db.pendingDeposits = pendingDeposits
+	pendingDepositsCount.Set(float64(len(db.pendingDeposits)))
+	return nil
+}
+
+// splitDepositsAfter returns the deposit containers included up to the given block
+// number, and the merkle tree indices of the ones included after it.
+func splitDepositsAfter(ctnrs []*depositContainer, blockNum *big.Int) ([]*depositContainer, []uint64) {
+	var kept []*depositContainer
+	var removed []uint64
+	for _, ctnr := range ctnrs {
+		if ctnr.block.Cmp(blockNum) > 0 {
+			removed = append(removed, ctnr.deposit.MerkleTreeIndex)
+			continue
+		}
+		kept = append(kept, ctnr)
+	}
+	return kept, removed
+}
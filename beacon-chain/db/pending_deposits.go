@@ -2,12 +2,17 @@ package db
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
 	"sort"
 
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -26,6 +31,93 @@ type depositContainer struct {
 	block   *big.Int
 }
 
+// encodeDepositContainer serializes a deposit container as the 8 byte block number
+// followed by the protobuf encoded deposit.
+func encodeDepositContainer(ctnr *depositContainer) ([]byte, error) {
+	enc, err := proto.Marshal(ctnr.deposit)
+	if err != nil {
+		return nil, err
+	}
+	return append(bytesutil.Bytes8(ctnr.block.Uint64()), enc...), nil
+}
+
+// decodeDepositContainer is the inverse of encodeDepositContainer.
+func decodeDepositContainer(enc []byte) (*depositContainer, error) {
+	if len(enc) < 8 {
+		return nil, errors.New("deposit encoding is too short")
+	}
+	deposit := &pb.Deposit{}
+	if err := proto.Unmarshal(enc[8:], deposit); err != nil {
+		return nil, err
+	}
+	return &depositContainer{
+		deposit: deposit,
+		block:   new(big.Int).SetUint64(bytesutil.FromBytes8(enc[:8])),
+	}, nil
+}
+
+// saveDepositContainer persists a deposit container in the given bucket, keyed by
+// the merkle tree index of the deposit.
+func (db *BeaconDB) saveDepositContainer(bucket []byte, ctnr *depositContainer) error {
+	enc, err := encodeDepositContainer(ctnr)
+	if err != nil {
+		return fmt.Errorf("could not encode deposit: %v", err)
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put(encodeDepositIndex(ctnr.deposit.MerkleTreeIndex), enc)
+	})
+}
+
+// depositContainers loads all the deposit containers persisted in the given bucket.
+func (db *BeaconDB) depositContainers(bucket []byte) ([]*depositContainer, error) {
+	var ctnrs []*depositContainer
+	err := db.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).ForEach(func(k, v []byte) error {
+			ctnr, err := decodeDepositContainer(v)
+			if err != nil {
+				return err
+			}
+			ctnrs = append(ctnrs, ctnr)
+			return nil
+		})
+	})
+	return ctnrs, err
+}
+
+// loadDeposits restores the in-memory deposits, pending deposits and chainstart
+// pubkeys from the values persisted by a previous run of the node.
+func (db *BeaconDB) loadDeposits() error {
+	deposits, err := db.depositContainers(depositsBucket)
+	if err != nil {
+		return err
+	}
+	pendingDeposits, err := db.depositContainers(pendingDepositsBucket)
+	if err != nil {
+		return err
+	}
+	chainstartPubkeys := make(map[string]bool)
+	if err := db.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainstartPubkeysBucket).ForEach(func(k, v []byte) error {
+			chainstartPubkeys[string(k)] = true
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+
+	db.depositsLock.Lock()
+	db.deposits = deposits
+	db.pendingDeposits = pendingDeposits
+	db.depositsLock.Unlock()
+	db.chainstartPubkeysLock.Lock()
+	db.chainstartPubkeys = chainstartPubkeys
+	db.chainstartPubkeysLock.Unlock()
+
+	historicalDepositsCount.Add(float64(len(deposits)))
+	pendingDepositsCount.Set(float64(len(pendingDeposits)))
+	return nil
+}
+
 // InsertPendingDeposit into the database. If deposit or block number are nil
 // then this method does nothing.
 func (db *BeaconDB) InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) {
@@ -40,7 +132,11 @@ func (db *BeaconDB) InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blo
 	}
 	db.depositsLock.Lock()
 	defer db.depositsLock.Unlock()
-	db.pendingDeposits = append(db.pendingDeposits, &depositContainer{deposit: d, block: blockNum})
+	ctnr := &depositContainer{deposit: d, block: blockNum}
+	if err := db.saveDepositContainer(pendingDepositsBucket, ctnr); err != nil {
+		log.Errorf("Could not persist pending deposit: %v", err)
+	}
+	db.pendingDeposits = append(db.pendingDeposits, ctnr)
 	pendingDepositsCount.Inc()
 }
 
@@ -89,6 +185,11 @@ func (db *BeaconDB) RemovePendingDeposit(ctx context.Context, d *pb.Deposit) {
 	}
 
 	if idx >= 0 {
+		if err := db.update(func(tx *bolt.Tx) error {
+			return tx.Bucket(pendingDepositsBucket).Delete(encodeDepositIndex(d.MerkleTreeIndex))
+		}); err != nil {
+			log.Errorf("Could not delete pending deposit: %v", err)
+		}
 		db.pendingDeposits = append(db.pendingDeposits[:idx], db.pendingDeposits[idx+1:]...)
 		pendingDepositsCount.Dec()
 	}
@@ -108,10 +209,24 @@ func (db *BeaconDB) PrunePendingDeposits(ctx context.Context, merkleTreeIndex ui
 	defer db.depositsLock.Unlock()
 
 	var cleanDeposits []*depositContainer
+	var prunedIndices []uint64
 	for _, dp := range db.pendingDeposits {
 		if dp.deposit.MerkleTreeIndex >= merkleTreeIndex {
 			cleanDeposits = append(cleanDeposits, dp)
+		} else {
+			prunedIndices = append(prunedIndices, dp.deposit.MerkleTreeIndex)
+		}
+	}
+	if err := db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(pendingDepositsBucket)
+		for _, index := range prunedIndices {
+			if err := bkt.Delete(encodeDepositIndex(index)); err != nil {
+				return err
+			}
 		}
+		return nil
+	}); err != nil {
+		log.Errorf("Could not delete pruned pending deposits: %v", err)
 	}
 
 	db.pendingDeposits = cleanDeposits
@@ -11,7 +11,8 @@ import (
 )
 
 func TestInsertPendingDeposit_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	db.InsertPendingDeposit(context.Background(), &pb.Deposit{}, big.NewInt(111))
 
 	if len(db.pendingDeposits) != 1 {
@@ -20,7 +21,8 @@ func TestInsertPendingDeposit_OK(t *testing.T) {
 }
 
 func TestInsertPendingDeposit_ignoresNilDeposit(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	db.InsertPendingDeposit(context.Background(), nil /*deposit*/, nil /*blockNum*/)
 
 	if len(db.pendingDeposits) > 0 {
@@ -29,7 +31,8 @@ func TestInsertPendingDeposit_ignoresNilDeposit(t *testing.T) {
 }
 
 func TestRemovePendingDeposit_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	depToRemove := &pb.Deposit{MerkleTreeIndex: 1}
 	otherDep := &pb.Deposit{MerkleTreeIndex: 5}
 	db.pendingDeposits = []*depositContainer{
@@ -44,7 +47,8 @@ func TestRemovePendingDeposit_OK(t *testing.T) {
 }
 
 func TestRemovePendingDeposit_IgnoresNilDeposit(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	db.pendingDeposits = []*depositContainer{{deposit: &pb.Deposit{}}}
 	db.RemovePendingDeposit(context.Background(), nil /*deposit*/)
 	if len(db.pendingDeposits) != 1 {
@@ -53,7 +57,8 @@ func TestRemovePendingDeposit_IgnoresNilDeposit(t *testing.T) {
 }
 
 func TestPendingDeposit_RoundTrip(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	dep := &pb.Deposit{MerkleTreeIndex: 123}
 	db.InsertPendingDeposit(context.Background(), dep, big.NewInt(111))
 	db.RemovePendingDeposit(context.Background(), dep)
@@ -63,7 +68,8 @@ func TestPendingDeposit_RoundTrip(t *testing.T) {
 }
 
 func TestPendingDeposits_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 
 	db.pendingDeposits = []*depositContainer{
 		{block: big.NewInt(2), deposit: &pb.Deposit{MerkleTreeIndex: 2}},
@@ -88,7 +94,8 @@ func TestPendingDeposits_OK(t *testing.T) {
 }
 
 func TestPrunePendingDeposits_ZeroMerkleIndex(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 
 	db.pendingDeposits = []*depositContainer{
 		{block: big.NewInt(2), deposit: &pb.Deposit{MerkleTreeIndex: 2}},
@@ -115,7 +122,8 @@ func TestPrunePendingDeposits_ZeroMerkleIndex(t *testing.T) {
 }
 
 func TestPrunePendingDeposits_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 
 	db.pendingDeposits = []*depositContainer{
 		{block: big.NewInt(2), deposit: &pb.Deposit{MerkleTreeIndex: 2}},
@@ -158,3 +166,71 @@ func TestPrunePendingDeposits_OK(t *testing.T) {
 	}
 
 }
+
+func TestDeposits_PersistAcrossRestarts(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	dep := &pb.Deposit{MerkleTreeIndex: 1, DepositData: []byte{'A'}}
+	pendingDep := &pb.Deposit{MerkleTreeIndex: 2, DepositData: []byte{'B'}}
+	removedDep := &pb.Deposit{MerkleTreeIndex: 3, DepositData: []byte{'C'}}
+	db.InsertDeposit(ctx, dep, big.NewInt(10))
+	db.InsertPendingDeposit(ctx, pendingDep, big.NewInt(11))
+	db.InsertPendingDeposit(ctx, removedDep, big.NewInt(12))
+	db.RemovePendingDeposit(ctx, removedDep)
+	db.MarkPubkeyForChainstart(ctx, "pubkey")
+
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	db, err := NewDB(db.DatabasePath)
+	if err != nil {
+		t.Fatalf("Could not reopen db: %v", err)
+	}
+	defer teardownDB(t, db)
+
+	deposits := db.AllDeposits(ctx, nil)
+	if len(deposits) != 1 || !proto.Equal(deposits[0], dep) {
+		t.Errorf("Expected deposits %v, received %v", []*pb.Deposit{dep}, deposits)
+	}
+	if deposits := db.AllDeposits(ctx, big.NewInt(9)); len(deposits) != 0 {
+		t.Errorf("Expected deposit block number to be persisted, received deposits %v", deposits)
+	}
+	pending := db.PendingDeposits(ctx, nil)
+	if len(pending) != 1 || !proto.Equal(pending[0], pendingDep) {
+		t.Errorf("Expected pending deposits %v, received %v", []*pb.Deposit{pendingDep}, pending)
+	}
+	if !db.PubkeyInChainstart(ctx, "pubkey") {
+		t.Error("Expected chainstart pubkey to be persisted")
+	}
+}
+
+func TestRemoveDepositsAfter_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	for i := uint64(0); i < 4; i++ {
+		dep := &pb.Deposit{MerkleTreeIndex: i}
+		db.InsertDeposit(ctx, dep, big.NewInt(int64(10+i)))
+		db.InsertPendingDeposit(ctx, dep, big.NewInt(int64(10+i)))
+	}
+	if err := db.RemoveDepositsAfter(ctx, big.NewInt(11)); err != nil {
+		t.Fatalf("Could not remove deposits: %v", err)
+	}
+
+	expected := []*pb.Deposit{{MerkleTreeIndex: 0}, {MerkleTreeIndex: 1}}
+	if deposits := db.AllDeposits(ctx, nil); !reflect.DeepEqual(deposits, expected) {
+		t.Errorf("Unexpected deposits. got=%+v want=%+v", deposits, expected)
+	}
+	if deposits := db.PendingDeposits(ctx, nil); !reflect.DeepEqual(deposits, expected) {
+		t.Errorf("Unexpected pending deposits. got=%+v want=%+v", deposits, expected)
+	}
+	deposits, err := db.depositContainers(depositsBucket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deposits) != 2 {
+		t.Errorf("Expected removed deposits to be deleted from the db, received %d deposits", len(deposits))
+	}
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveDepositTrieItem persists the item at the given index of the deposit trie
+// built by the powchain service, so that the trie can be regenerated on restart
+// without processing its deposit logs again.
+func (db *BeaconDB) SaveDepositTrieItem(ctx context.Context, index uint64, item []byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveDepositTrieItem")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(depositTrieBucket).Put(encodeDepositIndex(index), item)
+	})
+}
+
+// DepositTrieItems returns the persisted items of the deposit trie, ordered by index.
+func (db *BeaconDB) DepositTrieItems(ctx context.Context) ([][]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DepositTrieItems")
+	defer span.End()
+
+	var items [][]byte
+	err := db.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(depositTrieBucket).ForEach(func(k, v []byte) error {
+			item := make([]byte, len(v))
+			copy(item, v)
+			items = append(items, item)
+			return nil
+		})
+	})
+	return items, err
+}
+
+// DeleteDepositTrieItems deletes the persisted items of the deposit trie starting
+// from the given index.
+func (db *BeaconDB) DeleteDepositTrieItems(ctx context.Context, fromIndex uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteDepositTrieItems")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		c := tx.Bucket(depositTrieBucket).Cursor()
+		for k, _ := c.Seek(encodeDepositIndex(fromIndex)); k != nil; k, _ = c.Seek(encodeDepositIndex(fromIndex)) {
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SaveChainStart persists the ETH1 data and the genesis time of the ChainStart log.
+func (db *BeaconDB) SaveChainStart(ctx context.Context, eth1Data *pb.Eth1Data, genesisTime uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveChainStart")
+	defer span.End()
+
+	enc, err := proto.Marshal(eth1Data)
+	if err != nil {
+		return fmt.Errorf("could not encode eth1 data: %v", err)
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(powchainBucket)
+		if err := bkt.Put(chainStartETH1DataKey, enc); err != nil {
+			return err
+		}
+		return bkt.Put(chainStartTimeKey, bytesutil.Bytes8(genesisTime))
+	})
+}
+
+// ChainStart returns the ETH1 data and the genesis time of the ChainStart log.
+// Returns nil ETH1 data if no ChainStart log was processed.
+func (db *BeaconDB) ChainStart(ctx context.Context) (*pb.Eth1Data, uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ChainStart")
+	defer span.End()
+
+	var eth1Data *pb.Eth1Data
+	var genesisTime uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(powchainBucket)
+		enc := bkt.Get(chainStartETH1DataKey)
+		if enc == nil {
+			return nil
+		}
+		eth1Data = &pb.Eth1Data{}
+		if err := proto.Unmarshal(enc, eth1Data); err != nil {
+			return err
+		}
+		genesisTime = bytesutil.FromBytes8(bkt.Get(chainStartTimeKey))
+		return nil
+	})
+	return eth1Data, genesisTime, err
+}
+
+// SaveLastProcessedETH1Block persists the number of the last ETH1 block whose
+// deposit contract logs were processed.
+func (db *BeaconDB) SaveLastProcessedETH1Block(ctx context.Context, blockNum *big.Int) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLastProcessedETH1Block")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(powchainBucket).Put(lastProcessedETH1BlockKey, blockNum.Bytes())
+	})
+}
+
+// LastProcessedETH1Block returns the number of the last ETH1 block whose deposit
+// contract logs were processed. Returns nil if no block was processed yet.
+func (db *BeaconDB) LastProcessedETH1Block(ctx context.Context) (*big.Int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LastProcessedETH1Block")
+	defer span.End()
+
+	var blockNum *big.Int
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(powchainBucket).Get(lastProcessedETH1BlockKey)
+		if enc != nil {
+			blockNum = new(big.Int).SetBytes(enc)
+		}
+		return nil
+	})
+	return blockNum, err
+}
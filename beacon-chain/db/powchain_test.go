@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestDepositTrieItems_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	// Indices above 255 check that items are sorted by index rather than by the low byte.
+	saved := map[uint64][]byte{256: {'A'}, 0: {'B'}, 1: {'C'}}
+	for index, item := range saved {
+		if err := db.SaveDepositTrieItem(ctx, index, item); err != nil {
+			t.Fatal(err)
+		}
+	}
+	items, err := db.DepositTrieItems(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(items, [][]byte{{'B'}, {'C'}, {'A'}}) {
+		t.Errorf("Expected items ordered by index, received %v", items)
+	}
+}
+
+func TestDeleteDepositTrieItems_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	for i := uint64(0); i < 5; i++ {
+		if err := db.SaveDepositTrieItem(ctx, i, []byte{byte(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.DeleteDepositTrieItems(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	items, err := db.DepositTrieItems(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(items, [][]byte{{0}, {1}}) {
+		t.Errorf("Expected items below index 2 to remain, received %v", items)
+	}
+}
+
+func TestChainStart_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	eth1Data, _, err := db.ChainStart(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if eth1Data != nil {
+		t.Errorf("Expected no chainstart data, received %v", eth1Data)
+	}
+
+	want := &pb.Eth1Data{DepositRootHash32: []byte{'A'}, BlockHash32: []byte{'B'}}
+	if err := db.SaveChainStart(ctx, want, 100); err != nil {
+		t.Fatal(err)
+	}
+	eth1Data, genesisTime, err := db.ChainStart(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(eth1Data, want) || genesisTime != 100 {
+		t.Errorf("Expected chainstart data %v at time 100, received %v at time %d", want, eth1Data, genesisTime)
+	}
+}
+
+func TestLastProcessedETH1Block_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	blockNum, err := db.LastProcessedETH1Block(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blockNum != nil {
+		t.Errorf("Expected no processed block, received %v", blockNum)
+	}
+	if err := db.SaveLastProcessedETH1Block(ctx, big.NewInt(1000)); err != nil {
+		t.Fatal(err)
+	}
+	blockNum, err = db.LastProcessedETH1Block(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blockNum.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("Expected block 1000, received %v", blockNum)
+	}
+}
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"encoding/binary"
+
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
 
@@ -24,12 +26,43 @@ var (
 	chainInfoBucket         = []byte("chain-info")
 	validatorBucket         = []byte("validator")
 
+	proposerSlashingsBucket = []byte("proposer-slashings-bucket")
+	attesterSlashingsBucket = []byte("attester-slashings-bucket")
+
+	// Index of the attestation bucket by the hash of the attestation data, holding
+	// a nested bucket per data hash whose keys are the hashes of the attestations.
+	attestationDataBucket = []byte("attestation-data-bucket")
+
+	// Slashing history observed by the slasher service. Proposal history maps
+	// `validator index + slot` -> block root, while attestation history holds a
+	// nested bucket per validator index mapping `target epoch + hash` -> hash of
+	// the slashable attestation stored in the slashable attestation bucket.
+	proposalHistoryBucket      = []byte("proposal-history-bucket")
+	attestationHistoryBucket   = []byte("attestation-history-bucket")
+	slashableAttestationBucket = []byte("slashable-attestation-bucket")
+
+	// Deposits processed by the powchain service. The deposit buckets map the
+	// `merkle tree index` -> `block number + deposit`, the deposit trie bucket
+	// maps `index` -> deposit trie item and the powchain bucket holds the
+	// ChainStart data and the last processed ETH1 block.
+	depositsBucket          = []byte("deposits-bucket")
+	pendingDepositsBucket   = []byte("pending-deposits-bucket")
+	chainstartPubkeysBucket = []byte("chainstart-pubkeys-bucket")
+	depositTrieBucket       = []byte("deposit-trie-bucket")
+	powchainBucket          = []byte("powchain-bucket")
+
 	mainChainHeightKey      = []byte("chain-height")
 	stateLookupKey          = []byte("state")
 	finalizedStateLookupKey = []byte("finalized-state")
 	justifiedStateLookupKey = []byte("justified-state")
 	finalizedBlockLookupKey = []byte("finalized-block")
 	justifiedBlockLookupKey = []byte("justified-block")
+	backfillBlockLookupKey  = []byte("backfill-block")
+	syncProgressKey         = []byte("sync-progress")
+
+	chainStartETH1DataKey     = []byte("chainstart-eth1-data")
+	chainStartTimeKey         = []byte("chainstart-time")
+	lastProcessedETH1BlockKey = []byte("last-processed-eth1-block")
 
 	// DB internal use
 	cleanupHistoryBucket = []byte("cleanup-history-bucket")
@@ -45,3 +78,11 @@ func encodeSlotNumber(number uint64) []byte {
 func decodeToSlotNumber(bytearray []byte) uint64 {
 	return bytesutil.FromBytes8(bytearray)
 }
+
+// encodeDepositIndex encodes a merkle tree index as big-endian uint64, so that
+// iterating over a bucket yields the entries in index order.
+func encodeDepositIndex(index uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, index)
+	return enc
+}
@@ -0,0 +1,164 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveProposalHistory records the root and signature of the block proposed by a
+// validator at a slot. Only the first observed proposal is kept, as any later one
+// at the same slot is slashable against it.
+func (db *BeaconDB) SaveProposalHistory(ctx context.Context, validatorIdx uint64, slot uint64, blockRoot [32]byte, signature []byte) error {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.SaveProposalHistory")
+	defer span.End()
+
+	key := proposalHistoryKey(validatorIdx, slot)
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(proposalHistoryBucket)
+		if b.Get(key) != nil {
+			return nil
+		}
+		return b.Put(key, append(blockRoot[:], signature...))
+	})
+}
+
+// ProposalHistory returns the root and signature of the block proposed by a
+// validator at a slot, or a nil root if no proposal has been recorded.
+func (db *BeaconDB) ProposalHistory(validatorIdx uint64, slot uint64) ([]byte, []byte, error) {
+	var root, signature []byte
+	err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(proposalHistoryBucket)
+		if enc := b.Get(proposalHistoryKey(validatorIdx, slot)); enc != nil {
+			root = append([]byte{}, enc[:32]...)
+			signature = append([]byte{}, enc[32:]...)
+		}
+		return nil
+	})
+	return root, signature, err
+}
+
+// SaveAttestationHistory records a slashable attestation in the vote history
+// of every validator it contains.
+func (db *BeaconDB) SaveAttestationHistory(ctx context.Context, att *pb.SlashableAttestation) error {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.SaveAttestationHistory")
+	defer span.End()
+
+	encodedAtt, err := proto.Marshal(att)
+	if err != nil {
+		return err
+	}
+	hash := hashutil.Hash(encodedAtt)
+	key := append(bytesutil.Bytes8(helpers.SlotToEpoch(att.Data.Slot)), hash[:]...)
+
+	return db.update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(slashableAttestationBucket).Put(hash[:], encodedAtt); err != nil {
+			return err
+		}
+		history := tx.Bucket(attestationHistoryBucket)
+		for _, idx := range att.ValidatorIndices {
+			b, err := history.CreateBucketIfNotExists(bytesutil.Bytes8(idx))
+			if err != nil {
+				return fmt.Errorf("could not create history bucket for validator %d: %v", idx, err)
+			}
+			if err := b.Put(key, hash[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// AttestationHistory returns every slashable attestation recorded for a validator.
+func (db *BeaconDB) AttestationHistory(validatorIdx uint64) ([]*pb.SlashableAttestation, error) {
+	var atts []*pb.SlashableAttestation
+	err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(attestationHistoryBucket).Bucket(bytesutil.Bytes8(validatorIdx))
+		if b == nil {
+			return nil
+		}
+		attBucket := tx.Bucket(slashableAttestationBucket)
+		return b.ForEach(func(k, v []byte) error {
+			enc := attBucket.Get(v)
+			if enc == nil {
+				return fmt.Errorf("missing slashable attestation %#x", v)
+			}
+			att := &pb.SlashableAttestation{}
+			if err := proto.Unmarshal(enc, att); err != nil {
+				return err
+			}
+			atts = append(atts, att)
+			return nil
+		})
+	})
+	return atts, err
+}
+
+// PruneSlashingHistory deletes the proposals and votes recorded before the given
+// finalized epoch. Blocks and attestations from before finality can no longer be
+// included, so they cannot be part of a slashable offense with a new one.
+func (db *BeaconDB) PruneSlashingHistory(ctx context.Context, finalizedEpoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.PruneSlashingHistory")
+	defer span.End()
+
+	finalizedSlot := helpers.StartSlot(finalizedEpoch)
+	return db.update(func(tx *bolt.Tx) error {
+		proposals := tx.Bucket(proposalHistoryBucket)
+		var staleProposals [][]byte
+		if err := proposals.ForEach(func(k, v []byte) error {
+			if bytesutil.FromBytes8(k[8:]) < finalizedSlot {
+				staleProposals = append(staleProposals, k)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, k := range staleProposals {
+			if err := proposals.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		history := tx.Bucket(attestationHistoryBucket)
+		staleAtts := make(map[[32]byte]bool)
+		if err := history.ForEach(func(validatorKey, _ []byte) error {
+			b := history.Bucket(validatorKey)
+			var staleKeys [][]byte
+			if err := b.ForEach(func(k, v []byte) error {
+				if bytesutil.FromBytes8(k[:8]) < finalizedEpoch {
+					staleKeys = append(staleKeys, k)
+					staleAtts[bytesutil.ToBytes32(v)] = true
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			for _, k := range staleKeys {
+				if err := b.Delete(k); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		attBucket := tx.Bucket(slashableAttestationBucket)
+		for hash := range staleAtts {
+			if err := attBucket.Delete(hash[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func proposalHistoryKey(validatorIdx uint64, slot uint64) []byte {
+	return append(bytesutil.Bytes8(validatorIdx), bytesutil.Bytes8(slot)...)
+}
@@ -0,0 +1,154 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestProposalHistory_KeepsFirstProposal(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	root, _, err := db.ProposalHistory(3, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != nil {
+		t.Fatalf("Expected no proposal history, received %#x", root)
+	}
+
+	if err := db.SaveProposalHistory(ctx, 3, 10, [32]byte{'A'}, []byte("sigA")); err != nil {
+		t.Fatalf("Could not save proposal history: %v", err)
+	}
+	if err := db.SaveProposalHistory(ctx, 3, 10, [32]byte{'B'}, []byte("sigB")); err != nil {
+		t.Fatalf("Could not save proposal history: %v", err)
+	}
+
+	root, signature, err := db.ProposalHistory(3, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [32]byte{'A'}
+	if !bytes.Equal(root, want[:]) {
+		t.Errorf("Expected first proposal root %#x, received %#x", want, root)
+	}
+	if !bytes.Equal(signature, []byte("sigA")) {
+		t.Errorf("Expected first proposal signature %q, received %q", "sigA", signature)
+	}
+	root, _, err = db.ProposalHistory(4, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != nil {
+		t.Errorf("Expected no proposal history for another validator, received %#x", root)
+	}
+}
+
+func TestAttestationHistory_SavedForEachValidator(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	att1 := &pb.SlashableAttestation{
+		ValidatorIndices: []uint64{1, 2},
+		CustodyBitfield:  []byte{0},
+		Data:             &pb.AttestationData{Slot: 64, JustifiedEpoch: 0},
+	}
+	att2 := &pb.SlashableAttestation{
+		ValidatorIndices: []uint64{2, 3},
+		CustodyBitfield:  []byte{0},
+		Data:             &pb.AttestationData{Slot: 128, JustifiedEpoch: 1},
+	}
+	for _, att := range []*pb.SlashableAttestation{att1, att2} {
+		if err := db.SaveAttestationHistory(ctx, att); err != nil {
+			t.Fatalf("Could not save attestation history: %v", err)
+		}
+	}
+
+	tests := []struct {
+		validatorIdx uint64
+		want         []*pb.SlashableAttestation
+	}{
+		{validatorIdx: 1, want: []*pb.SlashableAttestation{att1}},
+		{validatorIdx: 2, want: []*pb.SlashableAttestation{att1, att2}},
+		{validatorIdx: 3, want: []*pb.SlashableAttestation{att2}},
+		{validatorIdx: 4, want: nil},
+	}
+	for _, tt := range tests {
+		history, err := db.AttestationHistory(tt.validatorIdx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(history) != len(tt.want) {
+			t.Fatalf("Expected %d attestations for validator %d, received %d",
+				len(tt.want), tt.validatorIdx, len(history))
+		}
+		for i := range history {
+			if !proto.Equal(history[i], tt.want[i]) {
+				t.Errorf("Expected %v, received %v", tt.want[i], history[i])
+			}
+		}
+	}
+}
+
+func TestPruneSlashingHistory_DeletesBeforeFinalizedEpoch(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	finalizedEpoch := params.BeaconConfig().GenesisEpoch + 2
+	staleSlot := helpers.StartSlot(finalizedEpoch) - 1
+	recentSlot := helpers.StartSlot(finalizedEpoch)
+	for _, slot := range []uint64{staleSlot, recentSlot} {
+		if err := db.SaveProposalHistory(ctx, 1, slot, [32]byte{'A'}, nil); err != nil {
+			t.Fatalf("Could not save proposal history: %v", err)
+		}
+	}
+	staleAtt := &pb.SlashableAttestation{
+		ValidatorIndices: []uint64{1, 2},
+		CustodyBitfield:  []byte{0},
+		Data:             &pb.AttestationData{Slot: staleSlot},
+	}
+	recentAtt := &pb.SlashableAttestation{
+		ValidatorIndices: []uint64{2},
+		CustodyBitfield:  []byte{0},
+		Data:             &pb.AttestationData{Slot: recentSlot},
+	}
+	for _, att := range []*pb.SlashableAttestation{staleAtt, recentAtt} {
+		if err := db.SaveAttestationHistory(ctx, att); err != nil {
+			t.Fatalf("Could not save attestation history: %v", err)
+		}
+	}
+
+	if err := db.PruneSlashingHistory(ctx, finalizedEpoch); err != nil {
+		t.Fatalf("Could not prune slashing history: %v", err)
+	}
+
+	if root, _, err := db.ProposalHistory(1, staleSlot); err != nil || root != nil {
+		t.Errorf("Expected the proposal before the finalized epoch to be pruned, received %#x, %v", root, err)
+	}
+	if root, _, err := db.ProposalHistory(1, recentSlot); err != nil || root == nil {
+		t.Errorf("Expected the proposal in the finalized epoch to be kept, received %#x, %v", root, err)
+	}
+	history, err := db.AttestationHistory(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 0 {
+		t.Errorf("Expected no attestations for validator 1, received %d", len(history))
+	}
+	history, err = db.AttestationHistory(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || !proto.Equal(history[0], recentAtt) {
+		t.Errorf("Expected only the attestation in the finalized epoch for validator 2, received %v", history)
+	}
+}
@@ -35,6 +35,14 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 	if err != nil {
 		return err
 	}
+	return db.InitializeGenesisState(ctx, beaconState)
+}
+
+// InitializeGenesisState saves a genesis state, built from chain start deposits or
+// otherwise, along with its genesis block as the initial state of the beacon node.
+func (db *BeaconDB) InitializeGenesisState(ctx context.Context, beaconState *pb.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.InitializeGenesisState")
+	defer span.End()
 
 	// #nosec G104
 	stateEnc, _ := proto.Marshal(beaconState)
@@ -89,6 +97,63 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 	})
 }
 
+// InitializeStateFromCheckpoint seeds the db of a fresh beacon node with a trusted
+// finalized state and its block, so that the node syncs the chain from this
+// checkpoint instead of chain start. The checkpoint becomes the finalized,
+// justified and head block and state of the node.
+func (db *BeaconDB) InitializeStateFromCheckpoint(ctx context.Context, beaconState *pb.BeaconState, block *pb.BeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.InitializeStateFromCheckpoint")
+	defer span.End()
+
+	if block.Slot > beaconState.Slot {
+		return fmt.Errorf("checkpoint block slot %d is higher than checkpoint state slot %d", block.Slot, beaconState.Slot)
+	}
+	if beaconState.LatestBlock != nil && !proto.Equal(beaconState.LatestBlock, block) {
+		return errors.New("checkpoint block is not the latest block of the checkpoint state")
+	}
+	// TODO(#2011): Remove this in state caching.
+	beaconState.LatestBlock = block
+
+	blockRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not hash checkpoint block: %v", err)
+	}
+	if err := db.SaveBlock(block); err != nil {
+		return fmt.Errorf("could not save checkpoint block: %v", err)
+	}
+	if err := db.SaveAttestationTarget(ctx, &pb.AttestationTarget{
+		Slot:       block.Slot,
+		BlockRoot:  blockRoot[:],
+		ParentRoot: block.ParentRootHash32,
+	}); err != nil {
+		return fmt.Errorf("could not save checkpoint attestation target: %v", err)
+	}
+	if err := db.SaveHistoricalState(ctx, beaconState); err != nil {
+		return fmt.Errorf("could not save checkpoint historical state: %v", err)
+	}
+	if err := db.SaveFinalizedBlock(block); err != nil {
+		return fmt.Errorf("could not save checkpoint block as finalized block: %v", err)
+	}
+	if err := db.SaveFinalizedState(beaconState); err != nil {
+		return fmt.Errorf("could not save checkpoint state as finalized state: %v", err)
+	}
+	if err := db.SaveJustifiedBlock(block); err != nil {
+		return fmt.Errorf("could not save checkpoint block as justified block: %v", err)
+	}
+	if err := db.SaveJustifiedState(beaconState); err != nil {
+		return fmt.Errorf("could not save checkpoint state as justified state: %v", err)
+	}
+	if err := db.SaveBackfillBlock(block); err != nil {
+		return fmt.Errorf("could not save checkpoint block as backfill block: %v", err)
+	}
+	for i, validator := range beaconState.ValidatorRegistry {
+		if err := db.SaveValidatorIndexBatch(validator.Pubkey, i); err != nil {
+			return fmt.Errorf("could not save validator index: %v", err)
+		}
+	}
+	return db.UpdateChainHead(ctx, block, beaconState)
+}
+
 // HeadState fetches the canonical beacon chain's head state from the DB.
 func (db *BeaconDB) HeadState(ctx context.Context) (*pb.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HeadState")
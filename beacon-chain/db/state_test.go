@@ -10,6 +10,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -88,6 +89,83 @@ func TestInitializeState_OK(t *testing.T) {
 	}
 }
 
+func TestInitializeStateFromCheckpoint_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	deposits, _ := setupInitialDeposits(t, 10)
+	checkpointState, err := state.GenesisBeaconState(deposits, uint64(time.Now().Unix()), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not create state: %v", err)
+	}
+	checkpointBlock := &pb.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 64,
+		ParentRootHash32: []byte{'A'},
+	}
+	checkpointState.Slot = checkpointBlock.Slot + 2
+	if err := db.InitializeStateFromCheckpoint(ctx, checkpointState, checkpointBlock); err != nil {
+		t.Fatalf("Failed to initialize state from checkpoint: %v", err)
+	}
+
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("Failed to get chain head: %v", err)
+	}
+	if !proto.Equal(head, checkpointBlock) {
+		t.Errorf("Expected chain head %v, received %v", checkpointBlock, head)
+	}
+	for _, retrieve := range []func() (*pb.BeaconBlock, error){db.FinalizedBlock, db.JustifiedBlock, db.BackfillBlock} {
+		block, err := retrieve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(block, checkpointBlock) {
+			t.Errorf("Expected checkpoint block %v, received %v", checkpointBlock, block)
+		}
+	}
+	headState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get state: %v", err)
+	}
+	for _, retrieve := range []func() (*pb.BeaconState, error){db.FinalizedState, db.JustifiedState} {
+		beaconState, err := retrieve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(beaconState, headState) || beaconState.Slot != checkpointState.Slot {
+			t.Error("Expected checkpoint state to be saved as finalized and justified state")
+		}
+	}
+	idx, err := db.ValidatorIndex(checkpointState.ValidatorRegistry[5].Pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if idx != 5 {
+		t.Errorf("Expected validator index 5, received %d", idx)
+	}
+}
+
+func TestInitializeStateFromCheckpoint_MismatchedBlock(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	checkpointState := &pb.BeaconState{
+		Slot:        params.BeaconConfig().GenesisSlot + 64,
+		LatestBlock: &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 64},
+	}
+	block := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 63}
+	want := "checkpoint block is not the latest block of the checkpoint state"
+	if err := db.InitializeStateFromCheckpoint(context.Background(), checkpointState, block); err == nil || err.Error() != want {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+
+	block = &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 65}
+	if err := db.InitializeStateFromCheckpoint(context.Background(), checkpointState, block); err == nil {
+		t.Error("Expected block after the checkpoint state to fail")
+	}
+}
+
 func TestFinalizeState_OK(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// syncProgressLength is the length of an encoded sync progress: two slots of 8
+// bytes and a root of 32 bytes.
+const syncProgressLength = 48
+
+// SyncProgress records how far initial sync got, so it can resume from there
+// after a restart.
+type SyncProgress struct {
+	// ProcessedSlot and ProcessedRoot identify the last block fully processed
+	// by initial sync.
+	ProcessedSlot uint64
+	ProcessedRoot [32]byte
+	// TargetSlot is the slot of the head of the peer synced with.
+	TargetSlot uint64
+}
+
+func (p *SyncProgress) marshal() []byte {
+	enc := make([]byte, 0, syncProgressLength)
+	enc = append(enc, bytesutil.Bytes8(p.ProcessedSlot)...)
+	enc = append(enc, p.ProcessedRoot[:]...)
+	return append(enc, bytesutil.Bytes8(p.TargetSlot)...)
+}
+
+func unmarshalSyncProgress(enc []byte) (*SyncProgress, error) {
+	if len(enc) != syncProgressLength {
+		return nil, fmt.Errorf("sync progress has length %d, wanted %d", len(enc), syncProgressLength)
+	}
+	return &SyncProgress{
+		ProcessedSlot: bytesutil.FromBytes8(enc[:8]),
+		ProcessedRoot: bytesutil.ToBytes32(enc[8:40]),
+		TargetSlot:    bytesutil.FromBytes8(enc[40:]),
+	}, nil
+}
+
+// SaveSyncProgress persists the progress of initial sync.
+func (db *BeaconDB) SaveSyncProgress(ctx context.Context, progress *SyncProgress) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveSyncProgress")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainInfoBucket).Put(syncProgressKey, progress.marshal())
+	})
+}
+
+// SyncProgress returns the progress of an unfinished initial sync. Returns nil if
+// initial sync never started or has completed.
+func (db *BeaconDB) SyncProgress(ctx context.Context) (*SyncProgress, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SyncProgress")
+	defer span.End()
+
+	var progress *SyncProgress
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainInfoBucket).Get(syncProgressKey)
+		if enc == nil {
+			return nil
+		}
+		var err error
+		progress, err = unmarshalSyncProgress(enc)
+		return err
+	})
+	return progress, err
+}
+
+// DeleteSyncProgress removes the progress of initial sync once it has completed.
+func (db *BeaconDB) DeleteSyncProgress(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteSyncProgress")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainInfoBucket).Delete(syncProgressKey)
+	})
+}
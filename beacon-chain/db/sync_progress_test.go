@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestSyncProgress_CanSaveRetrieveDelete(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	progress, err := db.SyncProgress(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress != nil {
+		t.Fatalf("Expected no sync progress, received %v", progress)
+	}
+
+	saved := &SyncProgress{
+		ProcessedSlot: 1 << 63,
+		ProcessedRoot: [32]byte{'A'},
+		TargetSlot:    1<<63 + 100,
+	}
+	if err := db.SaveSyncProgress(ctx, saved); err != nil {
+		t.Fatal(err)
+	}
+	progress, err = db.SyncProgress(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(progress, saved) {
+		t.Errorf("Expected sync progress %v, received %v", saved, progress)
+	}
+
+	if err := db.DeleteSyncProgress(ctx); err != nil {
+		t.Fatal(err)
+	}
+	progress, err = db.SyncProgress(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress != nil {
+		t.Errorf("Expected sync progress to be deleted, received %v", progress)
+	}
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: BeaconServiceServer,BeaconService_LatestAttestationServer,BeaconService_WaitForChainStartServer)
+// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: BeaconServiceServer,BeaconService_ChainEventsServer,BeaconService_LatestAttestationServer,BeaconService_WaitForChainStartServer)
 
 // Package internal is a generated GoMock package.
 package internal
@@ -53,6 +53,20 @@ func (mr *MockBeaconServiceServerMockRecorder) CanonicalHead(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceServer)(nil).CanonicalHead), arg0, arg1)
 }
 
+// ChainEvents mocks base method
+func (m *MockBeaconServiceServer) ChainEvents(arg0 *types.Empty, arg1 v10.BeaconService_ChainEventsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChainEvents indicates an expected call of ChainEvents
+func (mr *MockBeaconServiceServerMockRecorder) ChainEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainEvents", reflect.TypeOf((*MockBeaconServiceServer)(nil).ChainEvents), arg0, arg1)
+}
+
 // Eth1Data mocks base method
 func (m *MockBeaconServiceServer) Eth1Data(arg0 context.Context, arg1 *types.Empty) (*v10.Eth1DataResponse, error) {
 	m.ctrl.T.Helper()
@@ -68,6 +82,21 @@ func (mr *MockBeaconServiceServerMockRecorder) Eth1Data(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1Data", reflect.TypeOf((*MockBeaconServiceServer)(nil).Eth1Data), arg0, arg1)
 }
 
+// FinalizedCheckpoint mocks base method
+func (m *MockBeaconServiceServer) FinalizedCheckpoint(arg0 context.Context, arg1 *types.Empty) (*v10.CheckpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinalizedCheckpoint", arg0, arg1)
+	ret0, _ := ret[0].(*v10.CheckpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinalizedCheckpoint indicates an expected call of FinalizedCheckpoint
+func (mr *MockBeaconServiceServerMockRecorder) FinalizedCheckpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalizedCheckpoint", reflect.TypeOf((*MockBeaconServiceServer)(nil).FinalizedCheckpoint), arg0, arg1)
+}
+
 // ForkData mocks base method
 func (m *MockBeaconServiceServer) ForkData(arg0 context.Context, arg1 *types.Empty) (*v1.Fork, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +170,125 @@ func (mr *MockBeaconServiceServerMockRecorder) WaitForChainStart(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForChainStart", reflect.TypeOf((*MockBeaconServiceServer)(nil).WaitForChainStart), arg0, arg1)
 }
 
+// MockBeaconService_ChainEventsServer is a mock of BeaconService_ChainEventsServer interface
+type MockBeaconService_ChainEventsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconService_ChainEventsServerMockRecorder
+}
+
+// MockBeaconService_ChainEventsServerMockRecorder is the mock recorder for MockBeaconService_ChainEventsServer
+type MockBeaconService_ChainEventsServerMockRecorder struct {
+	mock *MockBeaconService_ChainEventsServer
+}
+
+// NewMockBeaconService_ChainEventsServer creates a new mock instance
+func NewMockBeaconService_ChainEventsServer(ctrl *gomock.Controller) *MockBeaconService_ChainEventsServer {
+	mock := &MockBeaconService_ChainEventsServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_ChainEventsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconService_ChainEventsServer) EXPECT() *MockBeaconService_ChainEventsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockBeaconService_ChainEventsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockBeaconService_ChainEventsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_ChainEventsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method
+func (m *MockBeaconService_ChainEventsServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconService_ChainEventsServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_ChainEventsServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method
+func (m *MockBeaconService_ChainEventsServer) Send(arg0 *v10.ChainEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockBeaconService_ChainEventsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconService_ChainEventsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockBeaconService_ChainEventsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockBeaconService_ChainEventsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconService_ChainEventsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockBeaconService_ChainEventsServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconService_ChainEventsServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_ChainEventsServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockBeaconService_ChainEventsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockBeaconService_ChainEventsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconService_ChainEventsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockBeaconService_ChainEventsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockBeaconService_ChainEventsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconService_ChainEventsServer)(nil).SetTrailer), arg0)
+}
+
 // MockBeaconService_LatestAttestationServer is a mock of BeaconService_LatestAttestationServer interface
 type MockBeaconService_LatestAttestationServer struct {
 	ctrl     *gomock.Controller
@@ -43,21 +43,46 @@ func main() {
 	app.Usage = "this is a beacon chain implementation for Ethereum 2.0"
 	app.Action = startNode
 	app.Version = version.GetVersion()
+	app.Commands = []cli.Command{
+		{
+			Name:  "interop-genesis",
+			Usage: "writes the genesis state of a chain of interop validators with deterministic keys to a file",
+			Flags: []cli.Flag{
+				utils.NoCustomConfigFlag,
+				cmd.ChainConfigFileFlag,
+				utils.InteropGenesisStateFlag,
+				utils.InteropNumValidatorsFlag,
+				utils.InteropGenesisTimeFlag,
+			},
+			Action: node.WriteInteropGenesisState,
+		},
+	}
 
 	app.Flags = []cli.Flag{
 		utils.NoCustomConfigFlag,
 		utils.DepositContractFlag,
 		utils.Web3ProviderFlag,
 		utils.HTTPWeb3ProviderFlag,
+		utils.Web3PollingIntervalFlag,
+		utils.SimulatedETH1Flag,
+		utils.SimulatedETH1BlockIntervalFlag,
+		utils.SimulatedETH1DepositsFlag,
+		utils.InteropGenesisStateFlag,
+		utils.InteropNumValidatorsFlag,
+		utils.InteropGenesisTimeFlag,
 		utils.RPCPort,
 		utils.CertFlag,
 		utils.KeyFlag,
+		utils.CheckpointStateFlag,
+		utils.CheckpointBlockFlag,
+		utils.CheckpointRPCProviderFlag,
 		utils.EnableDBCleanup,
 		cmd.BootstrapNode,
 		cmd.RelayNode,
 		cmd.P2PPort,
 		cmd.P2PHost,
 		cmd.DataDirFlag,
+		cmd.ChainConfigFileFlag,
 		cmd.VerbosityFlag,
 		cmd.EnableTracingFlag,
 		cmd.TracingEndpointFlag,
@@ -0,0 +1,149 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+)
+
+// startFromCheckpoint seeds an empty database with a trusted finalized state and
+// its block, read from files or fetched from another beacon node, so that the node
+// syncs from the checkpoint slot instead of chain start.
+func (b *BeaconNode) startFromCheckpoint(ctx *cli.Context) error {
+	statePath := ctx.GlobalString(utils.CheckpointStateFlag.Name)
+	blockPath := ctx.GlobalString(utils.CheckpointBlockFlag.Name)
+	endpoint := ctx.GlobalString(utils.CheckpointRPCProviderFlag.Name)
+	if statePath == "" && blockPath == "" && endpoint == "" {
+		return nil
+	}
+
+	headState, err := b.db.HeadState(context.Background())
+	if err != nil {
+		return fmt.Errorf("could not fetch head state: %v", err)
+	}
+	if headState != nil {
+		log.Warn("Beacon chain data already exists, ignoring checkpoint")
+		return nil
+	}
+
+	var beaconState *pb.BeaconState
+	var block *pb.BeaconBlock
+	if endpoint != "" {
+		beaconState, block, err = fetchCheckpoint(context.Background(), endpoint)
+		if err != nil {
+			return fmt.Errorf("could not fetch checkpoint from %s: %v", endpoint, err)
+		}
+	} else {
+		if statePath == "" || blockPath == "" {
+			return fmt.Errorf("both --%s and --%s are required to start from a checkpoint file",
+				utils.CheckpointStateFlag.Name, utils.CheckpointBlockFlag.Name)
+		}
+		beaconState = &pb.BeaconState{}
+		if err := readCheckpointFile(statePath, beaconState); err != nil {
+			return fmt.Errorf("could not read checkpoint state: %v", err)
+		}
+		block = &pb.BeaconBlock{}
+		if err := readCheckpointFile(blockPath, block); err != nil {
+			return fmt.Errorf("could not read checkpoint block: %v", err)
+		}
+	}
+
+	if err := verifyCheckpointStateRoot(beaconState, block); err != nil {
+		return fmt.Errorf("could not verify checkpoint: %v", err)
+	}
+	if err := b.db.InitializeStateFromCheckpoint(context.Background(), beaconState, block); err != nil {
+		return fmt.Errorf("could not initialize state from checkpoint: %v", err)
+	}
+	validators.InitializeValidatorStore(beaconState)
+	b.fromCheckpoint = true
+
+	log.WithFields(logrus.Fields{
+		"slot":  beaconState.Slot - params.BeaconConfig().GenesisSlot,
+		"epoch": beaconState.FinalizedEpoch - params.BeaconConfig().GenesisEpoch,
+	}).Info("Initialized beacon chain from checkpoint")
+	return nil
+}
+
+// verifyCheckpointStateRoot checks that the checkpoint state is the state the checkpoint
+// block commits to. As in the proposer's state root computation, the block root is taken
+// over the post state of the block, whose latest block is the block without its state
+// root and signature.
+func verifyCheckpointStateRoot(beaconState *pb.BeaconState, block *pb.BeaconBlock) error {
+	if beaconState.Slot != block.Slot {
+		return fmt.Errorf("checkpoint state slot %d does not match checkpoint block slot %d",
+			beaconState.Slot-params.BeaconConfig().GenesisSlot, block.Slot-params.BeaconConfig().GenesisSlot)
+	}
+	unsignedBlock := proto.Clone(block).(*pb.BeaconBlock)
+	unsignedBlock.StateRootHash32 = nil
+	unsignedBlock.Signature = nil
+	postState := proto.Clone(beaconState).(*pb.BeaconState)
+	postState.LatestBlock = unsignedBlock
+	// The genesis block commits to the genesis state, which has no latest block.
+	if block.Slot == params.BeaconConfig().GenesisSlot {
+		postState.LatestBlock = nil
+	}
+	stateRoot, err := hashutil.HashProto(postState)
+	if err != nil {
+		return fmt.Errorf("could not hash checkpoint state: %v", err)
+	}
+	if !bytes.Equal(stateRoot[:], block.StateRootHash32) {
+		return fmt.Errorf("checkpoint state root %#x does not match checkpoint block state root %#x",
+			stateRoot, block.StateRootHash32)
+	}
+	return nil
+}
+
+// readCheckpointFile decodes a checkpoint file into the message, using SSZ for
+// files with the .ssz extension and protobuf otherwise.
+func readCheckpointFile(path string, msg proto.Message) error {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if filepath.Ext(path) == ".ssz" {
+		return ssz.Decode(bytes.NewReader(enc), msg)
+	}
+	return proto.Unmarshal(enc, msg)
+}
+
+// fetchCheckpoint requests the finalized state and block of a trusted beacon node.
+func fetchCheckpoint(ctx context.Context, endpoint string) (*pb.BeaconState, *pb.BeaconBlock, error) {
+	conn, err := grpc.DialContext(ctx, endpoint, grpc.WithInsecure())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer conn.Close()
+	return requestCheckpoint(ctx, pbrpc.NewBeaconServiceClient(conn))
+}
+
+// requestCheckpoint requests the finalized state and block from the beacon
+// service, and checks that the response contains both of them.
+func requestCheckpoint(ctx context.Context, client pbrpc.BeaconServiceClient) (*pb.BeaconState, *pb.BeaconBlock, error) {
+	res, err := client.FinalizedCheckpoint(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.State == nil {
+		return nil, nil, errors.New("checkpoint response has no state")
+	}
+	if res.Block == nil {
+		return nil, nil, errors.New("checkpoint response has no block")
+	}
+	return res.State, res.Block, nil
+}
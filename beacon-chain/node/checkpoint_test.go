@@ -0,0 +1,132 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc"
+)
+
+func TestReadCheckpointFile_Protobuf(t *testing.T) {
+	beaconState := &pb.BeaconState{Slot: 64, FinalizedEpoch: 1}
+	enc, err := proto.Marshal(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := path.Join(testutil.TempDir(), "checkpoint-state.pb")
+	if err := ioutil.WriteFile(p, enc, 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(p)
+
+	decoded := &pb.BeaconState{}
+	if err := readCheckpointFile(p, decoded); err != nil {
+		t.Fatalf("Could not read checkpoint file: %v", err)
+	}
+	if !proto.Equal(decoded, beaconState) {
+		t.Errorf("Expected %v, received %v", beaconState, decoded)
+	}
+}
+
+func TestReadCheckpointFile_SSZ(t *testing.T) {
+	block := &pb.BeaconBlock{Slot: 64, ParentRootHash32: []byte{'A'}}
+	buf := new(bytes.Buffer)
+	if err := ssz.Encode(buf, block); err != nil {
+		t.Fatal(err)
+	}
+	p := path.Join(testutil.TempDir(), "checkpoint-block.ssz")
+	if err := ioutil.WriteFile(p, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(p)
+
+	decoded := &pb.BeaconBlock{}
+	if err := readCheckpointFile(p, decoded); err != nil {
+		t.Fatalf("Could not read checkpoint file: %v", err)
+	}
+	if decoded.Slot != block.Slot || !bytes.Equal(decoded.ParentRootHash32, block.ParentRootHash32) {
+		t.Errorf("Expected %v, received %v", block, decoded)
+	}
+}
+
+type checkpointClient struct {
+	pbrpc.BeaconServiceClient
+	res *pbrpc.CheckpointResponse
+}
+
+func (c *checkpointClient) FinalizedCheckpoint(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*pbrpc.CheckpointResponse, error) {
+	return c.res, nil
+}
+
+func TestRequestCheckpoint_RequiresStateAndBlock(t *testing.T) {
+	ctx := context.Background()
+	beaconState := &pb.BeaconState{Slot: 64}
+	block := &pb.BeaconBlock{Slot: 64}
+
+	tests := []struct {
+		res     *pbrpc.CheckpointResponse
+		wantErr string
+	}{
+		{res: &pbrpc.CheckpointResponse{Block: block}, wantErr: "no state"},
+		{res: &pbrpc.CheckpointResponse{State: beaconState}, wantErr: "no block"},
+		{res: &pbrpc.CheckpointResponse{State: beaconState, Block: block}},
+	}
+	for _, tt := range tests {
+		gotState, gotBlock, err := requestCheckpoint(ctx, &checkpointClient{res: tt.res})
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, received %v", tt.wantErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Could not request checkpoint: %v", err)
+		}
+		if gotState != beaconState || gotBlock != block {
+			t.Errorf("Expected the state and block of the response, received %v, %v", gotState, gotBlock)
+		}
+	}
+}
+
+func TestVerifyCheckpointStateRoot(t *testing.T) {
+	block := &pb.BeaconBlock{Slot: 64, ParentRootHash32: []byte{'A'}}
+	beaconState := &pb.BeaconState{Slot: 64, LatestBlock: block}
+	stateRoot, err := hashutil.HashProto(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block = proto.Clone(block).(*pb.BeaconBlock)
+	block.StateRootHash32 = stateRoot[:]
+	block.Signature = []byte{'S'}
+	beaconState.LatestBlock = block
+
+	if err := verifyCheckpointStateRoot(beaconState, block); err != nil {
+		t.Errorf("Could not verify checkpoint: %v", err)
+	}
+
+	tamperedState := proto.Clone(beaconState).(*pb.BeaconState)
+	tamperedState.FinalizedEpoch++
+	if err := verifyCheckpointStateRoot(tamperedState, block); err == nil ||
+		!strings.Contains(err.Error(), "does not match checkpoint block state root") {
+		t.Errorf("Expected state root mismatch, received %v", err)
+	}
+
+	advancedState := proto.Clone(beaconState).(*pb.BeaconState)
+	advancedState.Slot++
+	if err := verifyCheckpointStateRoot(advancedState, block); err == nil ||
+		!strings.Contains(err.Error(), "does not match checkpoint block slot") {
+		t.Errorf("Expected slot mismatch, received %v", err)
+	}
+}
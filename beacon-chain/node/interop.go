@@ -0,0 +1,117 @@
+package node
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// usesInteropGenesis determines whether the beacon chain starts from an interop
+// genesis state instead of the ChainStart log of the deposit contract.
+func usesInteropGenesis(ctx *cli.Context) bool {
+	return ctx.GlobalString(utils.InteropGenesisStateFlag.Name) != "" ||
+		ctx.GlobalUint64(utils.InteropNumValidatorsFlag.Name) > 0
+}
+
+// loadInteropGenesisState reads the interop genesis state file, or generates the
+// interop genesis state, which the blockchain service starts the chain from.
+func (b *BeaconNode) loadInteropGenesisState(ctx *cli.Context) error {
+	if !usesInteropGenesis(ctx) {
+		return nil
+	}
+
+	genesisState := &pb.BeaconState{}
+	if statePath := ctx.GlobalString(utils.InteropGenesisStateFlag.Name); statePath != "" {
+		if err := readCheckpointFile(statePath, genesisState); err != nil {
+			return fmt.Errorf("could not read interop genesis state: %v", err)
+		}
+	} else {
+		var err error
+		genesisState, err = state.InteropGenesisState(
+			ctx.GlobalUint64(utils.InteropNumValidatorsFlag.Name),
+			interopGenesisTime(ctx.GlobalUint64(utils.InteropGenesisTimeFlag.Name)),
+		)
+		if err != nil {
+			return fmt.Errorf("could not generate interop genesis state: %v", err)
+		}
+	}
+	b.genesisState = genesisState
+
+	log.WithFields(logrus.Fields{
+		"validators":  len(genesisState.ValidatorRegistry),
+		"genesisTime": time.Unix(int64(genesisState.GenesisTime), 0),
+	}).Info("Using interop genesis state")
+	return nil
+}
+
+// WriteInteropGenesisState generates the interop genesis state given by the
+// command flags and writes it to the file of the interop-genesis-state flag.
+func WriteInteropGenesisState(ctx *cli.Context) error {
+	statePath := ctx.String(utils.InteropGenesisStateFlag.Name)
+	if statePath == "" {
+		return fmt.Errorf("--%s is required", utils.InteropGenesisStateFlag.Name)
+	}
+	if err := useChainConfig(
+		ctx.String(cmd.ChainConfigFileFlag.Name),
+		ctx.Bool(utils.NoCustomConfigFlag.Name),
+	); err != nil {
+		return err
+	}
+
+	genesisState, err := state.InteropGenesisState(
+		ctx.Uint64(utils.InteropNumValidatorsFlag.Name),
+		interopGenesisTime(ctx.Uint64(utils.InteropGenesisTimeFlag.Name)),
+	)
+	if err != nil {
+		return fmt.Errorf("could not generate interop genesis state: %v", err)
+	}
+	if err := writeStateFile(statePath, genesisState); err != nil {
+		return fmt.Errorf("could not write interop genesis state: %v", err)
+	}
+
+	log.WithFields(logrus.Fields{
+		"path":        statePath,
+		"validators":  len(genesisState.ValidatorRegistry),
+		"genesisTime": time.Unix(int64(genesisState.GenesisTime), 0),
+	}).Info("Wrote interop genesis state")
+	return nil
+}
+
+// interopGenesisTime defaults the genesis time to the current time.
+func interopGenesisTime(genesisTime uint64) uint64 {
+	if genesisTime == 0 {
+		return uint64(time.Now().Unix())
+	}
+	return genesisTime
+}
+
+// writeStateFile encodes the message to a file the way readCheckpointFile decodes
+// it, using SSZ for files with the .ssz extension and protobuf otherwise.
+func writeStateFile(path string, msg proto.Message) error {
+	var enc []byte
+	if filepath.Ext(path) == ".ssz" {
+		buf := new(bytes.Buffer)
+		if err := ssz.Encode(buf, msg); err != nil {
+			return err
+		}
+		enc = buf.Bytes()
+	} else {
+		var err error
+		enc, err = proto.Marshal(msg)
+		if err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(path, enc, 0600)
+}
@@ -0,0 +1,41 @@
+package node
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestWriteStateFile_ReadsBack(t *testing.T) {
+	genesisState, err := state.InteropGenesisState(4, 1000)
+	if err != nil {
+		t.Fatalf("Could not build interop genesis state: %v", err)
+	}
+	for _, name := range []string{"genesis.pb", "genesis.ssz"} {
+		p := path.Join(testutil.TempDir(), name)
+		if err := writeStateFile(p, genesisState); err != nil {
+			t.Fatalf("Could not write state file %s: %v", name, err)
+		}
+		decoded := &pb.BeaconState{}
+		if err := readCheckpointFile(p, decoded); err != nil {
+			t.Fatalf("Could not read state file %s: %v", name, err)
+		}
+		os.Remove(p)
+		if decoded.GenesisTime != genesisState.GenesisTime {
+			t.Errorf("Expected genesis time %d in %s, received %d", genesisState.GenesisTime, name, decoded.GenesisTime)
+		}
+		if len(decoded.ValidatorRegistry) != len(genesisState.ValidatorRegistry) {
+			t.Fatalf("Expected %d validators in %s, received %d", len(genesisState.ValidatorRegistry), name, len(decoded.ValidatorRegistry))
+		}
+		for i, validator := range decoded.ValidatorRegistry {
+			if !proto.Equal(validator, genesisState.ValidatorRegistry[i]) {
+				t.Errorf("Expected validator %d in %s to be %v, received %v", i, name, genesisState.ValidatorRegistry[i], validator)
+			}
+		}
+	}
+}
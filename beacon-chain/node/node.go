@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -19,12 +20,15 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
@@ -48,6 +52,13 @@ type BeaconNode struct {
 	lock     sync.RWMutex
 	stop     chan struct{} // Channel to wait for termination notifications.
 	db       *db.BeaconDB
+	// fromCheckpoint is set when the database was seeded from a trusted finalized state.
+	fromCheckpoint bool
+	// genesisState is set when the chain starts from an interop genesis state.
+	genesisState *pb.BeaconState
+	// noPOWChain is set when the node runs without an ETH1.0 chain, in which case
+	// no web3Service is registered.
+	noPOWChain bool
 }
 
 // NewBeaconNode creates a new node instance, sets up configuration options, and registers
@@ -69,10 +80,11 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		stop:     make(chan struct{}),
 	}
 
-	// Use custom config values if the --no-custom-config flag is set.
-	if !ctx.GlobalBool(utils.NoCustomConfigFlag.Name) {
-		log.Info("Using custom parameter configuration")
-		params.UseDemoBeaconConfig()
+	if err := useChainConfig(
+		ctx.GlobalString(cmd.ChainConfigFileFlag.Name),
+		ctx.GlobalBool(utils.NoCustomConfigFlag.Name),
+	); err != nil {
+		return nil, err
 	}
 
 	featureconfig.ConfigureBeaconFeatures(ctx)
@@ -81,6 +93,14 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.startFromCheckpoint(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.loadInteropGenesisState(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerP2P(ctx); err != nil {
 		return nil, err
 	}
@@ -105,6 +125,10 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerSlasherService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerRPCService(ctx); err != nil {
 		return nil, err
 	}
@@ -165,10 +189,35 @@ func (b *BeaconNode) Close() {
 	close(b.stop)
 }
 
+// useChainConfig uses the beacon chain config of the YAML file if given, otherwise
+// the demo config unless the real phase 0 parameters are asked for.
+func useChainConfig(configFile string, noCustomConfig bool) error {
+	if configFile != "" {
+		if err := params.LoadChainConfigFile(configFile); err != nil {
+			return fmt.Errorf("could not load chain config file: %v", err)
+		}
+		log.WithField("path", configFile).Info("Using chain config file")
+		return nil
+	}
+	// Use custom config values if the --no-custom-config flag is set.
+	if !noCustomConfig {
+		log.Info("Using custom parameter configuration")
+		params.UseDemoBeaconConfig()
+	}
+	return nil
+}
+
 func (b *BeaconNode) startDB(ctx *cli.Context) error {
 	baseDir := ctx.GlobalString(cmd.DataDirFlag.Name)
 	dbPath := path.Join(baseDir, beaconChainDBName)
-	if b.ctx.GlobalBool(cmd.ClearDB.Name) {
+	clearDB := b.ctx.GlobalBool(cmd.ClearDB.Name)
+	if !clearDB && b.ctx.GlobalBool(utils.SimulatedETH1Flag.Name) {
+		// The simulated mainchain does not persist, so a chain started from it
+		// cannot be resumed.
+		log.WithField("path", dbPath).Warn("Clearing the database since the simulated mainchain does not persist")
+		clearDB = true
+	}
+	if clearDB {
 		if err := db.ClearDB(dbPath); err != nil {
 			return err
 		}
@@ -185,17 +234,19 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 }
 
 func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
-	beaconp2p, err := configureP2P(ctx)
+	gossipValidator := rbcsync.NewGossipValidator(b.db)
+	beaconp2p, err := configureP2P(ctx, gossipValidator.TopicValidators())
 	if err != nil {
 		return fmt.Errorf("could not register p2p service: %v", err)
 	}
+	gossipValidator.InitializeReporter(beaconp2p)
 
 	return b.services.RegisterService(beaconp2p)
 }
 
 func (b *BeaconNode) registerBlockchainService(_ *cli.Context) error {
-	var web3Service *powchain.Web3Service
-	if err := b.services.FetchService(&web3Service); err != nil {
+	web3Service, err := b.fetchPOWChainService()
+	if err != nil {
 		return err
 	}
 	var opsService *operations.Service
@@ -217,6 +268,8 @@ func (b *BeaconNode) registerBlockchainService(_ *cli.Context) error {
 		OpsPoolService: opsService,
 		AttsService:    attsService,
 		P2p:            p2pService,
+		GenesisState:   b.genesisState,
+		NoPOWChain:     b.noPOWChain,
 	})
 	if err != nil {
 		return fmt.Errorf("could not register blockchain service: %v", err)
@@ -239,8 +292,13 @@ func (b *BeaconNode) registerOperationService() error {
 }
 
 func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
-	if cliCtx.GlobalBool(testSkipPowFlag) {
-		return b.services.RegisterService(&powchain.Web3Service{})
+	if cliCtx.GlobalBool(testSkipPowFlag) || usesInteropGenesis(cliCtx) {
+		log.Info("Running without a proof-of-work chain")
+		b.noPOWChain = true
+		return nil
+	}
+	if cliCtx.GlobalBool(utils.SimulatedETH1Flag.Name) {
+		return b.registerSimulatedPOWChainService(cliCtx)
 	}
 
 	depAddress := cliCtx.GlobalString(utils.DepositContractFlag.Name)
@@ -257,28 +315,100 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 		log.Fatalf("Invalid deposit contract address given: %s", depAddress)
 	}
 
-	rpcClient, err := gethRPC.Dial(cliCtx.GlobalString(utils.Web3ProviderFlag.Name))
+	web3Providers := strings.Split(cliCtx.GlobalString(utils.Web3ProviderFlag.Name), ",")
+	httpWeb3Providers := strings.Split(cliCtx.GlobalString(utils.HTTPWeb3ProviderFlag.Name), ",")
+	pollingInterval := cliCtx.GlobalDuration(utils.Web3PollingIntervalFlag.Name)
+	var endpoints []*powchain.Endpoint
+	if pollingInterval > 0 {
+		// Only the HTTP endpoints are needed to poll the mainchain.
+		for _, httpWeb3Provider := range httpWeb3Providers {
+			httpWeb3Provider := httpWeb3Provider
+			endpoint, err := dialHTTPPOWEndpoint(httpWeb3Provider)
+			if err != nil {
+				log.WithField("endpoint", httpWeb3Provider).Errorf("Unable to connect to Geth node, retrying later: %v", err)
+				endpoint = &powchain.Endpoint{
+					URL: httpWeb3Provider,
+					Dial: func() (*powchain.Endpoint, error) {
+						return dialHTTPPOWEndpoint(httpWeb3Provider)
+					},
+				}
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+	} else {
+		if len(web3Providers) != len(httpWeb3Providers) {
+			log.Fatalf("Each web3 provider requires an HTTP web3 provider, received %d web3 providers and %d HTTP web3 providers",
+				len(web3Providers), len(httpWeb3Providers))
+		}
+		for i := range web3Providers {
+			web3Provider, httpWeb3Provider := web3Providers[i], httpWeb3Providers[i]
+			endpoint, err := dialPOWEndpoint(web3Provider, httpWeb3Provider)
+			if err != nil {
+				log.WithField("endpoint", web3Provider).Errorf("Unable to connect to Geth node, retrying later: %v", err)
+				endpoint = &powchain.Endpoint{
+					URL: web3Provider,
+					Dial: func() (*powchain.Endpoint, error) {
+						return dialPOWEndpoint(web3Provider, httpWeb3Provider)
+					},
+				}
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	// Endpoints which could not be connected to are dialed again by the powchain
+	// service, but it needs one connected endpoint to start with.
+	connected := 0
+	for _, endpoint := range endpoints {
+		if endpoint.Dial == nil {
+			connected++
+		}
+	}
+	if connected == 0 {
+		log.Fatal("Access to PoW chain is required for validator. Unable to connect to any Geth node")
+	}
+
+	ctx := context.Background()
+	cfg := &powchain.Web3ServiceConfig{
+		DepositContract: common.HexToAddress(depAddress),
+		Endpoints:       endpoints,
+		PollingInterval: pollingInterval,
+		BeaconDB:        b.db,
+	}
+	web3Service, err := powchain.NewWeb3Service(ctx, cfg)
 	if err != nil {
-		log.Fatalf("Access to PoW chain is required for validator. Unable to connect to Geth node: %v", err)
+		return fmt.Errorf("could not register proof-of-work chain web3Service: %v", err)
+	}
+
+	if err := b.db.VerifyContractAddress(ctx, cfg.DepositContract); err != nil {
+		return err
 	}
-	powClient := ethclient.NewClient(rpcClient)
 
-	httpRPCClient, err := gethRPC.Dial(cliCtx.GlobalString(utils.HTTPWeb3ProviderFlag.Name))
+	return b.services.RegisterService(web3Service)
+}
+
+// registerSimulatedPOWChainService registers an in-process simulated ETH1.0 chain,
+// and a web3Service following it.
+func (b *BeaconNode) registerSimulatedPOWChainService(cliCtx *cli.Context) error {
+	depositKeys, err := keystore.InteropKeys(0, cliCtx.GlobalUint64(utils.SimulatedETH1DepositsFlag.Name))
 	if err != nil {
-		log.Fatalf("Access to PoW chain is required for validator. Unable to connect to Geth node: %v", err)
+		return fmt.Errorf("could not derive deposit keys: %v", err)
 	}
-	httpClient := ethclient.NewClient(httpRPCClient)
 
 	ctx := context.Background()
+	simulatedChain, err := powchain.NewSimulatedChain(ctx, &powchain.SimulatedChainConfig{
+		BlockInterval: cliCtx.GlobalDuration(utils.SimulatedETH1BlockIntervalFlag.Name),
+		DepositKeys:   depositKeys,
+	})
+	if err != nil {
+		return fmt.Errorf("could not create simulated proof-of-work chain: %v", err)
+	}
+	if err := b.services.RegisterService(simulatedChain); err != nil {
+		return err
+	}
+
 	cfg := &powchain.Web3ServiceConfig{
-		Endpoint:        cliCtx.GlobalString(utils.Web3ProviderFlag.Name),
-		DepositContract: common.HexToAddress(depAddress),
-		Client:          powClient,
-		Reader:          powClient,
-		Logger:          powClient,
-		HTTPLogger:      httpClient,
-		BlockFetcher:    httpClient,
-		ContractBackend: powClient,
+		DepositContract: simulatedChain.DepositContractAddress(),
+		Endpoints:       []*powchain.Endpoint{simulatedChain.Endpoint()},
 		BeaconDB:        b.db,
 	}
 	web3Service, err := powchain.NewWeb3Service(ctx, cfg)
@@ -293,6 +423,68 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 	return b.services.RegisterService(web3Service)
 }
 
+// fetchPOWChainService returns the registered web3Service, or nil when the node
+// runs without a proof-of-work chain.
+func (b *BeaconNode) fetchPOWChainService() (*powchain.Web3Service, error) {
+	if b.noPOWChain {
+		return nil, nil
+	}
+	var web3Service *powchain.Web3Service
+	if err := b.services.FetchService(&web3Service); err != nil {
+		return nil, err
+	}
+	return web3Service, nil
+}
+
+// dialPOWEndpoint connects to the IPC or WebSocket endpoint and to the HTTP endpoint
+// of an ETH1.0 node.
+func dialPOWEndpoint(endpoint string, httpEndpoint string) (*powchain.Endpoint, error) {
+	rpcClient, err := gethRPC.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	powClient := ethclient.NewClient(rpcClient)
+
+	httpRPCClient, err := gethRPC.Dial(httpEndpoint)
+	if err != nil {
+		rpcClient.Close()
+		return nil, err
+	}
+	httpClient := ethclient.NewClient(httpRPCClient)
+
+	return &powchain.Endpoint{
+		URL:             endpoint,
+		Client:          powClient,
+		Reader:          powClient,
+		Logger:          powClient,
+		HTTPLogger:      httpClient,
+		BlockFetcher:    httpClient,
+		ContractBackend: powClient,
+		SyncReader:      httpClient,
+	}, nil
+}
+
+// dialHTTPPOWEndpoint connects to the HTTP endpoint of an ETH1.0 node, which is
+// polled rather than subscribed to.
+func dialHTTPPOWEndpoint(httpEndpoint string) (*powchain.Endpoint, error) {
+	httpRPCClient, err := gethRPC.Dial(httpEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := ethclient.NewClient(httpRPCClient)
+
+	return &powchain.Endpoint{
+		URL:             httpEndpoint,
+		Client:          httpClient,
+		Reader:          httpClient,
+		Logger:          httpClient,
+		HTTPLogger:      httpClient,
+		BlockFetcher:    httpClient,
+		ContractBackend: httpClient,
+		SyncReader:      httpClient,
+	}, nil
+}
+
 func (b *BeaconNode) registerSyncService(_ *cli.Context) error {
 	var chainService *blockchain.ChainService
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -314,8 +506,8 @@ func (b *BeaconNode) registerSyncService(_ *cli.Context) error {
 		return err
 	}
 
-	var web3Service *powchain.Web3Service
-	if err := b.services.FetchService(&web3Service); err != nil {
+	web3Service, err := b.fetchPOWChainService()
+	if err != nil {
 		return err
 	}
 
@@ -324,14 +516,48 @@ func (b *BeaconNode) registerSyncService(_ *cli.Context) error {
 		P2P:              p2pService,
 		BeaconDB:         b.db,
 		OperationService: operationService,
-		PowChainService:  web3Service,
 		AttsService:      attsService,
+		FromCheckpoint:   b.fromCheckpoint,
+		PeerStatuses:     p2pService.PeerStatuses(),
+	}
+	if web3Service != nil {
+		cfg.PowChainService = web3Service
 	}
 
+	// Handshakes carry the chain status of the node from here on, and peers
+	// with an incompatible chain status are disconnected.
+	p2pService.SetStatusHandler(rbcsync.NewChainStatus(b.db))
+
 	syncService := rbcsync.NewSyncService(context.Background(), cfg)
 	return b.services.RegisterService(syncService)
 }
 
+func (b *BeaconNode) registerSlasherService() error {
+	var syncService *rbcsync.Service
+	if err := b.services.FetchService(&syncService); err != nil {
+		return err
+	}
+
+	var attsService *attestation.Service
+	if err := b.services.FetchService(&attsService); err != nil {
+		return err
+	}
+
+	var operationService *operations.Service
+	if err := b.services.FetchService(&operationService); err != nil {
+		return err
+	}
+
+	slasherService := slasher.NewSlasherService(context.Background(), &slasher.Config{
+		BeaconDB:       b.db,
+		SyncService:    syncService.RegularSync,
+		AttsService:    attsService,
+		OpsPoolService: operationService,
+	})
+
+	return b.services.RegisterService(slasherService)
+}
+
 func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	var chainService *blockchain.ChainService
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -348,8 +574,8 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		return err
 	}
 
-	var web3Service *powchain.Web3Service
-	if err := b.services.FetchService(&web3Service); err != nil {
+	web3Service, err := b.fetchPOWChainService()
+	if err != nil {
 		return err
 	}
 
@@ -361,17 +587,21 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	port := ctx.GlobalString(utils.RPCPort.Name)
 	cert := ctx.GlobalString(utils.CertFlag.Name)
 	key := ctx.GlobalString(utils.KeyFlag.Name)
-	rpcService := rpc.NewRPCService(context.Background(), &rpc.Config{
+	cfg := &rpc.Config{
 		Port:             port,
 		CertFlag:         cert,
 		KeyFlag:          key,
 		BeaconDB:         b.db,
 		Broadcaster:      p2pService,
+		PeerManager:      p2pService,
 		ChainService:     chainService,
 		OperationService: operationService,
-		POWChainService:  web3Service,
 		SyncService:      syncService,
-	})
+	}
+	if web3Service != nil {
+		cfg.POWChainService = web3Service
+	}
+	rpcService := rpc.NewRPCService(context.Background(), cfg)
 
 	return b.services.RegisterService(rpcService)
 }
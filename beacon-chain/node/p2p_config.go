@@ -2,11 +2,13 @@ package node
 
 import (
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/p2p/adapter/metric"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli"
 )
 
@@ -25,11 +27,32 @@ var topicMappings = map[pb.Topic]proto.Message{
 	pb.Topic_ATTESTATION_ANNOUNCE:                &pb.AttestationAnnounce{},
 	pb.Topic_ATTESTATION_REQUEST:                 &pb.AttestationRequest{},
 	pb.Topic_ATTESTATION_RESPONSE:                &pb.AttestationResponse{},
+	pb.Topic_BEACON_STATE_HEADER_REQUEST:         &pb.BeaconStateHeaderRequest{},
+	pb.Topic_BEACON_STATE_HEADER_RESPONSE:        &pb.BeaconStateHeaderResponse{},
+	pb.Topic_BEACON_STATE_CHUNK_REQUEST:          &pb.BeaconStateChunkRequest{},
+	pb.Topic_BEACON_STATE_CHUNK_RESPONSE:         &pb.BeaconStateChunkResponse{},
 }
 
-func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
+// topicRateLimits are the limits on the messages a peer may send on topics whose
+// messages are expensive to serve. Other topics use p2p.DefaultRateLimit.
+var topicRateLimits = map[pb.Topic]p2p.RateLimit{
+	pb.Topic_BEACON_STATE_REQUEST:                {Rate: 0.1, Burst: 2},
+	pb.Topic_BEACON_STATE_HEADER_REQUEST:         {Rate: 0.1, Burst: 2},
+	pb.Topic_BEACON_STATE_CHUNK_REQUEST:          {Rate: 5, Burst: 20},
+	pb.Topic_BATCHED_BEACON_BLOCK_REQUEST:        {Rate: 1, Burst: 10},
+	pb.Topic_BEACON_BLOCK_REQUEST_BY_SLOT_NUMBER: {Rate: 10, Burst: 50},
+	pb.Topic_CHAIN_HEAD_REQUEST:                  {Rate: 1, Burst: 5},
+}
+
+func configureP2P(ctx *cli.Context, validators map[pb.Topic]p2p.TopicValidator) (*p2p.Server, error) {
 	contractAddress := ctx.GlobalString(utils.DepositContractFlag.Name)
-	if contractAddress == "" {
+	if ctx.GlobalBool(utils.SimulatedETH1Flag.Name) {
+		address, err := powchain.SimulatedDepositContractAddress()
+		if err != nil {
+			return nil, err
+		}
+		contractAddress = address.Hex()
+	} else if contractAddress == "" && !usesInteropGenesis(ctx) {
 		var err error
 		contractAddress, err = fetchDepositContract()
 		if err != nil {
@@ -37,6 +60,16 @@ func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
 		}
 	}
 
+	configHash, err := params.ConfigHash()
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimits := make(map[string]p2p.RateLimit, len(topicRateLimits))
+	for topic, limit := range topicRateLimits {
+		rateLimits[topic.String()] = limit
+	}
+
 	s, err := p2p.NewServer(&p2p.ServerConfig{
 		NoDiscovery:            ctx.GlobalBool(cmd.NoDiscovery.Name),
 		BootstrapNodeAddr:      ctx.GlobalString(cmd.BootstrapNode.Name),
@@ -44,6 +77,8 @@ func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
 		HostAddress:            ctx.GlobalString(cmd.P2PHost.Name),
 		Port:                   ctx.GlobalInt(cmd.P2PPort.Name),
 		DepositContractAddress: contractAddress,
+		ChainConfigHash:        configHash[:],
+		RateLimits:             rateLimits,
 	})
 	if err != nil {
 		return nil, err
@@ -51,11 +86,11 @@ func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
 
 	adapters := []p2p.Adapter{}
 	if !ctx.GlobalBool(cmd.DisableMonitoringFlag.Name) {
-		adapters = append(adapters, metric.New())
+		adapters = append(adapters, metric.New(), metric.NewPeerScores(s))
 	}
 
 	for k, v := range topicMappings {
-		s.RegisterTopic(k.String(), v, adapters...)
+		s.RegisterTopic(k.String(), v, validators[k], adapters...)
 	}
 
 	return s, nil
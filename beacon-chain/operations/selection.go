@@ -0,0 +1,127 @@
+package operations
+
+import (
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// candidateAttestation is an attestation considered for inclusion in a block
+// along with the validators participating in it.
+type candidateAttestation struct {
+	attestation  *pb.Attestation
+	targetEpoch  uint64
+	participants []uint64
+}
+
+// selectAttestations greedily packs up to MaxAttestations attestations which
+// maximise the reward of the proposer with respect to the given state. The
+// attestations are expected to be valid for inclusion in a block on top of the
+// state. At each step, the attestation scoring the highest is picked and its
+// participants are considered covered for its target epoch. The selected
+// attestations are returned in slot ascending order.
+func selectAttestations(state *pb.BeaconState, attestations []*pb.Attestation) []*pb.Attestation {
+	covered := make(map[uint64]map[uint64]bool)
+	cover := func(epoch uint64, indices []uint64) {
+		if covered[epoch] == nil {
+			covered[epoch] = make(map[uint64]bool)
+		}
+		for _, idx := range indices {
+			covered[epoch][idx] = true
+		}
+	}
+	// Validators whose attestations are already included on chain earn nothing more.
+	for _, att := range state.LatestAttestations {
+		participants, err := helpers.AttestationParticipants(state, att.Data, att.AggregationBitfield)
+		if err != nil {
+			log.WithError(err).Debug("Could not get participants of included attestation")
+			continue
+		}
+		cover(helpers.SlotToEpoch(att.Data.Slot), participants)
+	}
+
+	candidates := make([]*candidateAttestation, 0, len(attestations))
+	for _, att := range attestations {
+		participants, err := helpers.AttestationParticipants(state, att.Data, att.AggregationBitfield)
+		if err != nil {
+			log.WithError(err).WithField(
+				"slot", att.Data.Slot-params.BeaconConfig().GenesisSlot).Debug(
+				"Skipping, could not get attestation participants")
+			continue
+		}
+		candidates = append(candidates, &candidateAttestation{
+			attestation:  att,
+			targetEpoch:  helpers.SlotToEpoch(att.Data.Slot),
+			participants: participants,
+		})
+	}
+
+	activeIndices := helpers.ActiveValidatorIndices(state.ValidatorRegistry, helpers.CurrentEpoch(state))
+	baseRewardQuotient := helpers.BaseRewardQuotient(helpers.TotalBalance(state, activeIndices))
+
+	var selected []*pb.Attestation
+	for uint64(len(selected)) < params.BeaconConfig().MaxAttestations && len(candidates) > 0 {
+		bestIdx := -1
+		var bestScore uint64
+		for i, c := range candidates {
+			if !hasUncoveredParticipant(c, covered[c.targetEpoch]) {
+				continue
+			}
+			score := attestationScore(state, c, covered[c.targetEpoch], baseRewardQuotient)
+			if bestIdx < 0 || score > bestScore || (score == bestScore &&
+				c.attestation.Data.Slot < candidates[bestIdx].attestation.Data.Slot) {
+				bestIdx = i
+				bestScore = score
+			}
+		}
+		// No remaining attestation brings any new participant.
+		if bestIdx < 0 {
+			break
+		}
+		best := candidates[bestIdx]
+		selected = append(selected, best.attestation)
+		cover(best.targetEpoch, best.participants)
+		candidates = append(candidates[:bestIdx], candidates[bestIdx+1:]...)
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Data.Slot < selected[j].Data.Slot
+	})
+	return selected
+}
+
+// hasUncoveredParticipant returns true if any participant of the attestation is not
+// covered yet.
+func hasUncoveredParticipant(candidate *candidateAttestation, covered map[uint64]bool) bool {
+	for _, idx := range candidate.participants {
+		if !covered[idx] {
+			return true
+		}
+	}
+	return false
+}
+
+// attestationScore sums the proposer reward earned for including the participants of
+// the attestation which are not covered yet:
+// base_reward // ATTESTATION_INCLUSION_REWARD_QUOTIENT per participant.
+func attestationScore(
+	state *pb.BeaconState,
+	candidate *candidateAttestation,
+	covered map[uint64]bool,
+	baseRewardQuotient uint64,
+) uint64 {
+	if baseRewardQuotient == 0 {
+		return 0
+	}
+	var score uint64
+	for _, idx := range candidate.participants {
+		if covered[idx] {
+			continue
+		}
+		score += helpers.BaseReward(state, idx, baseRewardQuotient) /
+			params.BeaconConfig().AttestationInclusionRewardQuotient
+	}
+	return score
+}
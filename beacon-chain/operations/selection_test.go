@@ -0,0 +1,99 @@
+package operations
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestSelectAttestations_SkipsCoveredParticipants(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	slot := params.BeaconConfig().GenesisSlot + 10
+	beaconState := setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+20)
+
+	large := committeeAttestation(t, beaconState, slot, 0, 1, 2, 3, 4)
+	overlapping := committeeAttestation(t, beaconState, slot, 3, 4)
+	other := committeeAttestation(t, beaconState, slot+1, 0)
+
+	// Once the large attestation is picked, the overlapping one brings no new participant.
+	selected := selectAttestations(beaconState, []*pb.Attestation{overlapping, other, large})
+	if !reflect.DeepEqual(selected, []*pb.Attestation{large, other}) {
+		t.Errorf("Expected attestations %v, received %v", []*pb.Attestation{large, other}, selected)
+	}
+}
+
+func TestSelectAttestations_SkipsParticipantsIncludedOnChain(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	slot := params.BeaconConfig().GenesisSlot + 10
+	beaconState := setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+20)
+
+	included := committeeAttestation(t, beaconState, slot, 0, 1, 2)
+	beaconState.LatestAttestations = []*pb.PendingAttestation{{
+		AggregationBitfield: included.AggregationBitfield,
+		Data:                included.Data,
+	}}
+
+	covered := committeeAttestation(t, beaconState, slot, 1, 2)
+	partlyCovered := committeeAttestation(t, beaconState, slot, 2, 5)
+	selected := selectAttestations(beaconState, []*pb.Attestation{covered, partlyCovered})
+	if !reflect.DeepEqual(selected, []*pb.Attestation{partlyCovered}) {
+		t.Errorf("Expected attestations %v, received %v", []*pb.Attestation{partlyCovered}, selected)
+	}
+}
+
+func TestSelectAttestations_PrefersMoreParticipants(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	beaconState := setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+20)
+
+	cfg := params.BeaconConfig()
+	maxAttestations := cfg.MaxAttestations
+	cfg.MaxAttestations = 2
+	params.OverrideBeaconConfig(cfg)
+	defer func() {
+		cfg.MaxAttestations = maxAttestations
+		params.OverrideBeaconConfig(cfg)
+	}()
+
+	// The proposer is rewarded per participant, whatever the attestation slot.
+	oldest := committeeAttestation(t, beaconState, params.BeaconConfig().GenesisSlot+2, 0, 1, 2)
+	single := committeeAttestation(t, beaconState, params.BeaconConfig().GenesisSlot+14, 0)
+	pair := committeeAttestation(t, beaconState, params.BeaconConfig().GenesisSlot+15, 0, 1)
+	selected := selectAttestations(beaconState, []*pb.Attestation{single, pair, oldest})
+	if !reflect.DeepEqual(selected, []*pb.Attestation{oldest, pair}) {
+		t.Errorf("Expected attestations %v, received %v", []*pb.Attestation{oldest, pair}, selected)
+	}
+}
+
+func TestAttestationScore_SumsProposerRewardOfUncoveredParticipants(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	beaconState := setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+20)
+
+	activeIndices := helpers.ActiveValidatorIndices(beaconState.ValidatorRegistry, helpers.CurrentEpoch(beaconState))
+	quotient := helpers.BaseRewardQuotient(helpers.TotalBalance(beaconState, activeIndices))
+	att := committeeAttestation(t, beaconState, params.BeaconConfig().GenesisSlot+15, 0, 1, 2)
+	participants, err := helpers.AttestationParticipants(beaconState, att.Data, att.AggregationBitfield)
+	if err != nil {
+		t.Fatal(err)
+	}
+	candidate := &candidateAttestation{attestation: att, participants: participants}
+
+	covered := map[uint64]bool{participants[0]: true}
+	var want uint64
+	for _, idx := range participants[1:] {
+		want += helpers.BaseReward(beaconState, idx, quotient) / params.BeaconConfig().AttestationInclusionRewardQuotient
+	}
+	if want == 0 {
+		t.Fatal("Expected a non zero proposer reward")
+	}
+	if score := attestationScore(beaconState, candidate, covered, quotient); score != want {
+		t.Errorf("Expected score %d, received %d", want, score)
+	}
+}
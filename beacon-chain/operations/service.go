@@ -2,13 +2,19 @@
 package operations
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	handler "github.com/prysmaticlabs/prysm/shared/messagehandler"
@@ -25,6 +31,8 @@ var log = logrus.WithField("prefix", "operation")
 type OperationFeeds interface {
 	IncomingAttFeed() *event.Feed
 	IncomingExitFeed() *event.Feed
+	IncomingProposerSlashingFeed() *event.Feed
+	IncomingAttesterSlashingFeed() *event.Feed
 	IncomingProcessedBlockFeed() *event.Feed
 }
 
@@ -36,12 +44,19 @@ type Service struct {
 	beaconDB                   *db.BeaconDB
 	incomingExitFeed           *event.Feed
 	incomingValidatorExits     chan *pb.VoluntaryExit
+	proposerSlashingFeed       *event.Feed
+	incomingProposerSlashing   chan *pb.ProposerSlashing
+	attesterSlashingFeed       *event.Feed
+	incomingAttesterSlashing   chan *pb.AttesterSlashing
 	incomingAttFeed            *event.Feed
 	incomingAtt                chan *pb.Attestation
 	incomingProcessedBlockFeed *event.Feed
 	incomingProcessedBlock     chan *pb.BeaconBlock
 	p2p                        p2p.Broadcaster
 	error                      error
+	// attestationPoolLock is held while the pooled attestations are read and
+	// updated, so that concurrent updates do not aggregate or delete stale ones.
+	attestationPoolLock sync.Mutex
 }
 
 // Config options for the service.
@@ -60,6 +75,10 @@ func NewOpsPoolService(ctx context.Context, cfg *Config) *Service {
 		beaconDB:                   cfg.BeaconDB,
 		incomingExitFeed:           new(event.Feed),
 		incomingValidatorExits:     make(chan *pb.VoluntaryExit, params.BeaconConfig().DefaultBufferSize),
+		proposerSlashingFeed:       new(event.Feed),
+		incomingProposerSlashing:   make(chan *pb.ProposerSlashing, params.BeaconConfig().DefaultBufferSize),
+		attesterSlashingFeed:       new(event.Feed),
+		incomingAttesterSlashing:   make(chan *pb.AttesterSlashing, params.BeaconConfig().DefaultBufferSize),
 		incomingAttFeed:            new(event.Feed),
 		incomingAtt:                make(chan *pb.Attestation, params.BeaconConfig().DefaultBufferSize),
 		incomingProcessedBlockFeed: new(event.Feed),
@@ -97,6 +116,18 @@ func (s *Service) IncomingExitFeed() *event.Feed {
 	return s.incomingExitFeed
 }
 
+// IncomingProposerSlashingFeed returns a feed that any service can send detected proposer slashings into.
+// The beacon block operation pool service will subscribe to this feed in order to save valid slashings.
+func (s *Service) IncomingProposerSlashingFeed() *event.Feed {
+	return s.proposerSlashingFeed
+}
+
+// IncomingAttesterSlashingFeed returns a feed that any service can send detected attester slashings into.
+// The beacon block operation pool service will subscribe to this feed in order to save valid slashings.
+func (s *Service) IncomingAttesterSlashingFeed() *event.Feed {
+	return s.attesterSlashingFeed
+}
+
 // IncomingAttFeed returns a feed that any service can send incoming p2p attestations into.
 // The beacon block operation pool service will subscribe to this feed in order to relay incoming attestations.
 func (s *Service) IncomingAttFeed() *event.Feed {
@@ -109,51 +140,234 @@ func (s *Service) IncomingProcessedBlockFeed() *event.Feed {
 	return s.incomingProcessedBlockFeed
 }
 
-// PendingAttestations returns the attestations that have not seen on the beacon chain, the attestations are
-// returns in slot ascending order and up to MaxAttestations capacity. The attestations get
-// deleted in DB after they have been retrieved.
-func (s *Service) PendingAttestations(ctx context.Context) ([]*pb.Attestation, error) {
-	var attestations []*pb.Attestation
+// PendingAttestations returns the attestations that have not seen on the beacon chain and can be
+// included in a block proposed at the given slot, selected to maximise the rewards earned by the
+// proposer and returned in slot ascending order, up to MaxAttestations capacity. The attestations
+// which are one epoch older than the head state get deleted in DB.
+func (s *Service) PendingAttestations(ctx context.Context, proposalSlot uint64) ([]*pb.Attestation, error) {
+	ctx, span := trace.StartSpan(ctx, "operations.PendingAttestations")
+	defer span.End()
+	s.attestationPoolLock.Lock()
+	defer s.attestationPoolLock.Unlock()
+
 	attestationsFromDB, err := s.beaconDB.Attestations()
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve attestations from DB")
 	}
-	state, err := s.beaconDB.HeadState(ctx)
+	beaconState, err := s.beaconDB.HeadState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve attestations from DB")
 	}
-	sort.Slice(attestationsFromDB, func(i, j int) bool {
-		return attestationsFromDB[i].Data.Slot < attestationsFromDB[j].Data.Slot
-	})
-	var validAttsCount uint64
+	var attestations []*pb.Attestation
 	for _, att := range attestationsFromDB {
 		// Delete the attestation if the attestation is one epoch older than head state,
 		// we don't want to pass these attestations to RPC for proposer to include.
-		if att.Data.Slot+params.BeaconConfig().SlotsPerEpoch <= state.Slot {
+		if att.Data.Slot+params.BeaconConfig().SlotsPerEpoch <= beaconState.Slot {
 			if err := s.beaconDB.DeleteAttestation(att); err != nil {
 				return nil, err
 			}
 			continue
 		}
+		attestations = append(attestations, att)
+	}
+
+	beaconState, err = s.proposalState(ctx, beaconState, proposalSlot)
+	if err != nil {
+		return nil, err
+	}
+	// Only the attestations which can be included at the proposal slot compete for
+	// the block, so that none of its MaxAttestations capacity is wasted.
+	validAtts := make([]*pb.Attestation, 0, len(attestations))
+	for _, att := range attestations {
+		if att.Data.Slot+params.BeaconConfig().MinAttestationInclusionDelay > beaconState.Slot {
+			continue
+		}
+		if err := blocks.VerifyAttestation(beaconState, att, false); err != nil {
+			log.WithError(err).WithField(
+				"slot", att.Data.Slot-params.BeaconConfig().GenesisSlot).Warn(
+				"Skipping, pending attestation failed verification")
+			continue
+		}
+		validAtts = append(validAtts, att)
+	}
+	return selectAttestations(beaconState, validAtts), nil
+}
+
+// proposalState advances the head state through the empty slots preceding the proposal slot,
+// and then to the proposal slot itself, as the state a block proposed at that slot is verified
+// against.
+func (s *Service) proposalState(ctx context.Context, beaconState *pb.BeaconState, proposalSlot uint64) (*pb.BeaconState, error) {
+	head, err := s.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash beacon block: %v", err)
+	}
+	for beaconState.Slot < proposalSlot-1 {
+		beaconState, err = state.ExecuteStateTransition(
+			ctx, beaconState, nil /* block */, headRoot, &state.TransitionConfig{},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not execute head transition: %v", err)
+		}
+	}
+	beaconState.Slot++
+	return beaconState, nil
+}
+
+// PendingProposerSlashings returns the proposer slashings that can be applied to the
+// head state, at most one per proposer and up to MaxProposerSlashings capacity. Proposer
+// slashings which are no longer valid, for instance because the proposer has already
+// been slashed, get deleted in DB.
+func (s *Service) PendingProposerSlashings(ctx context.Context) ([]*pb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "operations.PendingProposerSlashings")
+	defer span.End()
+
+	slashingsFromDB, err := s.beaconDB.ProposerSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve proposer slashings from DB: %v", err)
+	}
+	state, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	sort.Slice(slashingsFromDB, func(i, j int) bool {
+		return slashingsFromDB[i].ProposerIndex < slashingsFromDB[j].ProposerIndex
+	})
+
+	var slashings []*pb.ProposerSlashing
+	seen := make(map[uint64]bool)
+	for _, slashing := range slashingsFromDB {
+		if err := blocks.VerifyProposerSlashing(state, slashing, false); err != nil {
+			log.WithError(err).Debug("Deleting invalid proposer slashing")
+			if err := s.beaconDB.DeleteProposerSlashing(slashing); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if seen[slashing.ProposerIndex] || uint64(len(slashings)) == params.BeaconConfig().MaxProposerSlashings {
+			continue
+		}
+		seen[slashing.ProposerIndex] = true
+		slashings = append(slashings, slashing)
+	}
+	return slashings, nil
+}
+
+// PendingAttesterSlashings returns the attester slashings that can be applied to the
+// head state, up to MaxAttesterSlashings capacity. An attester slashing is skipped
+// if every validator it slashes is already in the slashed set, which holds the
+// validators slashed by the other operations of the block and is updated with the
+// validators slashed by the selected attester slashings. Attester slashings which
+// are no longer valid get deleted in DB.
+func (s *Service) PendingAttesterSlashings(ctx context.Context, slashed map[uint64]bool) ([]*pb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "operations.PendingAttesterSlashings")
+	defer span.End()
+
+	slashingsFromDB, err := s.beaconDB.AttesterSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attester slashings from DB: %v", err)
+	}
+	state, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+
+	var slashings []*pb.AttesterSlashing
+	for _, slashing := range slashingsFromDB {
+		if err := blocks.VerifyAttesterSlashing(state, slashing, false); err != nil {
+			log.WithError(err).Debug("Deleting invalid attester slashing")
+			if err := s.beaconDB.DeleteAttesterSlashing(slashing); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if uint64(len(slashings)) == params.BeaconConfig().MaxAttesterSlashings {
+			continue
+		}
+		indices := slashableIndices(state, slashing)
+		var newlySlashed bool
+		for _, idx := range indices {
+			if !slashed[idx] {
+				newlySlashed = true
+			}
+		}
+		if !newlySlashed {
+			continue
+		}
+		for _, idx := range indices {
+			slashed[idx] = true
+		}
+		slashings = append(slashings, slashing)
+	}
+	return slashings, nil
+}
+
+// slashableIndices returns the indices of the validators present in both slashable
+// attestations of the attester slashing which have not been slashed yet.
+func slashableIndices(state *pb.BeaconState, slashing *pb.AttesterSlashing) []uint64 {
+	currentEpoch := helpers.CurrentEpoch(state)
+	var indices []uint64
+	for _, idx1 := range slashing.SlashableAttestation_1.ValidatorIndices {
+		for _, idx2 := range slashing.SlashableAttestation_2.ValidatorIndices {
+			if idx1 == idx2 && state.ValidatorRegistry[idx1].SlashedEpoch > currentEpoch {
+				indices = append(indices, idx1)
+			}
+		}
+	}
+	return indices
+}
+
+// PendingExits returns the exit requests that can be applied to the head state, at
+// most one per validator and up to MaxVoluntaryExits capacity. Exit requests that are
+// not valid yet are kept in DB until they are.
+func (s *Service) PendingExits(ctx context.Context) ([]*pb.VoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "operations.PendingExits")
+	defer span.End()
+
+	exitsFromDB, err := s.beaconDB.Exits()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exit requests from DB: %v", err)
+	}
+	state, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	sort.Slice(exitsFromDB, func(i, j int) bool {
+		return exitsFromDB[i].Epoch < exitsFromDB[j].Epoch
+	})
 
-		validAttsCount++
-		// Stop the max attestation number per beacon block is reached.
-		if validAttsCount == params.BeaconConfig().MaxAttestations {
+	var exits []*pb.VoluntaryExit
+	seen := make(map[uint64]bool)
+	for _, exit := range exitsFromDB {
+		if seen[exit.ValidatorIndex] {
+			continue
+		}
+		if err := blocks.VerifyExit(state, exit, false); err != nil {
+			continue
+		}
+		seen[exit.ValidatorIndex] = true
+		exits = append(exits, exit)
+		if uint64(len(exits)) == params.BeaconConfig().MaxVoluntaryExits {
 			break
 		}
-		attestations = append(attestations, att)
 	}
-	return attestations, nil
+	return exits, nil
 }
 
 // saveOperations saves the newly broadcasted beacon block operations
 // that was received from sync service.
 func (s *Service) saveOperations() {
-	// TODO(1438): Add rest of operations (slashings, attestation, exists...etc)
 	incomingSub := s.incomingExitFeed.Subscribe(s.incomingValidatorExits)
 	defer incomingSub.Unsubscribe()
 	incomingAttSub := s.incomingAttFeed.Subscribe(s.incomingAtt)
 	defer incomingAttSub.Unsubscribe()
+	proposerSlashingSub := s.proposerSlashingFeed.Subscribe(s.incomingProposerSlashing)
+	defer proposerSlashingSub.Unsubscribe()
+	attesterSlashingSub := s.attesterSlashingFeed.Subscribe(s.incomingAttesterSlashing)
+	defer attesterSlashingSub.Unsubscribe()
 
 	for {
 		select {
@@ -168,6 +382,10 @@ func (s *Service) saveOperations() {
 			handler.SafelyHandleMessage(s.ctx, s.HandleValidatorExits, exit)
 		case attestation := <-s.incomingAtt:
 			handler.SafelyHandleMessage(s.ctx, s.HandleAttestations, attestation)
+		case slashing := <-s.incomingProposerSlashing:
+			handler.SafelyHandleMessage(s.ctx, s.HandleProposerSlashings, slashing)
+		case slashing := <-s.incomingAttesterSlashing:
+			handler.SafelyHandleMessage(s.ctx, s.HandleAttesterSlashings, slashing)
 		}
 	}
 }
@@ -189,7 +407,10 @@ func (s *Service) HandleValidatorExits(ctx context.Context, message proto.Messag
 	return nil
 }
 
-// HandleAttestations processes a received attestation message.
+// HandleAttestations processes a received attestation message. The attestation is
+// aggregated with the pooled attestations sharing its attestation data whenever
+// their participants do not overlap, so that proposers include fewer and denser
+// attestations.
 func (s *Service) HandleAttestations(ctx context.Context, message proto.Message) error {
 	ctx, span := trace.StartSpan(ctx, "operations.HandleAttestations")
 	defer span.End()
@@ -199,15 +420,122 @@ func (s *Service) HandleAttestations(ctx context.Context, message proto.Message)
 	if err != nil {
 		return err
 	}
+	dataRoot, err := hashutil.HashProto(attestation.Data)
+	if err != nil {
+		return fmt.Errorf("could not hash attestation data: %v", err)
+	}
+	s.attestationPoolLock.Lock()
+	defer s.attestationPoolLock.Unlock()
 	if s.beaconDB.HasAttestation(hash) {
 		return nil
 	}
+	pooledAtts, err := s.beaconDB.AttestationsByDataRoot(dataRoot)
+	if err != nil {
+		return fmt.Errorf("could not retrieve attestations from DB: %v", err)
+	}
+	for _, pooled := range pooledAtts {
+		// The attestation brings no new participant.
+		if isBitfieldSubset(attestation.AggregationBitfield, pooled.AggregationBitfield) {
+			return nil
+		}
+		// The attestation supersedes the pooled one.
+		if isBitfieldSubset(pooled.AggregationBitfield, attestation.AggregationBitfield) {
+			if err := s.beaconDB.DeleteAttestation(pooled); err != nil {
+				return err
+			}
+			continue
+		}
+		if bitutil.BitfieldsOverlap(pooled.AggregationBitfield, attestation.AggregationBitfield) {
+			continue
+		}
+		aggregated, err := helpers.AggregateAttestation(pooled, attestation)
+		if err != nil {
+			log.WithError(err).Warn("Could not aggregate attestation")
+			continue
+		}
+		if err := s.beaconDB.DeleteAttestation(pooled); err != nil {
+			return err
+		}
+		attestation = aggregated
+	}
 	if err := s.beaconDB.SaveAttestation(ctx, attestation); err != nil {
 		return err
 	}
 	return nil
 }
 
+// isBitfieldSubset returns true if every bit set in b1 is also set in b2.
+func isBitfieldSubset(b1 []byte, b2 []byte) bool {
+	union, err := bitutil.OrBitfields(b1, b2)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(union, b2)
+}
+
+// HandleProposerSlashings processes a proposer slashing, saving it to the DB
+// so that it can be included in a future beacon block if it is valid against
+// the head state.
+func (s *Service) HandleProposerSlashings(ctx context.Context, message proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "operations.HandleProposerSlashings")
+	defer span.End()
+
+	slashing := message.(*pb.ProposerSlashing)
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	if s.beaconDB.HasProposerSlashing(hash) {
+		return nil
+	}
+	state, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if err := blocks.VerifyProposerSlashing(state, slashing, false); err != nil {
+		log.WithError(err).Debug("Discarding invalid proposer slashing")
+		return fmt.Errorf("invalid proposer slashing: %v", err)
+	}
+	if err := s.beaconDB.SaveProposerSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"hash":          fmt.Sprintf("%#x", hash),
+		"proposerIndex": slashing.ProposerIndex,
+	}).Info("Proposer slashing saved in DB")
+	return nil
+}
+
+// HandleAttesterSlashings processes an attester slashing, saving it to the DB
+// so that it can be included in a future beacon block if it is valid against
+// the head state.
+func (s *Service) HandleAttesterSlashings(ctx context.Context, message proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "operations.HandleAttesterSlashings")
+	defer span.End()
+
+	slashing := message.(*pb.AttesterSlashing)
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	if s.beaconDB.HasAttesterSlashing(hash) {
+		return nil
+	}
+	state, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if err := blocks.VerifyAttesterSlashing(state, slashing, false); err != nil {
+		log.WithError(err).Debug("Discarding invalid attester slashing")
+		return fmt.Errorf("invalid attester slashing: %v", err)
+	}
+	if err := s.beaconDB.SaveAttesterSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	log.WithField("hash", fmt.Sprintf("%#x", hash)).Info("Attester slashing saved in DB")
+	return nil
+}
+
 // removeOperations removes the processed operations from operation pool and DB.
 func (s *Service) removeOperations() {
 	incomingBlockSub := s.incomingProcessedBlockFeed.Subscribe(s.incomingProcessedBlock)
@@ -243,21 +571,55 @@ func (s *Service) handleProcessedBlock(_ context.Context, message proto.Message)
 	if err := s.removePendingAttestations(block.Body.Attestations); err != nil {
 		return fmt.Errorf("could not remove processed attestations from DB: %v", err)
 	}
+	if err := s.removePendingSlashingsAndExits(block.Body); err != nil {
+		return fmt.Errorf("could not remove processed slashings and exits from DB: %v", err)
+	}
 	return nil
 }
 
-// removePendingAttestations removes a list of attestations from DB.
+// removePendingSlashingsAndExits removes the slashings and exit requests included
+// in a block body from DB.
+func (s *Service) removePendingSlashingsAndExits(body *pb.BeaconBlockBody) error {
+	for _, slashing := range body.ProposerSlashings {
+		if err := s.beaconDB.DeleteProposerSlashing(slashing); err != nil {
+			return err
+		}
+	}
+	for _, slashing := range body.AttesterSlashings {
+		if err := s.beaconDB.DeleteAttesterSlashing(slashing); err != nil {
+			return err
+		}
+	}
+	for _, exit := range body.VoluntaryExits {
+		if err := s.beaconDB.DeleteExit(exit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removePendingAttestations removes a list of attestations from DB, along with the
+// pooled attestations whose participants are all covered by one of them.
 func (s *Service) removePendingAttestations(attestations []*pb.Attestation) error {
+	s.attestationPoolLock.Lock()
+	defer s.attestationPoolLock.Unlock()
 	for _, attestation := range attestations {
-		hash, err := hashutil.HashProto(attestation)
+		dataRoot, err := hashutil.HashProto(attestation.Data)
+		if err != nil {
+			return fmt.Errorf("could not hash attestation data: %v", err)
+		}
+		pooledAtts, err := s.beaconDB.AttestationsByDataRoot(dataRoot)
 		if err != nil {
 			return err
 		}
-		if s.beaconDB.HasAttestation(hash) {
-			if err := s.beaconDB.DeleteAttestation(attestation); err != nil {
+		for _, pooled := range pooledAtts {
+			if !isBitfieldSubset(pooled.AggregationBitfield, attestation.AggregationBitfield) {
+				continue
+			}
+			if err := s.beaconDB.DeleteAttestation(pooled); err != nil {
 				return err
 			}
-			log.WithField("slot", attestation.Data.Slot-params.BeaconConfig().GenesisSlot).Debug("Attestation removed")
+			log.WithField("slot", pooled.Data.Slot-params.BeaconConfig().GenesisSlot).Debug("Attestation removed")
 		}
 	}
 	return nil
@@ -265,6 +627,8 @@ func (s *Service) removePendingAttestations(attestations []*pb.Attestation) erro
 
 // removeEpochOldAttestations removes attestations that's older than one epoch length from current slot.
 func (s *Service) removeEpochOldAttestations(slot uint64) error {
+	s.attestationPoolLock.Lock()
+	defer s.attestationPoolLock.Unlock()
 	attestations, err := s.beaconDB.Attestations()
 	if err != nil {
 		return err
@@ -1,15 +1,23 @@
 package operations
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -30,6 +38,74 @@ func init() {
 	logrus.SetLevel(logrus.DebugLevel)
 }
 
+// setupHeadState saves a genesis state advanced to the given slot as the head
+// state. Its registry holds 8 validators per slot so that each slot has a single
+// committee of 8 validators.
+func setupHeadState(t *testing.T, beaconDB *db.BeaconDB, slot uint64) *pb.BeaconState {
+	deposits := make([]*pb.Deposit, 8*params.BeaconConfig().SlotsPerEpoch)
+	for i := 0; i < len(deposits); i++ {
+		depositInput := &pb.DepositInput{
+			Pubkey: []byte(strconv.Itoa(i)),
+		}
+		depositData, err := helpers.EncodeDepositData(
+			depositInput, params.BeaconConfig().MaxDepositAmount, time.Now().Unix(),
+		)
+		if err != nil {
+			t.Fatalf("Cannot encode data: %v", err)
+		}
+		deposits[i] = &pb.Deposit{DepositData: depositData}
+	}
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(time.Now().Unix()), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not create genesis state: %v", err)
+	}
+	beaconState.Slot = slot
+	// Committees are cached by slot, so the shuffling of an epoch must not depend on
+	// whether it is the previous or the current epoch of the head state, as it would
+	// after the epoch transitions skipped by setting the slot.
+	beaconState.PreviousShufflingSeedHash32 = beaconState.CurrentShufflingSeedHash32
+	head := &pb.BeaconBlock{Slot: slot}
+	if err := beaconDB.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.UpdateChainHead(context.Background(), head, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	return beaconState
+}
+
+// committeeAttestation returns an attestation of the first committee at the given slot
+// with the committee positions as participants, or the whole committee if none is given.
+// The attestation votes for the justified checkpoint and crosslink of the state.
+func committeeAttestation(t *testing.T, beaconState *pb.BeaconState, slot uint64, positions ...int) *pb.Attestation {
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false /* registryChange */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0].Committee
+	if len(positions) == 0 {
+		for i := range committee {
+			positions = append(positions, i)
+		}
+	}
+	bitfield := make([]byte, (len(committee)+7)/8)
+	for _, i := range positions {
+		bitfield[i/8] |= 1 << (7 - uint(i%8))
+	}
+	return &pb.Attestation{
+		AggregationBitfield: bitfield,
+		Data: &pb.AttestationData{
+			Slot:                     slot,
+			Shard:                    committees[0].Shard,
+			JustifiedEpoch:           beaconState.JustifiedEpoch,
+			JustifiedBlockRootHash32: beaconState.JustifiedRoot,
+			LatestCrosslink:          beaconState.LatestCrosslinks[committees[0].Shard],
+			CrosslinkDataRootHash32:  params.BeaconConfig().ZeroHash[:],
+		},
+		CustodyBitfield: make([]byte, len(bitfield)),
+	}
+}
+
 func TestStop_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 	opsService := NewOpsPoolService(context.Background(), &Config{})
@@ -116,71 +192,410 @@ func TestIncomingAttestation_OK(t *testing.T) {
 	}
 }
 
-func TestRetrieveAttestations_OK(t *testing.T) {
+func TestHandleAttestations_AggregatesDisjointAttestations(t *testing.T) {
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
 	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
 
-	// Save 140 attestations for test. During 1st retrieval we should get slot:1 - slot:61 attestations.
-	// The 1st retrieval is set at slot 64.
-	origAttestations := make([]*pb.Attestation, 140)
-	for i := 0; i < len(origAttestations); i++ {
-		origAttestations[i] = &pb.Attestation{
-			Data: &pb.AttestationData{
-				Slot:                    params.BeaconConfig().GenesisSlot + uint64(i),
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			},
+	data := &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1}
+	sigs := make([]*bls.Signature, 3)
+	pubKeys := make([]*bls.PublicKey, 3)
+	for i, bitfield := range [][]byte{{0x80}, {0x40}, {0x01}} {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
 		}
-		if err := service.beaconDB.SaveAttestation(context.Background(), origAttestations[i]); err != nil {
-			t.Fatalf("Failed to save attestation: %v", err)
+		sigs[i] = priv.Sign([]byte("attestation"), 0)
+		pubKeys[i] = priv.PublicKey()
+		att := &pb.Attestation{
+			AggregationBitfield: bitfield,
+			Data:                data,
+			CustodyBitfield:     []byte{0x00},
+			AggregateSignature:  sigs[i].Marshal(),
+		}
+		if err := service.HandleAttestations(context.Background(), att); err != nil {
+			t.Fatal(err)
 		}
 	}
-	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{
-		Slot: params.BeaconConfig().GenesisSlot + 64,
-		LatestCrosslinks: []*pb.Crosslink{{
-			Epoch:                   params.BeaconConfig().GenesisEpoch,
-			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:]}}}); err != nil {
+
+	atts, err := beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) != 1 {
+		t.Fatalf("Expected attestations to be aggregated into 1, received %d", len(atts))
+	}
+	if !bytes.Equal(atts[0].AggregationBitfield, []byte{0xC1}) {
+		t.Errorf("Expected aggregation bitfield %#x, received %#x", []byte{0xC1}, atts[0].AggregationBitfield)
+	}
+	wantSig := bls.AggregateSignatures(sigs).Marshal()
+	if !bytes.Equal(atts[0].AggregateSignature, wantSig) {
+		t.Errorf("Expected aggregate signature %#x, received %#x", wantSig, atts[0].AggregateSignature)
+	}
+	aggregateSig, err := bls.SignatureFromBytes(atts[0].AggregateSignature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !aggregateSig.VerifyAggregate(pubKeys, []byte("attestation"), 0) {
+		t.Error("Expected the aggregate signature to verify against the participants' public keys")
+	}
+}
+
+func TestHandleAttestations_AggregatesUnsignedAttestations(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	data := &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1}
+	for _, bitfield := range [][]byte{{0x80}, {0x01}} {
+		att := &pb.Attestation{
+			AggregationBitfield: bitfield,
+			Data:                data,
+			CustodyBitfield:     []byte{0x00},
+			AggregateSignature:  []byte("signed"),
+		}
+		if err := service.HandleAttestations(context.Background(), att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	atts, err := beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) != 1 || !bytes.Equal(atts[0].AggregationBitfield, []byte{0x81}) {
+		t.Errorf("Expected the unsigned attestations to be aggregated, received %v", atts)
+	}
+}
+
+func TestHandleAttestations_AggregatesConcurrentAttestations(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	data := &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1}
+	errs := make(chan error, 8)
+	for i := uint(0); i < 8; i++ {
+		go func(bitfield []byte) {
+			errs <- service.HandleAttestations(context.Background(), &pb.Attestation{
+				AggregationBitfield: bitfield,
+				Data:                data,
+				CustodyBitfield:     []byte{0x00},
+			})
+		}([]byte{0x80 >> i})
+	}
+	for i := 0; i < 8; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	atts, err := beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) != 1 || !bytes.Equal(atts[0].AggregationBitfield, []byte{0xFF}) {
+		t.Errorf("Expected a single attestation with every participant, received %v", atts)
+	}
+}
+
+func TestHandleAttestations_DoesNotAggregateOverlappingAttestations(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	data := &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1}
+	for _, bitfield := range [][]byte{{0xC0}, {0x60}, {0x40}} {
+		att := &pb.Attestation{
+			AggregationBitfield: bitfield,
+			Data:                data,
+			CustodyBitfield:     []byte{0x00},
+		}
+		if err := service.HandleAttestations(context.Background(), att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The last attestation is covered by the pooled ones and is dropped, the
+	// first two overlap and are kept separately.
+	atts, err := beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) != 2 {
+		t.Fatalf("Expected 2 attestations in the pool, received %d", len(atts))
+	}
+}
+
+func TestHandleAttestations_ReplacesSupersededAttestation(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	data := &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1}
+	for _, bitfield := range [][]byte{{0x40}, {0xC0}} {
+		att := &pb.Attestation{
+			AggregationBitfield: bitfield,
+			Data:                data,
+			CustodyBitfield:     []byte{0x00},
+		}
+		if err := service.HandleAttestations(context.Background(), att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	atts, err := beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) != 1 || !bytes.Equal(atts[0].AggregationBitfield, []byte{0xC0}) {
+		t.Errorf("Expected only the superseding attestation in the pool, received %v", atts)
+	}
+}
+
+func TestIncomingProposerSlashing_Ok(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+	setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+params.BeaconConfig().SlotsPerEpoch)
+
+	slashing := &pb.ProposerSlashing{
+		ProposerIndex:  1,
+		ProposalData_1: &pb.ProposalSignedData{Slot: 5, BlockRootHash32: []byte{'A'}},
+		ProposalData_2: &pb.ProposalSignedData{Slot: 5, BlockRootHash32: []byte{'B'}},
+	}
+	if err := service.HandleProposerSlashings(context.Background(), slashing); err != nil {
+		t.Error(err)
+	}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !beaconDB.HasProposerSlashing(hash) {
+		t.Error("Expected proposer slashing to be saved in DB")
+	}
+	testutil.AssertLogsContain(t, hook, "Proposer slashing saved in DB")
+}
+
+func TestIncomingAttesterSlashing_Ok(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+	setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+params.BeaconConfig().SlotsPerEpoch)
+
+	slashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{
+			ValidatorIndices: []uint64{1},
+			CustodyBitfield:  []byte{0x00},
+			Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: 1},
+		},
+		SlashableAttestation_2: &pb.SlashableAttestation{
+			ValidatorIndices: []uint64{1},
+			CustodyBitfield:  []byte{0x00},
+			Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: 0},
+		},
+	}
+	if err := service.HandleAttesterSlashings(context.Background(), slashing); err != nil {
+		t.Error(err)
+	}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !beaconDB.HasAttesterSlashing(hash) {
+		t.Error("Expected attester slashing to be saved in DB")
+	}
+	testutil.AssertLogsContain(t, hook, "Attester slashing saved in DB")
+}
+
+func TestIncomingSlashings_RejectsInvalidSlashings(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+	beaconState := setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+params.BeaconConfig().SlotsPerEpoch)
+	beaconState.ValidatorRegistry[1].SlashedEpoch = params.BeaconConfig().GenesisEpoch
+	if err := beaconDB.SaveState(context.Background(), beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	proposerSlashings := []*pb.ProposerSlashing{
+		// The proposer has already been slashed.
+		{
+			ProposerIndex:  1,
+			ProposalData_1: &pb.ProposalSignedData{Slot: 5, BlockRootHash32: []byte{'A'}},
+			ProposalData_2: &pb.ProposalSignedData{Slot: 5, BlockRootHash32: []byte{'B'}},
+		},
+		// The proposals are at different slots.
+		{
+			ProposerIndex:  2,
+			ProposalData_1: &pb.ProposalSignedData{Slot: 5, BlockRootHash32: []byte{'A'}},
+			ProposalData_2: &pb.ProposalSignedData{Slot: 6, BlockRootHash32: []byte{'B'}},
+		},
+	}
+	for _, slashing := range proposerSlashings {
+		if err := service.HandleProposerSlashings(context.Background(), slashing); err == nil {
+			t.Errorf("Expected invalid proposer slashing to be rejected: %v", slashing)
+		}
+	}
+	// The votes are neither a double vote nor a surround vote.
+	attesterSlashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{
+			ValidatorIndices: []uint64{2},
+			CustodyBitfield:  []byte{0x00},
+			Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: 0},
+		},
+		SlashableAttestation_2: &pb.SlashableAttestation{
+			ValidatorIndices: []uint64{2},
+			CustodyBitfield:  []byte{0x00},
+			Data:             &pb.AttestationData{Slot: 5 + params.BeaconConfig().SlotsPerEpoch, JustifiedEpoch: 0},
+		},
+	}
+	if err := service.HandleAttesterSlashings(context.Background(), attesterSlashing); err == nil {
+		t.Error("Expected invalid attester slashing to be rejected")
+	}
+
+	pendingProposerSlashings, err := beaconDB.ProposerSlashings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pendingAttesterSlashings, err := beaconDB.AttesterSlashings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pendingProposerSlashings) != 0 || len(pendingAttesterSlashings) != 0 {
+		t.Errorf("Expected no slashings in DB, received %v and %v", pendingProposerSlashings, pendingAttesterSlashings)
+	}
+}
+
+func TestSaveOperations_ReceivesSlashingsFromFeeds(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+	setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+params.BeaconConfig().SlotsPerEpoch)
+	exitRoutine := make(chan bool)
+	go func() {
+		service.saveOperations()
+		exitRoutine <- true
+	}()
+
+	slashing := &pb.ProposerSlashing{
+		ProposerIndex:  1,
+		ProposalData_1: &pb.ProposalSignedData{Slot: 5, BlockRootHash32: []byte{'A'}},
+		ProposalData_2: &pb.ProposalSignedData{Slot: 5, BlockRootHash32: []byte{'B'}},
+	}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
 		t.Fatal(err)
 	}
-	// Test we can retrieve attestations from slot1 - slot61.
-	attestations, err := service.PendingAttestations(context.Background())
+	// Wait for the service to subscribe to the feed.
+	for service.IncomingProposerSlashingFeed().Send(slashing) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	for i := 0; i < 100 && !beaconDB.HasProposerSlashing(hash); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	service.cancel()
+	<-exitRoutine
+	if !beaconDB.HasProposerSlashing(hash) {
+		t.Error("Expected proposer slashing from the feed to be saved in DB")
+	}
+}
+
+func TestRetrieveAttestations_OK(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+	headSlot := params.BeaconConfig().GenesisSlot + 150
+	beaconState := setupHeadState(t, beaconDB, headSlot)
+
+	// Save attestations for slots 87 to 150. A block proposed at slot 151 can only
+	// include the attestations from slot 87 up to slot 151 - MIN_ATTESTATION_INCLUSION_DELAY.
+	var origAttestations []*pb.Attestation
+	for slot := params.BeaconConfig().GenesisSlot + 87; slot <= headSlot; slot++ {
+		att := committeeAttestation(t, beaconState, slot)
+		if err := service.beaconDB.SaveAttestation(context.Background(), att); err != nil {
+			t.Fatalf("Failed to save attestation: %v", err)
+		}
+		origAttestations = append(origAttestations, att)
+	}
+	attestations, err := service.PendingAttestations(context.Background(), headSlot+1)
 	if err != nil {
 		t.Fatalf("Could not retrieve attestations: %v", err)
 	}
 
-	if !reflect.DeepEqual(attestations, origAttestations[1:128]) {
+	// The attestations of the last MIN_ATTESTATION_INCLUSION_DELAY - 1 slots are not ready yet.
+	lastReady := uint64(len(origAttestations)) - params.BeaconConfig().MinAttestationInclusionDelay + 1
+	if !reflect.DeepEqual(attestations, origAttestations[:lastReady]) {
 		t.Error("Retrieved attestations did not match")
 	}
 }
 
+func TestRetrieveAttestations_SkipsAttestationsNotIncludable(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+	headSlot := params.BeaconConfig().GenesisSlot + 150
+	beaconState := setupHeadState(t, beaconDB, headSlot)
+
+	cfg := params.BeaconConfig()
+	maxAttestations := cfg.MaxAttestations
+	cfg.MaxAttestations = 2
+	params.OverrideBeaconConfig(cfg)
+	defer func() {
+		cfg.MaxAttestations = maxAttestations
+		params.OverrideBeaconConfig(cfg)
+	}()
+
+	// The attestations which are not ready or not valid at the proposal slot have the
+	// most participants, so they would take the block capacity if they were scored.
+	notReady := committeeAttestation(t, beaconState, headSlot)
+	invalid := committeeAttestation(t, beaconState, headSlot-20)
+	invalid.Data.JustifiedEpoch++
+	first := committeeAttestation(t, beaconState, headSlot-30, 0, 1)
+	second := committeeAttestation(t, beaconState, headSlot-10, 0, 1)
+	third := committeeAttestation(t, beaconState, headSlot-5, 0)
+	for _, att := range []*pb.Attestation{notReady, invalid, first, second, third} {
+		if err := service.beaconDB.SaveAttestation(context.Background(), att); err != nil {
+			t.Fatalf("Failed to save attestation: %v", err)
+		}
+	}
+
+	attestations, err := service.PendingAttestations(context.Background(), headSlot+1)
+	if err != nil {
+		t.Fatalf("Could not retrieve attestations: %v", err)
+	}
+	if !reflect.DeepEqual(attestations, []*pb.Attestation{first, second}) {
+		t.Errorf("Expected attestations %v, received %v", []*pb.Attestation{first, second}, attestations)
+	}
+}
+
 func TestRetrieveAttestations_PruneInvalidAtts(t *testing.T) {
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
 	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+	beaconState := setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+200)
 
 	// Save 140 attestations for slots 0 to 139.
 	origAttestations := make([]*pb.Attestation, 140)
 	for i := 0; i < len(origAttestations); i++ {
 		origAttestations[i] = &pb.Attestation{
 			Data: &pb.AttestationData{
-				Slot:                    params.BeaconConfig().GenesisSlot + uint64(i),
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
+				Slot: params.BeaconConfig().GenesisSlot + uint64(i),
 			},
 		}
+		// Committees can only be computed for the previous, current and next epoch.
+		if origAttestations[i].Data.Slot >= params.BeaconConfig().GenesisSlot+128 {
+			origAttestations[i] = committeeAttestation(t, beaconState, origAttestations[i].Data.Slot)
+		}
 		if err := service.beaconDB.SaveAttestation(context.Background(), origAttestations[i]); err != nil {
 			t.Fatalf("Failed to save attestation: %v", err)
 		}
 	}
 
 	// At slot 200 only attestations up to from slot 137 to 139 are valid attestations.
-	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{
-		Slot: params.BeaconConfig().GenesisSlot + 200,
-		LatestCrosslinks: []*pb.Crosslink{{
-			Epoch:                   params.BeaconConfig().GenesisEpoch + 2,
-			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:]}}}); err != nil {
-		t.Fatal(err)
-	}
-	attestations, err := service.PendingAttestations(context.Background())
+	attestations, err := service.PendingAttestations(context.Background(), params.BeaconConfig().GenesisSlot+201)
 	if err != nil {
 		t.Fatalf("Could not retrieve attestations: %v", err)
 	}
@@ -202,28 +617,17 @@ func TestRemoveProcessedAttestations_Ok(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	s := NewOpsPoolService(context.Background(), &Config{BeaconDB: db})
+	beaconState := setupHeadState(t, db, params.BeaconConfig().GenesisSlot+15)
 
 	attestations := make([]*pb.Attestation, 10)
 	for i := 0; i < len(attestations); i++ {
-		attestations[i] = &pb.Attestation{
-			Data: &pb.AttestationData{
-				Slot:                    params.BeaconConfig().GenesisSlot + uint64(i),
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			},
-		}
+		attestations[i] = committeeAttestation(t, beaconState, params.BeaconConfig().GenesisSlot+uint64(i))
 		if err := s.beaconDB.SaveAttestation(context.Background(), attestations[i]); err != nil {
 			t.Fatalf("Failed to save attestation: %v", err)
 		}
 	}
-	if err := db.SaveState(context.Background(), &pb.BeaconState{
-		Slot: params.BeaconConfig().GenesisSlot + 15,
-		LatestCrosslinks: []*pb.Crosslink{{
-			Epoch:                   params.BeaconConfig().GenesisEpoch,
-			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:]}}}); err != nil {
-		t.Fatal(err)
-	}
 
-	retrievedAtts, err := s.PendingAttestations(context.Background())
+	retrievedAtts, err := s.PendingAttestations(context.Background(), params.BeaconConfig().GenesisSlot+16)
 	if err != nil {
 		t.Fatalf("Could not retrieve attestations: %v", err)
 	}
@@ -235,7 +639,7 @@ func TestRemoveProcessedAttestations_Ok(t *testing.T) {
 		t.Fatalf("Could not remove pending attestations: %v", err)
 	}
 
-	retrievedAtts, _ = s.PendingAttestations(context.Background())
+	retrievedAtts, _ = s.PendingAttestations(context.Background(), params.BeaconConfig().GenesisSlot+16)
 	if len(retrievedAtts) != 0 {
 		t.Errorf("Attestation pool should be empty but got a length of %d", len(retrievedAtts))
 	}
@@ -281,29 +685,17 @@ func TestReceiveBlkRemoveOps_Ok(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	s := NewOpsPoolService(context.Background(), &Config{BeaconDB: db})
+	beaconState := setupHeadState(t, db, params.BeaconConfig().GenesisSlot+15)
 
 	attestations := make([]*pb.Attestation, 10)
 	for i := 0; i < len(attestations); i++ {
-		attestations[i] = &pb.Attestation{
-			Data: &pb.AttestationData{
-				Slot:                    params.BeaconConfig().GenesisSlot + uint64(i),
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			},
-		}
+		attestations[i] = committeeAttestation(t, beaconState, params.BeaconConfig().GenesisSlot+uint64(i))
 		if err := s.beaconDB.SaveAttestation(context.Background(), attestations[i]); err != nil {
 			t.Fatalf("Failed to save attestation: %v", err)
 		}
 	}
 
-	if err := db.SaveState(context.Background(), &pb.BeaconState{
-		Slot: params.BeaconConfig().GenesisSlot + 15,
-		LatestCrosslinks: []*pb.Crosslink{{
-			Epoch:                   params.BeaconConfig().GenesisEpoch,
-			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:]}}}); err != nil {
-		t.Fatal(err)
-	}
-
-	atts, _ := s.PendingAttestations(context.Background())
+	atts, _ := s.PendingAttestations(context.Background(), params.BeaconConfig().GenesisSlot+16)
 	if len(atts) != len(attestations) {
 		t.Errorf("Attestation pool should be %d but got a length of %d",
 			len(attestations), len(atts))
@@ -320,8 +712,235 @@ func TestReceiveBlkRemoveOps_Ok(t *testing.T) {
 		t.Error(err)
 	}
 
-	atts, _ = s.PendingAttestations(context.Background())
+	atts, _ = s.PendingAttestations(context.Background(), params.BeaconConfig().GenesisSlot+16)
 	if len(atts) != 0 {
 		t.Errorf("Attestation pool should be empty but got a length of %d", len(atts))
 	}
 }
+
+func TestPendingProposerSlashings_PrunesAlreadySlashed(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch,
+		ValidatorRegistry: []*pb.Validator{
+			{SlashedEpoch: params.BeaconConfig().FarFutureEpoch},
+			{SlashedEpoch: params.BeaconConfig().GenesisEpoch},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	slashing := func(proposerIdx uint64, root byte) *pb.ProposerSlashing {
+		return &pb.ProposerSlashing{
+			ProposerIndex:  proposerIdx,
+			ProposalData_1: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{'A'}},
+			ProposalData_2: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{root}},
+		}
+	}
+	origSlashings := []*pb.ProposerSlashing{slashing(0, 'B'), slashing(0, 'C'), slashing(1, 'B')}
+	for _, s := range origSlashings {
+		if err := beaconDB.SaveProposerSlashing(context.Background(), s); err != nil {
+			t.Fatalf("Failed to save proposer slashing: %v", err)
+		}
+	}
+
+	slashings, err := service.PendingProposerSlashings(context.Background())
+	if err != nil {
+		t.Fatalf("Could not retrieve proposer slashings: %v", err)
+	}
+	if len(slashings) != 1 || slashings[0].ProposerIndex != 0 {
+		t.Errorf("Expected a single proposer slashing for proposer 0, received %v", slashings)
+	}
+	hash, err := hashutil.HashProto(origSlashings[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconDB.HasProposerSlashing(hash) {
+		t.Error("Proposer slashing of an already slashed proposer is not deleted")
+	}
+}
+
+func TestPendingAttesterSlashings_SkipsOverlappingSlashings(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	validators := make([]*pb.Validator, 4)
+	for i := range validators {
+		validators[i] = &pb.Validator{SlashedEpoch: params.BeaconConfig().FarFutureEpoch}
+	}
+	validators[3].SlashedEpoch = params.BeaconConfig().GenesisEpoch
+	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch,
+		ValidatorRegistry: validators,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	slashing := func(indices []uint64, justifiedEpoch uint64) *pb.AttesterSlashing {
+		return &pb.AttesterSlashing{
+			SlashableAttestation_1: &pb.SlashableAttestation{
+				Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: justifiedEpoch},
+				ValidatorIndices: indices,
+				CustodyBitfield:  []byte{0x00},
+			},
+			SlashableAttestation_2: &pb.SlashableAttestation{
+				Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: 0},
+				ValidatorIndices: indices,
+				CustodyBitfield:  []byte{0x00},
+			},
+		}
+	}
+	origSlashings := []*pb.AttesterSlashing{
+		slashing([]uint64{0, 1}, 1),
+		slashing([]uint64{1}, 2),
+		slashing([]uint64{3}, 1),
+		// Validator 2 is already slashed by another operation of the block.
+		slashing([]uint64{2}, 1),
+	}
+	for _, s := range origSlashings {
+		if err := beaconDB.SaveAttesterSlashing(context.Background(), s); err != nil {
+			t.Fatalf("Failed to save attester slashing: %v", err)
+		}
+	}
+
+	slashed := map[uint64]bool{2: true}
+	slashings, err := service.PendingAttesterSlashings(context.Background(), slashed)
+	if err != nil {
+		t.Fatalf("Could not retrieve attester slashings: %v", err)
+	}
+	if len(slashings) != 1 {
+		t.Fatalf("Expected 1 attester slashing, received %d", len(slashings))
+	}
+	if !reflect.DeepEqual(slashings[0].SlashableAttestation_1.ValidatorIndices, []uint64{0, 1}) {
+		t.Errorf("Unexpected attester slashing selected: %v", slashings[0])
+	}
+	if !reflect.DeepEqual(slashed, map[uint64]bool{0: true, 1: true, 2: true}) {
+		t.Errorf("Expected the selected slashing to be added to the slashed validators, received %v", slashed)
+	}
+	hash, err := hashutil.HashProto(origSlashings[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconDB.HasAttesterSlashing(hash) {
+		t.Error("Attester slashing of an already slashed validator is not deleted")
+	}
+}
+
+func TestPendingExits_SkipsInvalidExits(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch,
+		ValidatorRegistry: []*pb.Validator{
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	origExits := []*pb.VoluntaryExit{
+		{ValidatorIndex: 0, Epoch: params.BeaconConfig().GenesisEpoch},
+		{ValidatorIndex: 0, Epoch: params.BeaconConfig().GenesisEpoch + 1},
+		{ValidatorIndex: 1, Epoch: params.BeaconConfig().GenesisEpoch + 10},
+	}
+	for _, exit := range origExits {
+		if err := beaconDB.SaveExit(context.Background(), exit); err != nil {
+			t.Fatalf("Failed to save exit request: %v", err)
+		}
+	}
+
+	exits, err := service.PendingExits(context.Background())
+	if err != nil {
+		t.Fatalf("Could not retrieve exit requests: %v", err)
+	}
+	if !reflect.DeepEqual(exits, origExits[:1]) {
+		t.Errorf("Expected exits %v, received %v", origExits[:1], exits)
+	}
+	// Exit requests which are not valid yet are kept in DB.
+	hash, err := hashutil.HashProto(origExits[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !beaconDB.HasExit(hash) {
+		t.Error("Exit request for a future epoch should not be deleted")
+	}
+}
+
+func TestReceiveBlkRemoveSlashingsAndExits_Ok(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	s := NewOpsPoolService(context.Background(), &Config{BeaconDB: db})
+
+	proposerSlashing := &pb.ProposerSlashing{ProposerIndex: 1}
+	attesterSlashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{2}},
+	}
+	exit := &pb.VoluntaryExit{ValidatorIndex: 3}
+	if err := db.SaveProposerSlashing(context.Background(), proposerSlashing); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttesterSlashing(context.Background(), attesterSlashing); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveExit(context.Background(), exit); err != nil {
+		t.Fatal(err)
+	}
+
+	block := &pb.BeaconBlock{
+		Body: &pb.BeaconBlockBody{
+			ProposerSlashings: []*pb.ProposerSlashing{proposerSlashing},
+			AttesterSlashings: []*pb.AttesterSlashing{attesterSlashing},
+			VoluntaryExits:    []*pb.VoluntaryExit{exit},
+		},
+	}
+	if err := s.handleProcessedBlock(context.Background(), block); err != nil {
+		t.Error(err)
+	}
+
+	proposerSlashings, err := db.ProposerSlashings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	attesterSlashings, err := db.AttesterSlashings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exits, err := db.Exits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proposerSlashings) != 0 || len(attesterSlashings) != 0 || len(exits) != 0 {
+		t.Errorf("Expected processed operations to be removed, received %d proposer slashings, "+
+			"%d attester slashings and %d exits", len(proposerSlashings), len(attesterSlashings), len(exits))
+	}
+}
+
+func TestRemovePendingAttestations_RemovesCoveredAttestations(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	s := NewOpsPoolService(context.Background(), &Config{BeaconDB: db})
+
+	data := &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1}
+	for _, bitfield := range [][]byte{{0x80}, {0x40}, {0x21}} {
+		att := &pb.Attestation{AggregationBitfield: bitfield, Data: data}
+		if err := db.SaveAttestation(context.Background(), att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	included := &pb.Attestation{AggregationBitfield: []byte{0xC1}, Data: data}
+	if err := s.removePendingAttestations([]*pb.Attestation{included}); err != nil {
+		t.Fatal(err)
+	}
+	atts, err := db.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) != 1 || !bytes.Equal(atts[0].AggregationBitfield, []byte{0x21}) {
+		t.Errorf("Expected only the uncovered attestation to remain, received %v", atts)
+	}
+}
@@ -40,8 +40,9 @@ var (
 
 // blockInfo specifies the block information in the ETH 1.0 chain.
 type blockInfo struct {
-	Number *big.Int
-	Hash   common.Hash
+	Number     *big.Int
+	Hash       common.Hash
+	ParentHash common.Hash
 }
 
 // hashKeyFn takes the hex string representation as the key for a blockInfo.
@@ -133,6 +134,31 @@ func (b *blockCache) BlockInfoByHeight(height *big.Int) (bool, *blockInfo, error
 	return exists, bInfo, nil
 }
 
+// HeightRange returns the lowest and highest block numbers in the cache, and false
+// if the cache is empty.
+func (b *blockCache) HeightRange() (*big.Int, *big.Int, bool, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	var lowest, highest *big.Int
+	for _, obj := range b.heightCache.List() {
+		bInfo, ok := obj.(*blockInfo)
+		if !ok {
+			return nil, nil, false, ErrNotABlockInfo
+		}
+		if lowest == nil || bInfo.Number.Cmp(lowest) < 0 {
+			lowest = bInfo.Number
+		}
+		if highest == nil || bInfo.Number.Cmp(highest) > 0 {
+			highest = bInfo.Number
+		}
+	}
+	if lowest == nil {
+		return nil, nil, false, nil
+	}
+	return new(big.Int).Set(lowest), new(big.Int).Set(highest), true, nil
+}
+
 // AddBlock adds a blockInfo object to the cache. This method also trims the
 // least recently added block info if the cache size has reached the max cache
 // size limit. This method should be called in sequential block number order if
@@ -143,8 +169,9 @@ func (b *blockCache) AddBlock(blk *gethTypes.Block) error {
 	defer b.lock.Unlock()
 
 	bInfo := &blockInfo{
-		Hash:   blk.Hash(),
-		Number: blk.Number(),
+		Hash:       blk.Hash(),
+		Number:     blk.Number(),
+		ParentHash: blk.ParentHash(),
 	}
 
 	if err := b.hashCache.AddIfNotPresent(bInfo); err != nil {
@@ -162,6 +189,33 @@ func (b *blockCache) AddBlock(blk *gethTypes.Block) error {
 	return nil
 }
 
+// RemoveBlocksAfter removes the block info of every block with a number higher
+// than the given height, such as the blocks orphaned by a reorg of the chain.
+func (b *blockCache) RemoveBlocksAfter(height *big.Int) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for _, obj := range b.heightCache.List() {
+		bInfo, ok := obj.(*blockInfo)
+		if !ok {
+			return ErrNotABlockInfo
+		}
+		if bInfo.Number.Cmp(height) <= 0 {
+			continue
+		}
+		if err := b.heightCache.Delete(bInfo); err != nil {
+			return err
+		}
+		if err := b.hashCache.Delete(bInfo); err != nil {
+			return err
+		}
+	}
+
+	blockCacheSize.Set(float64(len(b.hashCache.ListKeys())))
+
+	return nil
+}
+
 // trim the FIFO queue to the maxSize.
 func trim(queue *cache.FIFO, maxSize int) {
 	for s := len(queue.ListKeys()); s > maxSize; s-- {
@@ -163,3 +163,55 @@ func TestBlockCache_maxSize(t *testing.T) {
 		)
 	}
 }
+
+func TestBlockCache_RemoveBlocksAfter(t *testing.T) {
+	cache := newBlockCache()
+
+	var headers []*gethTypes.Header
+	for i := int64(0); i < 4; i++ {
+		header := &gethTypes.Header{Number: big.NewInt(i)}
+		if err := cache.AddBlock(gethTypes.NewBlockWithHeader(header)); err != nil {
+			t.Fatal(err)
+		}
+		headers = append(headers, header)
+	}
+	if err := cache.RemoveBlocksAfter(big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, header := range headers {
+		existsByHash, _, err := cache.BlockInfoByHash(header.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		existsByHeight, _, err := cache.BlockInfoByHeight(header.Number)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := i <= 1; existsByHash != want || existsByHeight != want {
+			t.Errorf("Expected block %d to exist: %v, received %v by hash and %v by height",
+				i, want, existsByHash, existsByHeight)
+		}
+	}
+}
+
+func TestBlockCache_HeightRange(t *testing.T) {
+	cache := newBlockCache()
+	if _, _, ok, err := cache.HeightRange(); err != nil || ok {
+		t.Fatalf("Expected no range for an empty cache, received %v, %v", ok, err)
+	}
+
+	for _, i := range []int64{5, 3, 4} {
+		header := &gethTypes.Header{Number: big.NewInt(i)}
+		if err := cache.AddBlock(gethTypes.NewBlockWithHeader(header)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lowest, highest, ok, err := cache.HeightRange()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || lowest.Int64() != 3 || highest.Int64() != 5 {
+		t.Errorf("Expected range 3 to 5, received %v to %v", lowest, highest)
+	}
+}
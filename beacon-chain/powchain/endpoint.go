@@ -0,0 +1,320 @@
+package powchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// defaultHealthCheckInterval is how often endpoints are probed when the config
+	// does not specify an interval.
+	defaultHealthCheckInterval = 10 * time.Second
+	// probeTimeout bounds the requests made to probe the health of an endpoint.
+	probeTimeout = 5 * time.Second
+	// maxResubscribeBackoff is the longest wait between attempts to re-establish the
+	// header subscription.
+	maxResubscribeBackoff = 30 * time.Second
+
+	errEndpointSwitched = errors.New("active eth1 endpoint switched")
+	errNotConnected     = errors.New("not connected")
+
+	// Metrics
+	endpointHealthyGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_healthy",
+		Help: "Whether the ETH1.0 endpoint was healthy when last probed, 1 if healthy and 0 otherwise",
+	}, []string{"endpoint"})
+	endpointBlockNumberGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_block_number",
+		Help: "The latest block number reported by the ETH1.0 endpoint",
+	}, []string{"endpoint"})
+	endpointFailoverCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_endpoint_failovers",
+		Help: "The number of times the powchain service switched to another ETH1.0 endpoint",
+	})
+)
+
+// Endpoint defines an ETH1.0 node the powchain service can follow. The Client,
+// Reader, Logger and ContractBackend use the IPC or WebSocket connection to the
+// node, while HTTPLogger and BlockFetcher use its HTTP connection.
+type Endpoint struct {
+	URL             string
+	Client          Client
+	Reader          Reader
+	Logger          bind.ContractFilterer
+	HTTPLogger      bind.ContractFilterer
+	BlockFetcher    POWBlockFetcher
+	ContractBackend bind.ContractBackend
+	// SyncReader reports whether the node is still syncing. Endpoints without a
+	// sync reader are only probed for their latest block.
+	SyncReader ethereum.ChainSyncReader
+	// Dial connects to the node. It is only set on endpoints which could not be
+	// connected to yet, which are dialed again whenever the endpoints are probed.
+	Dial func() (*Endpoint, error)
+}
+
+// endpointState holds an endpoint along with its health as of the last probe.
+type endpointState struct {
+	*Endpoint
+	depositContractCaller *contracts.DepositContractCaller
+	err                   error
+	blockNumber           *big.Int
+}
+
+// probe returns the latest block number of the endpoint, or an error if the
+// endpoint cannot be reached or is still syncing.
+func (e *Endpoint) probe(ctx context.Context) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	if e.SyncReader != nil {
+		progress, err := e.SyncReader.SyncProgress(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get sync status: %v", err)
+		}
+		if progress != nil {
+			return nil, fmt.Errorf("node is syncing, at block %d of %d", progress.CurrentBlock, progress.HighestBlock)
+		}
+	}
+	header, err := e.BlockFetcher.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest header: %v", err)
+	}
+	return header.Number, nil
+}
+
+// monitorEndpoints probes the endpoints at every health check interval until stop
+// is closed. It runs apart from the main event loop, so slow endpoints do not
+// hold up the processing of headers and logs.
+func (w *Web3Service) monitorEndpoints(stop <-chan struct{}) {
+	healthTicker := time.NewTicker(w.healthCheckInterval)
+	defer healthTicker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-healthTicker.C:
+			w.probeEndpoints()
+		}
+	}
+}
+
+// probeEndpoints checks the sync status and latest block of every endpoint. An
+// endpoint lagging more than LogBlockDelay blocks behind the highest endpoint is
+// considered unhealthy. The service then fails over to the first healthy endpoint,
+// in the order of preference of the config.
+func (w *Web3Service) probeEndpoints() {
+	blockNumbers := make([]*big.Int, len(w.endpoints))
+	errs := make([]error, len(w.endpoints))
+	dialed := make([]*Endpoint, len(w.endpoints))
+	var wg sync.WaitGroup
+	for i, e := range w.endpoints {
+		wg.Add(1)
+		go func(i int, e *Endpoint) {
+			defer wg.Done()
+			if e.Dial != nil {
+				endpoint, err := e.Dial()
+				if err != nil {
+					errs[i] = fmt.Errorf("could not connect: %v", err)
+					return
+				}
+				dialed[i] = endpoint
+				e = endpoint
+			}
+			blockNumbers[i], errs[i] = e.probe(w.ctx)
+		}(i, e.Endpoint)
+	}
+	wg.Wait()
+	highest := big.NewInt(0)
+	for i := range w.endpoints {
+		if errs[i] == nil && blockNumbers[i].Cmp(highest) > 0 {
+			highest = blockNumbers[i]
+		}
+	}
+	maxLag := big.NewInt(params.BeaconConfig().LogBlockDelay)
+	for i := range w.endpoints {
+		if errs[i] != nil {
+			continue
+		}
+		if lag := big.NewInt(0).Sub(highest, blockNumbers[i]); lag.Cmp(maxLag) > 0 {
+			errs[i] = fmt.Errorf("%v blocks behind the highest endpoint", lag)
+		}
+	}
+
+	w.endpointLock.Lock()
+	for i, e := range w.endpoints {
+		if dialed[i] != nil {
+			depositContractCaller, err := contracts.NewDepositContractCaller(w.depositContractAddress, dialed[i].ContractBackend)
+			if err != nil {
+				errs[i] = fmt.Errorf("could not create deposit contract caller: %v", err)
+			} else {
+				log.WithField("endpoint", e.URL).Info("Connected to ETH1.0 endpoint")
+				e.Endpoint = dialed[i]
+				e.depositContractCaller = depositContractCaller
+			}
+		}
+		e.err = errs[i]
+		e.blockNumber = blockNumbers[i]
+		if e.err != nil {
+			log.WithField("endpoint", e.URL).Debugf("ETH1.0 endpoint is unhealthy: %v", e.err)
+			endpointHealthyGauge.WithLabelValues(e.URL).Set(0)
+			continue
+		}
+		endpointHealthyGauge.WithLabelValues(e.URL).Set(1)
+		endpointBlockNumberGauge.WithLabelValues(e.URL).Set(float64(e.blockNumber.Int64()))
+	}
+	activeEndpoint := w.activeEndpoint
+	w.endpointLock.Unlock()
+
+	for i, e := range w.endpoints {
+		if e.err == nil {
+			if i != activeEndpoint {
+				w.switchEndpoint(i)
+			}
+			return
+		}
+	}
+	log.Error("No healthy ETH1.0 endpoint available")
+}
+
+// switchEndpoint makes the endpoint at the given index the one followed by the
+// service, and ends the header subscription to the previous endpoint so that it
+// is re-established on the new one.
+func (w *Web3Service) switchEndpoint(index int) {
+	w.endpointLock.Lock()
+	defer w.endpointLock.Unlock()
+
+	previous := w.endpoints[w.activeEndpoint]
+	e := w.endpoints[index]
+	w.activeEndpoint = index
+	w.endpoint = e.URL
+	w.client = e.Client
+	w.reader = e.Reader
+	w.logger = e.Logger
+	w.httpLogger = e.HTTPLogger
+	w.blockFetcher = e.BlockFetcher
+	w.depositContractCaller = e.depositContractCaller
+	close(w.endpointSwitched)
+	w.endpointSwitched = make(chan struct{})
+
+	endpointFailoverCount.Inc()
+	log.WithFields(logrus.Fields{
+		"previousEndpoint": previous.URL,
+		"endpoint":         e.URL,
+	}).Warn("Switched ETH1.0 endpoint")
+}
+
+// activeClient returns the client of the active endpoint. The connections to the
+// active endpoint are replaced on failover, so they are read holding the lock.
+func (w *Web3Service) activeClient() Client {
+	w.endpointLock.RLock()
+	defer w.endpointLock.RUnlock()
+	return w.client
+}
+
+// activeReader returns the header reader of the active endpoint.
+func (w *Web3Service) activeReader() Reader {
+	w.endpointLock.RLock()
+	defer w.endpointLock.RUnlock()
+	return w.reader
+}
+
+// activeHTTPLogger returns the log filterer of the active endpoint.
+func (w *Web3Service) activeHTTPLogger() bind.ContractFilterer {
+	w.endpointLock.RLock()
+	defer w.endpointLock.RUnlock()
+	return w.httpLogger
+}
+
+// activeBlockFetcher returns the block fetcher of the active endpoint.
+func (w *Web3Service) activeBlockFetcher() POWBlockFetcher {
+	w.endpointLock.RLock()
+	defer w.endpointLock.RUnlock()
+	return w.blockFetcher
+}
+
+// activeDepositContract returns the deposit contract caller of the active endpoint.
+func (w *Web3Service) activeDepositContract() *contracts.DepositContractCaller {
+	w.endpointLock.RLock()
+	defer w.endpointLock.RUnlock()
+	return w.depositContractCaller
+}
+
+// subscribeNewHead keeps a header subscription to the active endpoint through
+// event.Resubscribe, starting from the given subscription established on the
+// active endpoint. The subscription is re-established whenever it fails or the
+// active endpoint changes.
+func (w *Web3Service) subscribeNewHead(initial ethereum.Subscription) event.Subscription {
+	w.endpointLock.RLock()
+	url, switched := w.endpoint, w.endpointSwitched
+	w.endpointLock.RUnlock()
+	initialSub := w.followEndpointSwitch(initial, url, switched)
+
+	return event.Resubscribe(maxResubscribeBackoff, func(ctx context.Context) (event.Subscription, error) {
+		if initialSub != nil {
+			sub := initialSub
+			initialSub = nil
+			return sub, nil
+		}
+		w.endpointLock.RLock()
+		reader, url, switched := w.reader, w.endpoint, w.endpointSwitched
+		w.endpointLock.RUnlock()
+
+		sub, err := reader.SubscribeNewHead(w.ctx, w.headerChan)
+		if err != nil {
+			log.WithField("endpoint", url).Errorf("Unable to subscribe to incoming ETH1.0 chain headers: %v", err)
+			return nil, err
+		}
+		log.WithField("endpoint", url).Info("Subscribed to incoming ETH1.0 chain headers")
+		return w.followEndpointSwitch(sub, url, switched), nil
+	})
+}
+
+// followEndpointSwitch wraps a header subscription so that it fails once the
+// given switched channel is closed, making event.Resubscribe subscribe again to
+// the new active endpoint.
+func (w *Web3Service) followEndpointSwitch(sub ethereum.Subscription, url string, switched chan struct{}) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		select {
+		case err := <-sub.Err():
+			log.WithField("endpoint", url).Errorf("ETH1.0 chain header subscription failed: %v", err)
+			return err
+		case <-switched:
+			return errEndpointSwitched
+		case <-quit:
+			return nil
+		}
+	})
+}
+
+// endpointsStatus returns an error describing every endpoint which was unhealthy
+// when last probed, or nil if all endpoints are healthy.
+func (w *Web3Service) endpointsStatus() error {
+	w.endpointLock.RLock()
+	defer w.endpointLock.RUnlock()
+
+	var unhealthy []string
+	for _, e := range w.endpoints {
+		if e.err != nil {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s: %v", e.URL, e.err))
+		}
+	}
+	if len(unhealthy) > 0 {
+		return fmt.Errorf("unhealthy eth1 endpoints: %s", strings.Join(unhealthy, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package powchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+type heightFetcher struct {
+	goodFetcher
+	height int64
+	err    error
+}
+
+func (h *heightFetcher) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	if h.err != nil {
+		return nil, h.err
+	}
+	return &gethTypes.Header{Number: big.NewInt(h.height)}, nil
+}
+
+type syncingReader struct{}
+
+func (s *syncingReader) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error) {
+	return &ethereum.SyncProgress{CurrentBlock: 10, HighestBlock: 100}, nil
+}
+
+type recordingReader struct {
+	subscribed chan bool
+}
+
+func (r *recordingReader) SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error) {
+	r.subscribed <- true
+	return new(event.Feed).Subscribe(ch), nil
+}
+
+func setupEndpoints(t *testing.T, endpoints ...*Endpoint) *Web3Service {
+	for _, e := range endpoints {
+		if e.Reader == nil {
+			e.Reader = &goodReader{}
+		}
+	}
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		DepositContract: common.Address{},
+		Endpoints:       endpoints,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	return web3Service
+}
+
+func TestProbeEndpoints_FailsOverToHealthyEndpoint(t *testing.T) {
+	failing := &heightFetcher{err: errors.New("connection refused")}
+	web3Service := setupEndpoints(t,
+		&Endpoint{URL: "ws://a", BlockFetcher: failing},
+		&Endpoint{URL: "ws://b", BlockFetcher: &heightFetcher{height: 100}},
+	)
+
+	web3Service.probeEndpoints()
+	if web3Service.activeEndpoint != 1 || web3Service.endpoint != "ws://b" {
+		t.Errorf("Expected to fail over to ws://b, following %s", web3Service.endpoint)
+	}
+	if web3Service.activeBlockFetcher() != web3Service.endpoints[1].BlockFetcher {
+		t.Error("Expected block fetcher of ws://b to be used")
+	}
+	err := web3Service.endpointsStatus()
+	if err == nil || !strings.Contains(err.Error(), "ws://a") {
+		t.Errorf("Expected status to report ws://a as unhealthy, received %v", err)
+	}
+
+	// The preferred endpoint is followed again once it is healthy.
+	failing.err = nil
+	failing.height = 100
+	web3Service.probeEndpoints()
+	if web3Service.activeEndpoint != 0 {
+		t.Errorf("Expected to switch back to ws://a, following %s", web3Service.endpoint)
+	}
+	if err := web3Service.endpointsStatus(); err != nil {
+		t.Errorf("Expected all endpoints to be healthy, received %v", err)
+	}
+}
+
+func TestProbeEndpoints_SkipsSyncingAndLaggingEndpoints(t *testing.T) {
+	web3Service := setupEndpoints(t,
+		&Endpoint{URL: "ws://a", BlockFetcher: &heightFetcher{height: 100}, SyncReader: &syncingReader{}},
+		&Endpoint{URL: "ws://b", BlockFetcher: &heightFetcher{height: 10}},
+		&Endpoint{URL: "ws://c", BlockFetcher: &heightFetcher{height: 99}},
+	)
+
+	web3Service.probeEndpoints()
+	if web3Service.activeEndpoint != 2 {
+		t.Errorf("Expected to follow ws://c, following %s", web3Service.endpoint)
+	}
+	err := web3Service.endpointsStatus()
+	if err == nil || !strings.Contains(err.Error(), "syncing") || !strings.Contains(err.Error(), "behind") {
+		t.Errorf("Expected status to report syncing and lagging endpoints, received %v", err)
+	}
+}
+
+func TestProbeEndpoints_ConnectsEndpointsDialedLater(t *testing.T) {
+	dialed := &Endpoint{URL: "ws://a", Reader: &goodReader{}, BlockFetcher: &heightFetcher{height: 100}}
+	dialErr := errors.New("connection refused")
+	web3Service := setupEndpoints(t,
+		&Endpoint{URL: "ws://a", Dial: func() (*Endpoint, error) {
+			if dialErr != nil {
+				return nil, dialErr
+			}
+			return dialed, nil
+		}},
+		&Endpoint{URL: "ws://b", BlockFetcher: &heightFetcher{height: 100}},
+	)
+	if web3Service.activeEndpoint != 1 {
+		t.Fatalf("Expected to start following ws://b, following %s", web3Service.endpoint)
+	}
+
+	web3Service.probeEndpoints()
+	err := web3Service.endpointsStatus()
+	if err == nil || !strings.Contains(err.Error(), "could not connect") {
+		t.Errorf("Expected status to report ws://a as not connected, received %v", err)
+	}
+
+	dialErr = nil
+	web3Service.probeEndpoints()
+	if web3Service.activeEndpoint != 0 {
+		t.Errorf("Expected to switch to ws://a once connected, following %s", web3Service.endpoint)
+	}
+	if web3Service.activeBlockFetcher() != dialed.BlockFetcher {
+		t.Error("Expected block fetcher of the dialed endpoint to be used")
+	}
+}
+
+func TestNewWeb3Service_RequiresConnectedEndpoint(t *testing.T) {
+	_, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoints: []*Endpoint{{URL: "ws://a", Dial: func() (*Endpoint, error) {
+			return nil, errors.New("connection refused")
+		}}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "could not connect to any") {
+		t.Errorf("Expected error without a connected endpoint, received %v", err)
+	}
+}
+
+func TestSubscribeNewHead_ResubscribesAfterSwitch(t *testing.T) {
+	reader := &recordingReader{subscribed: make(chan bool, 1)}
+	web3Service := setupEndpoints(t,
+		&Endpoint{URL: "ws://a"},
+		&Endpoint{URL: "ws://b", Reader: reader},
+	)
+
+	initial, err := web3Service.reader.SubscribeNewHead(web3Service.ctx, web3Service.headerChan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headSub := web3Service.subscribeNewHead(initial)
+	defer headSub.Unsubscribe()
+
+	web3Service.switchEndpoint(1)
+	select {
+	case <-reader.subscribed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected header subscription to be re-established on the new endpoint")
+	}
+}
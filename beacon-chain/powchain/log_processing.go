@@ -21,6 +21,10 @@ import (
 )
 
 var (
+	// maxLogRequestRange is the largest range of blocks whose logs are requested at
+	// once, as ETH1.0 providers limit the size of log queries.
+	maxLogRequestRange = int64(1000)
+
 	depositEventSignature    = []byte("Deposit(bytes32,bytes,bytes,bytes32[32])")
 	chainStartEventSignature = []byte("ChainStart(bytes32,bytes)")
 )
@@ -28,7 +32,7 @@ var (
 // HasChainStartLogOccurred queries all logs in the deposit contract to verify
 // if ChainStart has occurred. If so, it returns true alongside the ChainStart timestamp.
 func (w *Web3Service) HasChainStartLogOccurred() (bool, uint64, error) {
-	genesisTime, err := w.depositContractCaller.GenesisTime(&bind.CallOpts{})
+	genesisTime, err := w.activeDepositContract().GenesisTime(&bind.CallOpts{})
 	if err != nil {
 		return false, 0, fmt.Errorf("could not query contract to verify chain started: %v", err)
 	}
@@ -102,6 +106,9 @@ func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
 	w.beaconDB.InsertDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber)))
 
 	if !w.chainStarted {
+		if err := w.beaconDB.SaveDepositTrieItem(w.ctx, uint64(len(w.chainStartDeposits)), depositData); err != nil {
+			log.Errorf("Could not persist deposit trie item: %v", err)
+		}
 		w.chainStartDeposits = append(w.chainStartDeposits, depositData)
 	} else {
 		w.beaconDB.InsertPendingDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber)))
@@ -135,6 +142,9 @@ func (w *Web3Service) ProcessChainStartLog(depositLog gethTypes.Log) {
 	}
 
 	timestamp := binary.LittleEndian.Uint64(timestampData)
+	if err := w.beaconDB.SaveChainStart(w.ctx, w.chainStartETH1Data, timestamp); err != nil {
+		log.Errorf("Could not persist ChainStart data: %v", err)
+	}
 	w.chainStarted = true
 	w.depositRoot = chainStartDepositRoot[:]
 	chainStartTime := time.Unix(int64(timestamp), 0)
@@ -157,24 +167,85 @@ func (w *Web3Service) ProcessChainStartLog(depositLog gethTypes.Log) {
 	w.chainStartFeed.Send(chainStartTime)
 }
 
+// restorePersistedLogs restores the deposit trie, the ChainStart data and the last
+// processed ETH1 block from the db, so that a restarted node resumes processing the
+// deposit contract logs where it stopped. It returns the persisted genesis time, or
+// zero if the ChainStart log has not been processed yet.
+func (w *Web3Service) restorePersistedLogs() (uint64, error) {
+	items, err := w.beaconDB.DepositTrieItems(w.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("could not get deposit trie items: %v", err)
+	}
+	if len(items) > 0 {
+		w.chainStartDeposits = items
+	}
+	if deposits := w.beaconDB.AllDeposits(w.ctx, nil); len(deposits) > 0 {
+		w.lastReceivedMerkleIndex = int64(deposits[len(deposits)-1].MerkleTreeIndex)
+	}
+
+	eth1Data, genesisTime, err := w.beaconDB.ChainStart(w.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("could not get ChainStart data: %v", err)
+	}
+	if eth1Data != nil {
+		sparseMerkleTrie, err := trieutil.GenerateTrieFromItems(
+			w.chainStartDeposits,
+			int(params.BeaconConfig().DepositContractTreeDepth),
+		)
+		if err != nil {
+			return 0, fmt.Errorf("could not generate deposit trie from persisted deposits: %v", err)
+		}
+		w.depositTrie = sparseMerkleTrie
+		w.chainStartETH1Data = eth1Data
+		w.depositRoot = eth1Data.DepositRootHash32
+		w.chainStarted = true
+	}
+
+	lastBlock, err := w.beaconDB.LastProcessedETH1Block(w.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("could not get last processed eth1 block: %v", err)
+	}
+	if lastBlock != nil {
+		w.lastRequestedBlock.Set(lastBlock)
+		log.WithFields(logrus.Fields{
+			"blockNumber": lastBlock,
+			"deposits":    w.lastReceivedMerkleIndex + 1,
+		}).Info("Resuming deposit log processing from persisted eth1 block")
+	}
+	return genesisTime, nil
+}
+
 // processPastLogs processes all the past logs from the deposit contract and
-// updates the deposit trie with the data from each individual log.
+// updates the deposit trie with the data from each individual log. Logs up to
+// the last processed block of a previous run are restored from the db instead.
 func (w *Web3Service) processPastLogs() error {
+	genesisTime, err := w.restorePersistedLogs()
+	if err != nil {
+		return err
+	}
+
 	query := ethereum.FilterQuery{
 		Addresses: []common.Address{
 			w.depositContractAddress,
 		},
 	}
+	if w.lastRequestedBlock.Sign() > 0 {
+		query.FromBlock = big.NewInt(0).Add(w.lastRequestedBlock, big.NewInt(1))
+	}
 
-	logs, err := w.httpLogger.FilterLogs(w.ctx, query)
+	logs, err := w.activeHTTPLogger().FilterLogs(w.ctx, query)
 	if err != nil {
 		return err
 	}
 
+	chainStarted := w.chainStarted
 	for _, log := range logs {
 		w.ProcessLog(log)
 	}
 	w.lastRequestedBlock.Set(w.blockHeight)
+	if err := w.beaconDB.SaveLastProcessedETH1Block(w.ctx, w.lastRequestedBlock); err != nil {
+		return fmt.Errorf("could not save last processed eth1 block: %v", err)
+	}
 
 	currentState, err := w.beaconDB.HeadState(w.ctx)
 	if err != nil {
@@ -183,36 +254,59 @@ func (w *Web3Service) processPastLogs() error {
 	if currentState != nil && currentState.DepositIndex > 0 {
 		w.beaconDB.PrunePendingDeposits(w.ctx, currentState.DepositIndex)
 	}
+	// The ChainStart log was processed by a previous run which stopped before the
+	// beacon chain was initialized, so the chain start is announced again.
+	if chainStarted && currentState == nil {
+		w.chainStartFeed.Send(time.Unix(int64(genesisTime), 0))
+	}
 
 	return nil
 }
 
 // requestBatchedLogs requests and processes all the logs from the period
-// last polled to now.
+// last polled to now, in ranges of at most maxLogRequestRange blocks.
 func (w *Web3Service) requestBatchedLogs() error {
 	// We request for the nth block behind the current head, in order to have
 	// stabilized logs when we retrieve it from the 1.0 chain.
 	requestedBlock := big.NewInt(0).Sub(w.blockHeight, big.NewInt(params.BeaconConfig().LogBlockDelay))
-	query := ethereum.FilterQuery{
-		Addresses: []common.Address{
-			w.depositContractAddress,
-		},
-		FromBlock: w.lastRequestedBlock.Add(w.lastRequestedBlock, big.NewInt(1)),
-		ToBlock:   requestedBlock,
-	}
-	logs, err := w.httpLogger.FilterLogs(w.ctx, query)
-	if err != nil {
-		return err
-	}
+	for w.lastRequestedBlock.Cmp(requestedBlock) < 0 {
+		fromBlock := big.NewInt(0).Add(w.lastRequestedBlock, big.NewInt(1))
+		toBlock := big.NewInt(0).Add(w.lastRequestedBlock, big.NewInt(maxLogRequestRange))
+		if toBlock.Cmp(requestedBlock) > 0 {
+			toBlock = requestedBlock
+		}
+		query := ethereum.FilterQuery{
+			Addresses: []common.Address{
+				w.depositContractAddress,
+			},
+			FromBlock: fromBlock,
+			ToBlock:   toBlock,
+		}
+		logs, err := w.activeHTTPLogger().FilterLogs(w.ctx, query)
+		if err != nil {
+			return err
+		}
+		reorged, err := w.checkLogBlockHashes(logs)
+		if err != nil {
+			return err
+		}
+		// The logs are requested again from the common ancestor.
+		if reorged {
+			continue
+		}
 
-	// Only process log slices which are larger than zero.
-	if len(logs) > 0 {
-		log.Debug("Processing Batched Logs")
-		for _, log := range logs {
-			w.ProcessLog(log)
+		// Only process log slices which are larger than zero.
+		if len(logs) > 0 {
+			log.Debug("Processing Batched Logs")
+			for _, log := range logs {
+				w.ProcessLog(log)
+			}
 		}
-	}
 
-	w.lastRequestedBlock.Set(requestedBlock)
+		w.lastRequestedBlock.Set(toBlock)
+		if err := w.beaconDB.SaveLastProcessedETH1Block(w.ctx, w.lastRequestedBlock); err != nil {
+			return fmt.Errorf("could not save last processed eth1 block: %v", err)
+		}
+	}
 	return nil
 }
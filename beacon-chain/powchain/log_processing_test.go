@@ -4,19 +4,23 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/ssz"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -34,6 +38,11 @@ func TestProcessDepositLog_OK(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unable to set up simulated backend %v", err)
 	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
 	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
 		Endpoint:        endpoint,
 		DepositContract: testAcc.contractAddr,
@@ -41,7 +50,7 @@ func TestProcessDepositLog_OK(t *testing.T) {
 		Logger:          &goodLogger{},
 		HTTPLogger:      &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        beaconDB,
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -97,6 +106,11 @@ func TestProcessDepositLog_InsertsPendingDeposit(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unable to set up simulated backend %v", err)
 	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
 	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
 		Endpoint:        endpoint,
 		DepositContract: testAcc.contractAddr,
@@ -104,7 +118,7 @@ func TestProcessDepositLog_InsertsPendingDeposit(t *testing.T) {
 		Logger:          &goodLogger{},
 		HTTPLogger:      &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        beaconDB,
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -251,6 +265,11 @@ func TestProcessChainStartLog_8DuplicatePubkeys(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unable to set up simulated backend %v", err)
 	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
 	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
 		Endpoint:        endpoint,
 		DepositContract: testAcc.contractAddr,
@@ -258,7 +277,7 @@ func TestProcessChainStartLog_8DuplicatePubkeys(t *testing.T) {
 		Logger:          &goodLogger{},
 		HTTPLogger:      &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        beaconDB,
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -336,6 +355,11 @@ func TestProcessChainStartLog_8UniquePubkeys(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unable to set up simulated backend %v", err)
 	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
 	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
 		Endpoint:        endpoint,
 		DepositContract: testAcc.contractAddr,
@@ -343,7 +367,7 @@ func TestProcessChainStartLog_8UniquePubkeys(t *testing.T) {
 		Logger:          &goodLogger{},
 		HTTPLogger:      &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        beaconDB,
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -548,6 +572,11 @@ func TestETH1DataGenesis_OK(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unable to set up simulated backend %v", err)
 	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
 	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
 		Endpoint:        endpoint,
 		DepositContract: testAcc.contractAddr,
@@ -555,7 +584,7 @@ func TestETH1DataGenesis_OK(t *testing.T) {
 		Logger:          testAcc.backend,
 		HTTPLogger:      &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        beaconDB,
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -646,3 +675,93 @@ func TestETH1DataGenesis_OK(t *testing.T) {
 			web3Service.ChainStartETH1Data(), expectedETH1Data)
 	}
 }
+
+type queryRecorder struct {
+	goodLogger
+	query ethereum.FilterQuery
+}
+
+func (q *queryRecorder) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	q.query = query
+	return nil, nil
+}
+
+func TestProcessPastLogs_ResumesFromPersistedBlock(t *testing.T) {
+	testAcc, err := setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	ctx := context.Background()
+
+	depositData := []byte{'A'}
+	eth1Data := &pb.Eth1Data{DepositRootHash32: []byte{'B'}, BlockHash32: []byte{'C'}}
+	beaconDB.InsertDeposit(ctx, &pb.Deposit{DepositData: depositData}, big.NewInt(50))
+	if err := beaconDB.SaveDepositTrieItem(ctx, 0, depositData); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveChainStart(ctx, eth1Data, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveLastProcessedETH1Block(ctx, big.NewInt(100)); err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := &queryRecorder{}
+	web3Service, err := NewWeb3Service(ctx, &Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: testAcc.contractAddr,
+		Reader:          &goodReader{},
+		Logger:          &goodLogger{},
+		HTTPLogger:      recorder,
+		ContractBackend: testAcc.backend,
+		BeaconDB:        beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	web3Service.blockHeight = big.NewInt(200)
+	chainStartChan := make(chan time.Time, 1)
+	sub := web3Service.ChainStartFeed().Subscribe(chainStartChan)
+	defer sub.Unsubscribe()
+
+	if err := web3Service.processPastLogs(); err != nil {
+		t.Fatalf("Could not process past logs: %v", err)
+	}
+
+	if recorder.query.FromBlock == nil || recorder.query.FromBlock.Cmp(big.NewInt(101)) != 0 {
+		t.Errorf("Expected logs to be requested from block 101, received %v", recorder.query.FromBlock)
+	}
+	if !web3Service.chainStarted || !proto.Equal(web3Service.ChainStartETH1Data(), eth1Data) {
+		t.Errorf("Expected ChainStart data %v to be restored, received %v", eth1Data, web3Service.ChainStartETH1Data())
+	}
+	if web3Service.lastReceivedMerkleIndex != 0 {
+		t.Errorf("Expected last received merkle index 0, received %d", web3Service.lastReceivedMerkleIndex)
+	}
+	trie, err := trieutil.GenerateTrieFromItems([][]byte{depositData}, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if web3Service.DepositTrie().Root() != trie.Root() {
+		t.Error("Expected deposit trie to be regenerated from the persisted items")
+	}
+	lastBlock, err := beaconDB.LastProcessedETH1Block(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastBlock.Cmp(big.NewInt(200)) != 0 {
+		t.Errorf("Expected last processed block 200, received %v", lastBlock)
+	}
+	select {
+	case genesisTime := <-chainStartChan:
+		if genesisTime.Unix() != 10 {
+			t.Errorf("Expected genesis time 10, received %v", genesisTime.Unix())
+		}
+	default:
+		t.Error("Expected chain start to be announced again when the beacon state is not initialized")
+	}
+}
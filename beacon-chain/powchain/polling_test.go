@@ -0,0 +1,185 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+type rangeRecorder struct {
+	goodLogger
+	queries []ethereum.FilterQuery
+}
+
+func (r *rangeRecorder) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	r.queries = append(r.queries, query)
+	return nil, nil
+}
+
+type chainHeaderFetcher struct {
+	goodFetcher
+	headers []*gethTypes.Header
+}
+
+func (c *chainHeaderFetcher) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	if number == nil {
+		return c.headers[len(c.headers)-1], nil
+	}
+	return c.headers[number.Int64()], nil
+}
+
+// advancingFetcher returns the next header of the chain as the latest header on
+// every request, until the end of the chain is reached.
+type advancingFetcher struct {
+	chainHeaderFetcher
+	latest int
+}
+
+func (a *advancingFetcher) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	if number != nil {
+		return a.headers[number.Int64()], nil
+	}
+	header := a.headers[a.latest]
+	if a.latest < len(a.headers)-1 {
+		a.latest++
+	}
+	return header, nil
+}
+
+func TestRequestBatchedLogs_RequestsBlockRanges(t *testing.T) {
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	recorder := &rangeRecorder{}
+	web3Service := &Web3Service{
+		ctx:                context.Background(),
+		blockCache:         newBlockCache(),
+		httpLogger:         recorder,
+		beaconDB:           beaconDB,
+		lastRequestedBlock: big.NewInt(0),
+		blockHeight:        big.NewInt(2500 + params.BeaconConfig().LogBlockDelay),
+	}
+
+	if err := web3Service.requestBatchedLogs(); err != nil {
+		t.Fatalf("Could not request logs: %v", err)
+	}
+	wanted := [][2]int64{{1, 1000}, {1001, 2000}, {2001, 2500}}
+	if len(recorder.queries) != len(wanted) {
+		t.Fatalf("Expected %d log queries, received %d", len(wanted), len(recorder.queries))
+	}
+	for i, query := range recorder.queries {
+		if query.FromBlock.Int64() != wanted[i][0] || query.ToBlock.Int64() != wanted[i][1] {
+			t.Errorf("Expected query from block %d to %d, received %v to %v",
+				wanted[i][0], wanted[i][1], query.FromBlock, query.ToBlock)
+		}
+	}
+	lastBlock, err := beaconDB.LastProcessedETH1Block(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastBlock.Int64() != 2500 {
+		t.Errorf("Expected last processed block 2500, received %v", lastBlock)
+	}
+
+	// No logs are requested until the chain advances.
+	if err := web3Service.requestBatchedLogs(); err != nil {
+		t.Fatalf("Could not request logs: %v", err)
+	}
+	if len(recorder.queries) != len(wanted) {
+		t.Errorf("Expected no new log queries, received %d queries", len(recorder.queries))
+	}
+}
+
+func TestPollHeaders_ProcessesNewBlocks(t *testing.T) {
+	var headers []*gethTypes.Header
+	parent := common.Hash{}
+	for i := int64(0); i < 5; i++ {
+		header := &gethTypes.Header{ParentHash: parent, Number: big.NewInt(i)}
+		headers = append(headers, header)
+		parent = header.Hash()
+	}
+	web3Service := &Web3Service{
+		blockCache:   newBlockCache(),
+		blockFetcher: &chainHeaderFetcher{headers: headers},
+		blockHeight:  big.NewInt(1),
+		blockHash:    headers[1].Hash(),
+	}
+
+	web3Service.pollHeaders()
+	if web3Service.runError != nil {
+		t.Fatalf("Could not poll headers: %v", web3Service.runError)
+	}
+	if web3Service.blockHeight.Int64() != 4 || web3Service.blockHash != headers[4].Hash() {
+		t.Errorf("Expected head to be block 4, received block %v", web3Service.blockHeight)
+	}
+	for _, header := range headers[2:] {
+		exists, _, err := web3Service.blockCache.BlockInfoByHash(header.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !exists {
+			t.Errorf("Expected polled block %v to be in the cache", header.Number)
+		}
+	}
+}
+
+func TestRun_PollingMode(t *testing.T) {
+	testAcc, err := setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	genesis := &gethTypes.Header{Number: big.NewInt(0)}
+	fetcher := &advancingFetcher{chainHeaderFetcher: chainHeaderFetcher{headers: []*gethTypes.Header{
+		genesis,
+		{ParentHash: genesis.Hash(), Number: big.NewInt(1)},
+	}}}
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:        "http://127.0.0.1",
+		DepositContract: testAcc.contractAddr,
+		Reader:          &badReader{},
+		Logger:          &goodLogger{},
+		HTTPLogger:      &goodLogger{},
+		BlockFetcher:    fetcher,
+		ContractBackend: testAcc.backend,
+		PollingInterval: 10 * time.Millisecond,
+		BeaconDB:        beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	testAcc.backend.Commit()
+
+	exitRoutine := make(chan bool)
+	go func() {
+		web3Service.run(web3Service.ctx.Done())
+		exitRoutine <- true
+	}()
+	time.Sleep(100 * time.Millisecond)
+	web3Service.cancel()
+	<-exitRoutine
+
+	if web3Service.runError != nil {
+		t.Errorf("Expected polling without a header subscription to succeed, received %v", web3Service.runError)
+	}
+	exists, _, err := web3Service.blockCache.BlockInfoByHash(fetcher.headers[1].Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Error("Expected polled header to be processed")
+	}
+}
@@ -0,0 +1,159 @@
+package powchain
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+var reorgCount = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "powchain_reorgs",
+	Help: "The number of ETH1.0 chain reorgs rolled back by the powchain service",
+})
+
+// handleReorg checks whether a new header builds on the chain in the block cache.
+// If it does not, or if it replaces cached blocks of the same or a lower height,
+// the ETH1.0 chain was reorged, so the deposits processed after the common
+// ancestor of the new header and the cached chain are rolled back.
+func (w *Web3Service) handleReorg(header *gethTypes.Header) error {
+	lowest, highest, ok, err := w.blockCache.HeightRange()
+	if err != nil {
+		return fmt.Errorf("could not get cached block range: %v", err)
+	}
+	// Headers older than the cached chain cannot be checked against it.
+	if !ok || header.Number.Cmp(lowest) < 0 {
+		return nil
+	}
+	exists, cached, err := w.blockCache.BlockInfoByHeight(header.Number)
+	if err != nil {
+		return fmt.Errorf("could not get block info: %v", err)
+	}
+	if exists && cached.Hash == header.Hash() {
+		return nil
+	}
+
+	height := big.NewInt(0).Sub(header.Number, big.NewInt(1))
+	ancestor, diverged, err := w.commonAncestor(height, header.ParentHash, lowest)
+	if err != nil {
+		return err
+	}
+	if !diverged && header.Number.Cmp(highest) > 0 {
+		return nil
+	}
+
+	reorgCount.Inc()
+	log.WithFields(logrus.Fields{
+		"ancestorHeight": ancestor,
+		"newHeadHeight":  header.Number,
+		"newHeadHash":    header.Hash().Hex(),
+	}).Warn("ETH1.0 chain reorg detected, rolling back to the common ancestor")
+	return w.rollbackTo(ancestor)
+}
+
+// commonAncestor walks back the chain from the block of the given height and hash
+// until its block matches the cached block of the same height, and returns the
+// height of that block. If the cache does not reach back that far, the height
+// below the lowest cached block is returned. It also reports whether a cached
+// block which is not part of the chain was found on the way.
+func (w *Web3Service) commonAncestor(height *big.Int, hash common.Hash, lowest *big.Int) (*big.Int, bool, error) {
+	height = big.NewInt(0).Set(height)
+	diverged := false
+	for height.Cmp(lowest) >= 0 {
+		exists, cached, err := w.blockCache.BlockInfoByHeight(height)
+		if err != nil {
+			return nil, false, fmt.Errorf("could not get block info: %v", err)
+		}
+		if exists && cached.Hash == hash {
+			break
+		}
+		if exists {
+			diverged = true
+		}
+		block, err := w.activeBlockFetcher().BlockByHash(w.ctx, hash)
+		if err != nil {
+			return nil, false, fmt.Errorf("could not fetch block %#x: %v", hash, err)
+		}
+		hash = block.ParentHash()
+		height.Sub(height, big.NewInt(1))
+	}
+	return height, diverged, nil
+}
+
+// rollbackTo removes the deposits and block infos of the blocks after the given
+// height, and rewinds log processing so that the logs of the new chain are requested.
+func (w *Web3Service) rollbackTo(height *big.Int) error {
+	if err := w.blockCache.RemoveBlocksAfter(height); err != nil {
+		return fmt.Errorf("could not remove orphaned blocks from cache: %v", err)
+	}
+	if err := w.beaconDB.RemoveDepositsAfter(w.ctx, height); err != nil {
+		return fmt.Errorf("could not remove orphaned deposits: %v", err)
+	}
+
+	w.lastReceivedMerkleIndex = -1
+	if deposits := w.beaconDB.AllDeposits(w.ctx, nil); len(deposits) > 0 {
+		w.lastReceivedMerkleIndex = int64(deposits[len(deposits)-1].MerkleTreeIndex)
+	}
+	// Before ChainStart, every deposit is an item of the deposit trie built at
+	// ChainStart, so the trie items of orphaned deposits are dropped as well.
+	if !w.chainStarted {
+		depositCount := w.lastReceivedMerkleIndex + 1
+		if int64(len(w.chainStartDeposits)) > depositCount {
+			w.chainStartDeposits = w.chainStartDeposits[:depositCount]
+		}
+		if err := w.beaconDB.DeleteDepositTrieItems(w.ctx, uint64(depositCount)); err != nil {
+			return fmt.Errorf("could not remove orphaned deposit trie items: %v", err)
+		}
+	}
+
+	if w.lastRequestedBlock.Cmp(height) > 0 {
+		w.lastRequestedBlock.Set(height)
+		if err := w.beaconDB.SaveLastProcessedETH1Block(w.ctx, w.lastRequestedBlock); err != nil {
+			return fmt.Errorf("could not save last processed eth1 block: %v", err)
+		}
+	}
+	return nil
+}
+
+// checkLogBlockHashes checks that every log was emitted in the cached block of the
+// same height. If a log was emitted in another block, either the logs or the block
+// cache belong to a chain which was reorged, so everything after the common
+// ancestor of the block of the log and the cached chain is rolled back, and true
+// is returned so that the logs are requested again.
+func (w *Web3Service) checkLogBlockHashes(logs []gethTypes.Log) (bool, error) {
+	for _, depositLog := range logs {
+		height := big.NewInt(int64(depositLog.BlockNumber))
+		exists, cached, err := w.blockCache.BlockInfoByHeight(height)
+		if err != nil {
+			return false, fmt.Errorf("could not get block info: %v", err)
+		}
+		if !exists || cached.Hash == depositLog.BlockHash {
+			continue
+		}
+		lowest, _, _, err := w.blockCache.HeightRange()
+		if err != nil {
+			return false, fmt.Errorf("could not get cached block range: %v", err)
+		}
+		ancestor, _, err := w.commonAncestor(height, depositLog.BlockHash, lowest)
+		if err != nil {
+			return false, err
+		}
+
+		reorgCount.Inc()
+		log.WithFields(logrus.Fields{
+			"ancestorHeight": ancestor,
+			"logBlockHeight": height,
+			"logBlockHash":   depositLog.BlockHash.Hex(),
+			"cachedHash":     cached.Hash.Hex(),
+		}).Warn("Deposit log is not in the cached ETH1.0 chain, rolling back to the common ancestor")
+		if err := w.rollbackTo(ancestor); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,235 @@
+package powchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+type chainFetcher struct {
+	goodFetcher
+	blocks map[common.Hash]*gethTypes.Block
+}
+
+func (c *chainFetcher) BlockByHash(ctx context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	block, ok := c.blocks[hash]
+	if !ok {
+		return nil, fmt.Errorf("unknown block %#x", hash)
+	}
+	return block, nil
+}
+
+// buildChain returns blocks of the given heights, each block being the child of the
+// previous one and the first block being the child of parent.
+func buildChain(parent common.Hash, fork byte, heights ...int64) []*gethTypes.Block {
+	var blocks []*gethTypes.Block
+	for _, height := range heights {
+		block := gethTypes.NewBlockWithHeader(&gethTypes.Header{
+			ParentHash: parent,
+			Number:     big.NewInt(height),
+			Extra:      []byte{fork},
+		})
+		blocks = append(blocks, block)
+		parent = block.Hash()
+	}
+	return blocks
+}
+
+func TestProcessSubscribedHeaders_RollsBackReorgedDeposits(t *testing.T) {
+	testAcc, err := setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	ctx := context.Background()
+
+	oldChain := buildChain(common.Hash{}, 'A', 0, 1, 2, 3)
+	newChain := buildChain(oldChain[1].Hash(), 'B', 2, 3, 4)
+	fetcher := &chainFetcher{blocks: make(map[common.Hash]*gethTypes.Block)}
+	for _, block := range newChain {
+		fetcher.blocks[block.Hash()] = block
+	}
+	web3Service, err := NewWeb3Service(ctx, &Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: testAcc.contractAddr,
+		Reader:          &goodReader{},
+		Logger:          &goodLogger{},
+		HTTPLogger:      &goodLogger{},
+		BlockFetcher:    fetcher,
+		ContractBackend: testAcc.backend,
+		BeaconDB:        beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	for _, block := range oldChain {
+		if err := web3Service.blockCache.AddBlock(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A deposit was included in each of the blocks 1 to 3 of the old chain.
+	for i := uint64(0); i < 3; i++ {
+		data := []byte{byte(i)}
+		beaconDB.InsertDeposit(ctx, &pb.Deposit{MerkleTreeIndex: i, DepositData: data}, big.NewInt(int64(i+1)))
+		if err := beaconDB.SaveDepositTrieItem(ctx, i, data); err != nil {
+			t.Fatal(err)
+		}
+		web3Service.chainStartDeposits = append(web3Service.chainStartDeposits, data)
+	}
+	web3Service.lastReceivedMerkleIndex = 2
+	web3Service.lastRequestedBlock = big.NewInt(3)
+
+	web3Service.processSubscribedHeaders(newChain[2].Header())
+	if web3Service.runError != nil {
+		t.Fatalf("Could not process reorged header: %v", web3Service.runError)
+	}
+
+	if deposits := beaconDB.AllDeposits(ctx, nil); len(deposits) != 1 || deposits[0].MerkleTreeIndex != 0 {
+		t.Errorf("Expected only the deposit of the common ancestor to remain, received %v", deposits)
+	}
+	if web3Service.lastReceivedMerkleIndex != 0 {
+		t.Errorf("Expected last received merkle index 0, received %d", web3Service.lastReceivedMerkleIndex)
+	}
+	if len(web3Service.ChainStartDeposits()) != 1 {
+		t.Errorf("Expected 1 chainstart deposit, received %d", len(web3Service.ChainStartDeposits()))
+	}
+	items, err := beaconDB.DepositTrieItems(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 {
+		t.Errorf("Expected 1 deposit trie item, received %d", len(items))
+	}
+	if web3Service.lastRequestedBlock.Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("Expected logs to be requested again after block 1, received %v", web3Service.lastRequestedBlock)
+	}
+	for _, block := range oldChain[2:] {
+		exists, _, err := web3Service.blockCache.BlockInfoByHash(block.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exists {
+			t.Errorf("Expected orphaned block %d to be removed from the cache", block.NumberU64())
+		}
+	}
+	exists, _, err := web3Service.blockCache.BlockInfoByHash(newChain[2].Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists || web3Service.blockHeight.Cmp(big.NewInt(4)) != 0 {
+		t.Error("Expected new head to be processed")
+	}
+}
+
+func TestProcessSubscribedHeaders_ReorgToSameHeight(t *testing.T) {
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	ctx := context.Background()
+
+	oldChain := buildChain(common.Hash{}, 'A', 0, 1, 2, 3)
+	newChain := buildChain(oldChain[1].Hash(), 'B', 2)
+	web3Service := &Web3Service{
+		ctx:                ctx,
+		beaconDB:           beaconDB,
+		blockCache:         newBlockCache(),
+		blockFetcher:       &chainFetcher{},
+		lastRequestedBlock: big.NewInt(3),
+	}
+	for _, block := range oldChain {
+		if err := web3Service.blockCache.AddBlock(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	web3Service.processSubscribedHeaders(oldChain[3].Header())
+	if web3Service.lastRequestedBlock.Cmp(big.NewInt(3)) != 0 {
+		t.Fatal("Expected a header which is already cached not to be rolled back")
+	}
+	web3Service.processSubscribedHeaders(newChain[0].Header())
+	if web3Service.runError != nil {
+		t.Fatalf("Could not process reorged header: %v", web3Service.runError)
+	}
+	if web3Service.lastRequestedBlock.Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("Expected logs to be requested again after block 1, received %v", web3Service.lastRequestedBlock)
+	}
+	for _, block := range oldChain[2:] {
+		exists, _, err := web3Service.blockCache.BlockInfoByHash(block.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exists {
+			t.Errorf("Expected orphaned block %d to be removed from the cache", block.NumberU64())
+		}
+	}
+}
+
+func TestCheckLogBlockHashes_RollsBackOrphanedBlocks(t *testing.T) {
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	ctx := context.Background()
+
+	oldChain := buildChain(common.Hash{}, 'A', 0, 1, 2, 3)
+	newChain := buildChain(oldChain[0].Hash(), 'B', 1, 2, 3)
+	fetcher := &chainFetcher{blocks: make(map[common.Hash]*gethTypes.Block)}
+	for _, block := range newChain {
+		fetcher.blocks[block.Hash()] = block
+	}
+	web3Service := &Web3Service{
+		ctx:                ctx,
+		beaconDB:           beaconDB,
+		blockCache:         newBlockCache(),
+		blockFetcher:       fetcher,
+		lastRequestedBlock: big.NewInt(3),
+	}
+	for _, block := range oldChain {
+		if err := web3Service.blockCache.AddBlock(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	canonical := gethTypes.Log{BlockNumber: 1, BlockHash: oldChain[1].Hash()}
+	reorged, err := web3Service.checkLogBlockHashes([]gethTypes.Log{canonical})
+	if err != nil || reorged {
+		t.Fatalf("Expected log of a cached block to be accepted, received %v, %v", reorged, err)
+	}
+	orphaned := gethTypes.Log{BlockNumber: 2, BlockHash: newChain[1].Hash()}
+	reorged, err = web3Service.checkLogBlockHashes([]gethTypes.Log{canonical, orphaned})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reorged {
+		t.Fatal("Expected log of another chain to roll back the cached chain")
+	}
+	if web3Service.lastRequestedBlock.Cmp(big.NewInt(0)) != 0 {
+		t.Errorf("Expected logs to be requested again after block 0, received %v", web3Service.lastRequestedBlock)
+	}
+	for _, block := range oldChain[1:] {
+		exists, _, err := web3Service.blockCache.BlockInfoByHash(block.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exists {
+			t.Errorf("Expected block %d to be evicted from the cache", block.NumberU64())
+		}
+	}
+	reorged, err = web3Service.checkLogBlockHashes([]gethTypes.Log{orphaned})
+	if err != nil || reorged {
+		t.Errorf("Expected the log to be accepted once the cache is rolled back, received %v, %v", reorged, err)
+	}
+}
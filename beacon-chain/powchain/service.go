@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"runtime/debug"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -96,34 +97,79 @@ type Web3Service struct {
 	isRunning               bool
 	runError                error
 	lastRequestedBlock      *big.Int
+	endpoints               []*endpointState
+	activeEndpoint          int
+	endpointSwitched        chan struct{} // closed when the active endpoint changes.
+	endpointLock            sync.RWMutex
+	healthCheckInterval     time.Duration
+	pollingInterval         time.Duration
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
+// Endpoints is the list of ETH1.0 nodes to follow in order of preference, at least
+// one of which must be connected. If it is empty, the single node defined by Endpoint, Client, Reader, Logger, HTTPLogger,
+// BlockFetcher and ContractBackend is followed. If PollingInterval is set, the
+// service polls the latest headers and logs at that interval instead of subscribing
+// to new headers, so HTTP endpoints can be followed.
 type Web3ServiceConfig struct {
-	Endpoint        string
-	DepositContract common.Address
-	Client          Client
-	Reader          Reader
-	Logger          bind.ContractFilterer
-	HTTPLogger      bind.ContractFilterer
-	BlockFetcher    POWBlockFetcher
-	ContractBackend bind.ContractBackend
-	BeaconDB        *db.BeaconDB
+	Endpoint            string
+	DepositContract     common.Address
+	Client              Client
+	Reader              Reader
+	Logger              bind.ContractFilterer
+	HTTPLogger          bind.ContractFilterer
+	BlockFetcher        POWBlockFetcher
+	ContractBackend     bind.ContractBackend
+	Endpoints           []*Endpoint
+	HealthCheckInterval time.Duration
+	PollingInterval     time.Duration
+	BeaconDB            *db.BeaconDB
 }
 
 // NewWeb3Service sets up a new instance with an ethclient when
 // given a web3 endpoint as a string in the config.
 func NewWeb3Service(ctx context.Context, config *Web3ServiceConfig) (*Web3Service, error) {
-	if !strings.HasPrefix(config.Endpoint, "ws") && !strings.HasPrefix(config.Endpoint, "ipc") {
-		return nil, fmt.Errorf(
-			"powchain service requires either an IPC or WebSocket endpoint, provided %s",
-			config.Endpoint,
-		)
+	endpoints := config.Endpoints
+	if len(endpoints) == 0 {
+		endpoints = []*Endpoint{{
+			URL:             config.Endpoint,
+			Client:          config.Client,
+			Reader:          config.Reader,
+			Logger:          config.Logger,
+			HTTPLogger:      config.HTTPLogger,
+			BlockFetcher:    config.BlockFetcher,
+			ContractBackend: config.ContractBackend,
+		}}
 	}
-
-	depositContractCaller, err := contracts.NewDepositContractCaller(config.DepositContract, config.ContractBackend)
-	if err != nil {
-		return nil, fmt.Errorf("could not create deposit contract caller %v", err)
+	states := make([]*endpointState, len(endpoints))
+	activeEndpoint := -1
+	for i, e := range endpoints {
+		subscribable := strings.HasPrefix(e.URL, "ws") || strings.HasPrefix(e.URL, "ipc") || e.URL == SimulatedEndpointURL
+		if config.PollingInterval == 0 && !subscribable {
+			return nil, fmt.Errorf(
+				"powchain service requires either an IPC or WebSocket endpoint, provided %s",
+				e.URL,
+			)
+		}
+		if e.Dial != nil {
+			states[i] = &endpointState{Endpoint: e, err: errNotConnected}
+			continue
+		}
+		depositContractCaller, err := contracts.NewDepositContractCaller(config.DepositContract, e.ContractBackend)
+		if err != nil {
+			return nil, fmt.Errorf("could not create deposit contract caller %v", err)
+		}
+		states[i] = &endpointState{Endpoint: e, depositContractCaller: depositContractCaller}
+		if activeEndpoint < 0 {
+			activeEndpoint = i
+		}
+	}
+	if activeEndpoint < 0 {
+		return nil, errors.New("could not connect to any ETH1.0 endpoint")
+	}
+	healthCheckInterval := config.HealthCheckInterval
+	if healthCheckInterval == 0 {
+		healthCheckInterval = defaultHealthCheckInterval
 	}
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -132,28 +178,34 @@ func NewWeb3Service(ctx context.Context, config *Web3ServiceConfig) (*Web3Servic
 		cancel()
 		return nil, fmt.Errorf("could not setup deposit trie: %v", err)
 	}
+	active := states[activeEndpoint]
 	return &Web3Service{
 		ctx:                     ctx,
 		cancel:                  cancel,
 		headerChan:              make(chan *gethTypes.Header),
-		endpoint:                config.Endpoint,
+		endpoint:                active.URL,
 		blockHeight:             nil,
 		blockHash:               common.BytesToHash([]byte{}),
 		blockCache:              newBlockCache(),
 		depositContractAddress:  config.DepositContract,
 		chainStartFeed:          new(event.Feed),
-		client:                  config.Client,
+		client:                  active.Client,
 		depositTrie:             depositTrie,
-		reader:                  config.Reader,
-		logger:                  config.Logger,
-		httpLogger:              config.HTTPLogger,
-		blockFetcher:            config.BlockFetcher,
-		depositContractCaller:   depositContractCaller,
+		reader:                  active.Reader,
+		logger:                  active.Logger,
+		httpLogger:              active.HTTPLogger,
+		blockFetcher:            active.BlockFetcher,
+		depositContractCaller:   active.depositContractCaller,
 		chainStartDeposits:      [][]byte{},
 		beaconDB:                config.BeaconDB,
 		lastReceivedMerkleIndex: -1,
 		lastRequestedBlock:      big.NewInt(0),
 		chainStartETH1Data:      &pb.Eth1Data{},
+		endpoints:               states,
+		activeEndpoint:          activeEndpoint,
+		endpointSwitched:        make(chan struct{}),
+		healthCheckInterval:     healthCheckInterval,
+		pollingInterval:         config.PollingInterval,
 	}, nil
 }
 
@@ -167,12 +219,11 @@ func (w *Web3Service) Start() {
 
 // Stop the web3 service's main event loop and associated goroutines.
 func (w *Web3Service) Stop() error {
+	// The header channel is left open, as the header subscription may still be
+	// sending to it until the main event loop exits and unsubscribes.
 	if w.cancel != nil {
 		defer w.cancel()
 	}
-	if w.headerChan != nil {
-		defer close(w.headerChan)
-	}
 	log.Info("Stopping service")
 	return nil
 }
@@ -211,7 +262,7 @@ func (w *Web3Service) Status() error {
 	if w.blockTime.Before(fiveMinutesTimeout) {
 		return errors.New("eth1 client is not syncing")
 	}
-	return nil
+	return w.endpointsStatus()
 }
 
 // DepositRoot returns the Merkle root of the latest deposit trie
@@ -236,15 +287,25 @@ func (w *Web3Service) LatestBlockHash() common.Hash {
 	return w.blockHash
 }
 
+// FollowedBlockHeight returns the height of the block Eth1FollowDistance blocks
+// behind the ETH1.0 head. Deposits are only exposed for inclusion in beacon blocks
+// once they are this deep, as more recent blocks may still be reorged.
+func (w *Web3Service) FollowedBlockHeight() *big.Int {
+	if w.blockHeight == nil {
+		return nil
+	}
+	return big.NewInt(0).Sub(w.blockHeight, big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance)))
+}
+
 // Client for interacting with the ETH1.0 chain.
 func (w *Web3Service) Client() Client {
-	return w.client
+	return w.activeClient()
 }
 
 // initDataFromContract calls the deposit contract and finds the deposit count
 // and deposit root.
 func (w *Web3Service) initDataFromContract() error {
-	root, err := w.depositContractCaller.GetDepositRoot(&bind.CallOpts{})
+	root, err := w.activeDepositContract().GetDepositRoot(&bind.CallOpts{})
 	if err != nil {
 		return fmt.Errorf("could not retrieve deposit root %v", err)
 	}
@@ -256,6 +317,11 @@ func (w *Web3Service) initDataFromContract() error {
 // updates the latest blockHeight, blockHash, and blockTime properties of the service.
 func (w *Web3Service) processSubscribedHeaders(header *gethTypes.Header) {
 	defer safelyHandlePanic()
+	if err := w.handleReorg(header); err != nil {
+		w.runError = err
+		log.Errorf("Unable to handle ETH1.0 chain reorg %v", err)
+		return
+	}
 	blockNumberGauge.Set(float64(header.Number.Int64()))
 	w.blockHeight = header.Number
 	w.blockHash = header.Hash()
@@ -271,6 +337,36 @@ func (w *Web3Service) processSubscribedHeaders(header *gethTypes.Header) {
 	}
 }
 
+// pollHeaders requests the headers of the blocks added to the ETH1.0 chain since
+// the last poll, and processes them the same way as headers received over a
+// subscription. Only the blocks which fit in the block cache are requested.
+func (w *Web3Service) pollHeaders() {
+	header, err := w.activeBlockFetcher().HeaderByNumber(w.ctx, nil)
+	if err != nil {
+		w.runError = err
+		log.Errorf("Unable to poll latest ETH1.0 chain header: %v", err)
+		return
+	}
+	if header.Hash() == w.blockHash {
+		return
+	}
+
+	next := big.NewInt(0).Add(w.blockHeight, big.NewInt(1))
+	if lowest := big.NewInt(0).Sub(header.Number, big.NewInt(int64(maxCacheSize))); next.Cmp(lowest) < 0 {
+		next = lowest
+	}
+	for ; next.Cmp(header.Number) < 0; next.Add(next, big.NewInt(1)) {
+		h, err := w.activeBlockFetcher().HeaderByNumber(w.ctx, next)
+		if err != nil {
+			w.runError = err
+			log.Errorf("Unable to poll ETH1.0 chain header %v: %v", next, err)
+			return
+		}
+		w.processSubscribedHeaders(h)
+	}
+	w.processSubscribedHeaders(header)
+}
+
 // safelyHandleHeader will recover and log any panic that occurs from the
 // block
 func safelyHandlePanic() {
@@ -301,19 +397,31 @@ func (w *Web3Service) handleDelayTicker() {
 func (w *Web3Service) run(done <-chan struct{}) {
 	w.isRunning = true
 	w.runError = nil
+	// With several endpoints, the first healthy one is followed from the start.
+	if len(w.endpoints) > 1 {
+		w.probeEndpoints()
+	}
 	if err := w.initDataFromContract(); err != nil {
 		log.Errorf("Unable to retrieve data from deposit contract %v", err)
 		return
 	}
 
-	headSub, err := w.reader.SubscribeNewHead(w.ctx, w.headerChan)
-	if err != nil {
-		log.Errorf("Unable to subscribe to incoming ETH1.0 chain headers: %v", err)
-		w.runError = err
-		return
+	// In polling mode headers are requested on every tick instead, and the nil
+	// error channel never fires.
+	var headSubErr <-chan error
+	if w.pollingInterval == 0 {
+		sub, err := w.activeReader().SubscribeNewHead(w.ctx, w.headerChan)
+		if err != nil {
+			log.Errorf("Unable to subscribe to incoming ETH1.0 chain headers: %v", err)
+			w.runError = err
+			return
+		}
+		headSub := w.subscribeNewHead(sub)
+		defer headSub.Unsubscribe()
+		headSubErr = headSub.Err()
 	}
 
-	header, err := w.blockFetcher.HeaderByNumber(w.ctx, nil)
+	header, err := w.activeBlockFetcher().HeaderByNumber(w.ctx, nil)
 	if err != nil {
 		log.Errorf("Unable to retrieve latest ETH1.0 chain header: %v", err)
 		w.runError = err
@@ -329,9 +437,15 @@ func (w *Web3Service) run(done <-chan struct{}) {
 		return
 	}
 
-	ticker := time.NewTicker(1 * time.Second)
-	defer headSub.Unsubscribe()
+	tickInterval := 1 * time.Second
+	if w.pollingInterval > 0 {
+		tickInterval = w.pollingInterval
+	}
+	ticker := time.NewTicker(tickInterval)
 	defer ticker.Stop()
+	stopMonitor := make(chan struct{})
+	defer close(stopMonitor)
+	go w.monitorEndpoints(stopMonitor)
 
 	for {
 		select {
@@ -340,12 +454,15 @@ func (w *Web3Service) run(done <-chan struct{}) {
 			w.runError = nil
 			log.Debug("ETH1.0 chain service context closed, exiting goroutine")
 			return
-		case w.runError = <-headSub.Err():
+		case w.runError = <-headSubErr:
 			log.Debugf("Unsubscribed to head events, exiting goroutine: %v", w.runError)
 			return
 		case header := <-w.headerChan:
 			w.processSubscribedHeaders(header)
 		case <-ticker.C:
+			if w.pollingInterval > 0 {
+				w.pollHeaders()
+			}
 			w.handleDelayTicker()
 		}
 	}
@@ -0,0 +1,310 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+	"github.com/sirupsen/logrus"
+)
+
+// SimulatedEndpointURL is the URL of the endpoint of a SimulatedChain.
+const SimulatedEndpointURL = "simulated"
+
+var (
+	// defaultSimulatedBlockInterval is how often a SimulatedChain mines a block when
+	// the config does not specify an interval.
+	defaultSimulatedBlockInterval = time.Second
+	// simulatedGasLimit is the gas limit of the blocks of a SimulatedChain.
+	simulatedGasLimit = uint64(2100000000)
+	// simulatedBalance is the genesis balance in wei of the account deploying the
+	// deposit contract and funding the deposits.
+	simulatedBalance, _ = new(big.Int).SetString("1000000000000000000000000000", 10)
+	// simulatedAccountSeed derives the key of the funded account, so the deposit
+	// contract address is the same every time a SimulatedChain is created.
+	simulatedAccountSeed = []byte("prysm simulated eth1 chain")
+)
+
+// SimulatedChain is an in-process ETH1.0 chain backed by go-ethereum's simulated
+// backend. The deposit contract is deployed when the chain is created, and a block
+// is mined at every block interval once the chain is started. It implements Client,
+// so a Web3Service can follow it like any ETH1.0 node through Endpoint.
+type SimulatedChain struct {
+	ctx                    context.Context
+	cancel                 context.CancelFunc
+	backend                *backends.SimulatedBackend
+	txOpts                 *bind.TransactOpts
+	depositContract        *contracts.DepositContract
+	depositContractAddress common.Address
+	blockInterval          time.Duration
+	headFeed               *event.Feed
+	lock                   sync.Mutex
+}
+
+// SimulatedChainConfig defines the config of a simulated ETH1.0 chain. A deposit of
+// the maximum deposit amount is submitted for each of the DepositKeys when the chain
+// is created.
+type SimulatedChainConfig struct {
+	BlockInterval time.Duration
+	DepositKeys   []*keystore.Key
+}
+
+// NewSimulatedChain creates a simulated ETH1.0 chain and deploys the deposit
+// contract to it.
+func NewSimulatedChain(ctx context.Context, config *SimulatedChainConfig) (*SimulatedChain, error) {
+	privKey, err := simulatedAccountKey()
+	if err != nil {
+		return nil, fmt.Errorf("could not derive simulated account key: %v", err)
+	}
+	txOpts := bind.NewKeyedTransactor(privKey)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		txOpts.From: {Balance: simulatedBalance},
+	}, simulatedGasLimit)
+
+	blockInterval := config.BlockInterval
+	if blockInterval == 0 {
+		blockInterval = defaultSimulatedBlockInterval
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	c := &SimulatedChain{
+		ctx:           ctx,
+		cancel:        cancel,
+		backend:       backend,
+		txOpts:        txOpts,
+		blockInterval: blockInterval,
+		headFeed:      new(event.Feed),
+	}
+	c.schedulePendingBlock(time.Now())
+
+	// The contract delays the genesis time to the next multiple of this many seconds
+	// after the chain start deposit.
+	chainStartDelay := big.NewInt(int64(params.BeaconConfig().SecondsPerSlot))
+	contractAddress, _, depositContract, err := contracts.DeployDepositContract(
+		txOpts,
+		backend,
+		new(big.Int).SetUint64(params.BeaconConfig().DepositsForChainStart),
+		params.ContractConfig().MinDepositAmount,
+		params.ContractConfig().MaxDepositAmount,
+		chainStartDelay,
+		txOpts.From,
+	)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not deploy deposit contract: %v", err)
+	}
+	c.depositContract = depositContract
+	c.depositContractAddress = contractAddress
+	c.Mine()
+
+	for _, key := range config.DepositKeys {
+		if err := c.Deposit(key); err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not submit deposit: %v", err)
+		}
+	}
+	if len(config.DepositKeys) > 0 {
+		c.Mine()
+	}
+	return c, nil
+}
+
+// SimulatedDepositContractAddress returns the address of the deposit contract of
+// every simulated chain, as it is deployed by the same account in its first
+// transaction.
+func SimulatedDepositContractAddress() (common.Address, error) {
+	privKey, err := simulatedAccountKey()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.CreateAddress(crypto.PubkeyToAddress(privKey.PublicKey), 0), nil
+}
+
+func simulatedAccountKey() (*ecdsa.PrivateKey, error) {
+	return crypto.ToECDSA(crypto.Keccak256(simulatedAccountSeed))
+}
+
+// Start mining blocks at every block interval.
+func (c *SimulatedChain) Start() {
+	log.WithFields(logrus.Fields{
+		"depositContract": c.depositContractAddress.Hex(),
+		"blockInterval":   c.blockInterval,
+	}).Info("Starting simulated ETH1.0 chain")
+	go c.run(c.ctx.Done())
+}
+
+// Stop mining blocks and release the simulated backend.
+func (c *SimulatedChain) Stop() error {
+	c.cancel()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	log.Info("Stopping simulated ETH1.0 chain")
+	return c.backend.Close()
+}
+
+// Status always returns nil, as the simulated chain has no external dependencies.
+func (c *SimulatedChain) Status() error {
+	return nil
+}
+
+func (c *SimulatedChain) run(done <-chan struct{}) {
+	ticker := time.NewTicker(c.blockInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			c.Mine()
+		}
+	}
+}
+
+// DepositContractAddress of the deposit contract deployed to the chain.
+func (c *SimulatedChain) DepositContractAddress() common.Address {
+	return c.depositContractAddress
+}
+
+// Endpoint returns the endpoint a Web3Service uses to follow the chain.
+func (c *SimulatedChain) Endpoint() *Endpoint {
+	return &Endpoint{
+		URL:             SimulatedEndpointURL,
+		Client:          c,
+		Reader:          c,
+		Logger:          c.backend,
+		HTTPLogger:      c.backend,
+		BlockFetcher:    c,
+		ContractBackend: c.backend,
+	}
+}
+
+// Deposit submits a deposit of the maximum deposit amount for the key, using the
+// key as the withdrawal key as well. The deposit is included in the next mined block.
+func (c *SimulatedChain) Deposit(key *keystore.Key) error {
+	depositInput, err := keystore.DepositInput(key, key)
+	if err != nil {
+		return fmt.Errorf("could not generate deposit input: %v", err)
+	}
+	serializedData := new(bytes.Buffer)
+	if err := ssz.Encode(serializedData, depositInput); err != nil {
+		return fmt.Errorf("could not serialize deposit input: %v", err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	txOpts := *c.txOpts
+	txOpts.Value = new(big.Int).Mul(params.ContractConfig().MaxDepositAmount, big.NewInt(1e9))
+	if _, err := c.depositContract.Deposit(&txOpts, serializedData.Bytes()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Mine a block with the pending transactions and notify the subscribers to new
+// headers.
+func (c *SimulatedChain) Mine() {
+	c.lock.Lock()
+	if c.ctx.Err() != nil {
+		c.lock.Unlock()
+		return
+	}
+	c.backend.Commit()
+	header := c.backend.Blockchain().CurrentBlock().Header()
+	c.schedulePendingBlock(time.Now().Add(c.blockInterval))
+	c.lock.Unlock()
+
+	// Headers are sent without holding the lock, as subscribers may call back into
+	// the chain before receiving the next header.
+	c.headFeed.Send(header)
+}
+
+// schedulePendingBlock timestamps the pending block with the time it is expected
+// to be mined at. Pending blocks are otherwise timestamped 10 seconds after their
+// parent, starting from a genesis block at the unix epoch, and the chain start log
+// would not carry a realistic genesis time. This must be called while the pending
+// block is empty, as its transactions would not be executed again at the new time.
+func (c *SimulatedChain) schedulePendingBlock(at time.Time) {
+	parentTime := int64(c.backend.Blockchain().CurrentBlock().Time())
+	blockTime := at.Unix()
+	if blockTime <= parentTime {
+		blockTime = parentTime + 1
+	}
+	if offset := blockTime - parentTime - 10; offset != 0 {
+		if err := c.backend.AdjustTime(time.Duration(offset) * time.Second); err != nil {
+			log.Errorf("Could not adjust simulated block time: %v", err)
+		}
+	}
+}
+
+// SubscribeNewHead subscribes to the headers of the blocks mined by the chain.
+func (c *SimulatedChain) SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error) {
+	return c.headFeed.Subscribe(ch), nil
+}
+
+// BlockByHash returns the block with the given hash.
+func (c *SimulatedChain) BlockByHash(ctx context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	block := c.backend.Blockchain().GetBlockByHash(hash)
+	if block == nil {
+		return nil, ethereum.NotFound
+	}
+	return block, nil
+}
+
+// BlockByNumber returns the block with the given number, or the latest block if
+// number is nil.
+func (c *SimulatedChain) BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error) {
+	if number == nil {
+		return c.backend.Blockchain().CurrentBlock(), nil
+	}
+	block := c.backend.Blockchain().GetBlockByNumber(number.Uint64())
+	if block == nil {
+		return nil, ethereum.NotFound
+	}
+	return block, nil
+}
+
+// HeaderByNumber returns the header of the block with the given number, or of the
+// latest block if number is nil.
+func (c *SimulatedChain) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	block, err := c.BlockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return block.Header(), nil
+}
+
+// FilterLogs executes a filter query against the chain.
+func (c *SimulatedChain) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	return c.backend.FilterLogs(ctx, query)
+}
+
+// SubscribeFilterLogs subscribes to the logs of the blocks mined by the chain which
+// match the filter query.
+func (c *SimulatedChain) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return c.backend.SubscribeFilterLogs(ctx, query, ch)
+}
+
+// CodeAt returns the code of the contract at the given address.
+func (c *SimulatedChain) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.backend.CodeAt(ctx, contract, blockNumber)
+}
+
+// CallContract executes a contract call against the chain.
+func (c *SimulatedChain) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.backend.CallContract(ctx, call, blockNumber)
+}
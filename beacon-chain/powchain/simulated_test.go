@@ -0,0 +1,135 @@
+package powchain
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestSimulatedChain_MinesBlocksAtCurrentTime(t *testing.T) {
+	chain, err := NewSimulatedChain(context.Background(), &SimulatedChainConfig{})
+	if err != nil {
+		t.Fatalf("Could not create simulated chain: %v", err)
+	}
+	defer chain.Stop()
+
+	headers := make(chan *gethTypes.Header, 1)
+	sub, err := chain.SubscribeNewHead(context.Background(), headers)
+	if err != nil {
+		t.Fatalf("Could not subscribe to new headers: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	before := time.Now().Unix()
+	chain.Mine()
+	header := <-headers
+	if header.Number.Uint64() != 2 {
+		t.Errorf("Expected block 2 after the deployment block, received block %d", header.Number.Uint64())
+	}
+	if int64(header.Time) < before || int64(header.Time) > time.Now().Unix()+1 {
+		t.Errorf("Expected block time close to %d, received %d", before, header.Time)
+	}
+
+	latest, err := chain.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Could not fetch latest header: %v", err)
+	}
+	if latest.Hash() != header.Hash() {
+		t.Errorf("Expected latest header %#x, received %#x", header.Hash(), latest.Hash())
+	}
+	block, err := chain.BlockByHash(context.Background(), header.ParentHash)
+	if err != nil {
+		t.Fatalf("Could not fetch parent block: %v", err)
+	}
+	if block.NumberU64() != 1 {
+		t.Errorf("Expected parent block 1, received %d", block.NumberU64())
+	}
+
+	code, err := chain.CodeAt(context.Background(), chain.DepositContractAddress(), nil)
+	if err != nil {
+		t.Fatalf("Could not fetch deposit contract code: %v", err)
+	}
+	if len(code) == 0 {
+		t.Error("Expected the deposit contract to be deployed")
+	}
+	address, err := SimulatedDepositContractAddress()
+	if err != nil {
+		t.Fatalf("Could not compute deposit contract address: %v", err)
+	}
+	if address != chain.DepositContractAddress() {
+		t.Errorf("Expected deposit contract address %#x, received %#x", chain.DepositContractAddress(), address)
+	}
+}
+
+func TestSimulatedChain_Web3ServiceReceivesChainStart(t *testing.T) {
+	config := params.BeaconConfig()
+	defer params.OverrideBeaconConfig(config)
+	simulatedConfig := *config
+	simulatedConfig.DepositsForChainStart = 2
+	params.OverrideBeaconConfig(&simulatedConfig)
+
+	keys := make([]*keystore.Key, simulatedConfig.DepositsForChainStart)
+	for i := range keys {
+		key, err := keystore.NewKey(rand.Reader)
+		if err != nil {
+			t.Fatalf("Could not generate key: %v", err)
+		}
+		keys[i] = key
+	}
+	chain, err := NewSimulatedChain(context.Background(), &SimulatedChainConfig{
+		BlockInterval: 100 * time.Millisecond,
+		DepositKeys:   keys,
+	})
+	if err != nil {
+		t.Fatalf("Could not create simulated chain: %v", err)
+	}
+	defer chain.Stop()
+
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		DepositContract: chain.DepositContractAddress(),
+		Endpoints:       []*Endpoint{chain.Endpoint()},
+		BeaconDB:        beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("Unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+
+	chainStartChan := make(chan time.Time, 1)
+	sub := web3Service.ChainStartFeed().Subscribe(chainStartChan)
+	defer sub.Unsubscribe()
+
+	chain.Start()
+	web3Service.Start()
+	defer web3Service.Stop()
+
+	select {
+	case genesisTime := <-chainStartChan:
+		if time.Until(genesisTime) > time.Duration(simulatedConfig.SecondsPerSlot)*time.Second {
+			t.Errorf("Expected genesis time within a slot, received %v", genesisTime)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for the chain start log")
+	}
+	if len(web3Service.ChainStartDeposits()) != len(keys) {
+		t.Errorf("Expected %d chain start deposits, received %d", len(keys), len(web3Service.ChainStartDeposits()))
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for web3Service.LatestBlockHeight() == nil || web3Service.LatestBlockHeight().Uint64() < 3 {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for the web3 service to follow newly mined blocks")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+)
+
+type peerManager interface {
+	PeerBans() []p2p.PeerBan
+	ClearPeerBans(pids ...peer.ID) int
+}
+
+// AdminServer defines a server implementation of the gRPC Admin service,
+// providing RPC methods for the operator of the beacon node to manage its peers.
+type AdminServer struct {
+	peerManager peerManager
+}
+
+// PeerBans lists the peers banned for misbehaving.
+func (as *AdminServer) PeerBans(ctx context.Context, req *ptypes.Empty) (*pb.PeerBansResponse, error) {
+	bans := as.peerManager.PeerBans()
+	res := &pb.PeerBansResponse{Bans: make([]*pb.PeerBan, len(bans))}
+	for i, ban := range bans {
+		expiry, err := ptypes.TimestampProto(ban.Expiry)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert ban expiry: %v", err)
+		}
+		res.Bans[i] = &pb.PeerBan{
+			PeerId: ban.Peer.Pretty(),
+			Score:  int64(ban.Score),
+			Expiry: expiry,
+		}
+	}
+	return res, nil
+}
+
+// ClearPeerBans lifts the bans of the given peers, or of every banned peer if none
+// is given.
+func (as *AdminServer) ClearPeerBans(ctx context.Context, req *pb.ClearPeerBansRequest) (*pb.ClearPeerBansResponse, error) {
+	pids := make([]peer.ID, len(req.PeerIds))
+	for i, id := range req.PeerIds {
+		pid, err := peer.IDB58Decode(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer ID %s: %v", id, err)
+		}
+		pids[i] = pid
+	}
+	cleared := as.peerManager.ClearPeerBans(pids...)
+	log.WithField("cleared", cleared).Info("Cleared peer bans")
+	return &pb.ClearPeerBansResponse{Cleared: uint64(cleared)}, nil
+}
@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+)
+
+type mockPeerManager struct {
+	bans    []p2p.PeerBan
+	cleared []peer.ID
+}
+
+func (m *mockPeerManager) PeerBans() []p2p.PeerBan {
+	return m.bans
+}
+
+func (m *mockPeerManager) ClearPeerBans(pids ...peer.ID) int {
+	m.cleared = pids
+	return len(m.bans)
+}
+
+func TestPeerBans_OK(t *testing.T) {
+	pid, err := peer.IDB58Decode("QmTtFWBbtFn8bcZcBbgfRWf4fAtD1XgtosL4GYYfF4rZPN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiry := time.Unix(1000, 0)
+	adminServer := &AdminServer{peerManager: &mockPeerManager{
+		bans: []p2p.PeerBan{{Peer: pid, Score: -100, Expiry: expiry}},
+	}}
+
+	res, err := adminServer.PeerBans(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not list peer bans: %v", err)
+	}
+	if len(res.Bans) != 1 {
+		t.Fatalf("Expected 1 ban, received %d", len(res.Bans))
+	}
+	if res.Bans[0].PeerId != pid.Pretty() || res.Bans[0].Score != -100 {
+		t.Errorf("Unexpected ban %v", res.Bans[0])
+	}
+	if res.Bans[0].Expiry.Seconds != expiry.Unix() {
+		t.Errorf("Expected expiry %d, received %d", expiry.Unix(), res.Bans[0].Expiry.Seconds)
+	}
+}
+
+func TestClearPeerBans_OK(t *testing.T) {
+	pid, err := peer.IDB58Decode("QmTtFWBbtFn8bcZcBbgfRWf4fAtD1XgtosL4GYYfF4rZPN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := &mockPeerManager{bans: []p2p.PeerBan{{Peer: pid}}}
+	adminServer := &AdminServer{peerManager: manager}
+
+	res, err := adminServer.ClearPeerBans(context.Background(), &pb.ClearPeerBansRequest{
+		PeerIds: []string{pid.Pretty()},
+	})
+	if err != nil {
+		t.Fatalf("Could not clear peer bans: %v", err)
+	}
+	if res.Cleared != 1 {
+		t.Errorf("Expected 1 ban cleared, received %d", res.Cleared)
+	}
+	if len(manager.cleared) != 1 || manager.cleared[0] != pid {
+		t.Errorf("Expected the ban of %s to be cleared, cleared %v", pid.Pretty(), manager.cleared)
+	}
+
+	if _, err := adminServer.ClearPeerBans(context.Background(), &pb.ClearPeerBansRequest{
+		PeerIds: []string{"invalid"},
+	}); err == nil {
+		t.Error("Expected an invalid peer ID to be rejected")
+	}
+}
@@ -35,9 +35,9 @@ type BeaconServer struct {
 // subscribes to an event stream triggered by the powchain service whenever the ChainStart log does
 // occur in the Deposit Contract on ETH 1.0.
 func (bs *BeaconServer) WaitForChainStart(req *ptypes.Empty, stream pb.BeaconService_WaitForChainStartServer) error {
-	ok, genesisTime, err := bs.powChainService.HasChainStartLogOccurred()
+	ok, genesisTime, err := bs.hasChainStarted(bs.ctx)
 	if err != nil {
-		return fmt.Errorf("could not determine if ChainStart log has occurred: %v", err)
+		return err
 	}
 	if ok {
 		res := &pb.ChainStartResponse{
@@ -66,6 +66,27 @@ func (bs *BeaconServer) WaitForChainStart(req *ptypes.Empty, stream pb.BeaconSer
 	}
 }
 
+// hasChainStarted determines whether the beacon chain has started and its genesis
+// time, from the ChainStart log of the deposit contract. Without a POW chain, the
+// chain starts from the genesis state given to the node once it is saved.
+func (bs *BeaconServer) hasChainStarted(ctx context.Context) (bool, uint64, error) {
+	if bs.powChainService == nil {
+		beaconState, err := bs.beaconDB.HeadState(ctx)
+		if err != nil {
+			return false, 0, fmt.Errorf("could not fetch beacon state: %v", err)
+		}
+		if beaconState == nil {
+			return false, 0, nil
+		}
+		return true, beaconState.GenesisTime, nil
+	}
+	ok, genesisTime, err := bs.powChainService.HasChainStartLogOccurred()
+	if err != nil {
+		return false, 0, fmt.Errorf("could not determine if ChainStart log has occurred: %v", err)
+	}
+	return ok, genesisTime, nil
+}
+
 // CanonicalHead of the current beacon chain. This method is requested on-demand
 // by a validator when it is their time to propose or attest.
 func (bs *BeaconServer) CanonicalHead(ctx context.Context, req *ptypes.Empty) (*pbp2p.BeaconBlock, error) {
@@ -97,6 +118,67 @@ func (bs *BeaconServer) LatestAttestation(req *ptypes.Empty, stream pb.BeaconSer
 	}
 }
 
+// ChainEvents streams the chain head changes, reorgs and new justified or finalized
+// blocks of the beacon node to the rpc clients.
+func (bs *BeaconServer) ChainEvents(req *ptypes.Empty, stream pb.BeaconService_ChainEventsServer) error {
+	// The events are relayed from the feed into a buffer which drops them once a
+	// slow stream let it fill up, so the chain service never waits for a client.
+	events := make(chan *pb.ChainEvent, params.BeaconConfig().DefaultBufferSize)
+	feedEvents := make(chan *pb.ChainEvent)
+	done := make(chan struct{})
+	defer close(done)
+	sub := bs.chainService.ChainEventFeed().Subscribe(feedEvents)
+	defer sub.Unsubscribe()
+	go func() {
+		for {
+			select {
+			case ev := <-feedEvents:
+				select {
+				case events <- ev:
+				default:
+					log.WithField("type", ev.Type).Debug("Dropping chain event for a slow stream")
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	for {
+		select {
+		case ev := <-events:
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+		case <-sub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return nil
+		case <-stream.Context().Done():
+			log.Debug("Stream context closed, exiting goroutine")
+			return nil
+		case <-bs.ctx.Done():
+			log.Debug("RPC context closed, exiting goroutine")
+			return nil
+		}
+	}
+}
+
+// FinalizedCheckpoint returns the last finalized state and block of the beacon
+// node, which a fresh beacon node can seed its db with to sync from.
+func (bs *BeaconServer) FinalizedCheckpoint(ctx context.Context, _ *ptypes.Empty) (*pb.CheckpointResponse, error) {
+	finalizedState, err := bs.beaconDB.FinalizedState()
+	if err != nil {
+		return nil, fmt.Errorf("could not get finalized state: %v", err)
+	}
+	finalizedBlock, err := bs.beaconDB.FinalizedBlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not get finalized block: %v", err)
+	}
+	return &pb.CheckpointResponse{
+		State: finalizedState,
+		Block: finalizedBlock,
+	}, nil
+}
+
 // ForkData fetches the current fork information from the beacon state.
 func (bs *BeaconServer) ForkData(ctx context.Context, _ *ptypes.Empty) (*pbp2p.Fork, error) {
 	state, err := bs.beaconDB.HeadState(ctx)
@@ -116,6 +198,10 @@ func (bs *BeaconServer) Eth1Data(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
 	}
+	if bs.powChainService == nil {
+		// Without a POW chain, proposers keep voting for the eth1 data of the state.
+		return &pb.Eth1DataResponse{Eth1Data: beaconState.LatestEth1Data}, nil
+	}
 	// Fetch the current canonical chain height from the eth1.0 chain.
 	currentHeight := bs.powChainService.LatestBlockHeight()
 	eth1FollowDistance := int64(params.BeaconConfig().Eth1FollowDistance)
@@ -204,12 +290,14 @@ func (bs *BeaconServer) Eth1Data(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1
 // PendingDeposits returns a list of pending deposits that are ready for
 // inclusion in the next beacon block.
 func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*pb.PendingDepositsResponse, error) {
-	bNum := bs.powChainService.LatestBlockHeight()
+	if bs.powChainService == nil {
+		return &pb.PendingDepositsResponse{PendingDeposits: nil}, nil
+	}
+	// Only request deposits that have passed the ETH1 follow distance window.
+	bNum := bs.powChainService.FollowedBlockHeight()
 	if bNum == nil {
 		return nil, errors.New("latest PoW block number is unknown")
 	}
-	// Only request deposits that have passed the ETH1 follow distance window.
-	bNum = bNum.Sub(bNum, big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance)))
 	allDeps := bs.beaconDB.AllDeposits(ctx, bNum)
 	if len(allDeps) == 0 {
 		return &pb.PendingDepositsResponse{PendingDeposits: nil}, nil
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
@@ -41,6 +42,10 @@ func (f *faultyPOWChainService) LatestBlockHeight() *big.Int {
 	return big.NewInt(0)
 }
 
+func (f *faultyPOWChainService) FollowedBlockHeight() *big.Int {
+	return big.NewInt(0)
+}
+
 func (f *faultyPOWChainService) BlockExists(_ context.Context, hash common.Hash) (bool, *big.Int, error) {
 	if f.hashesByHeight == nil {
 		return false, big.NewInt(1), errors.New("failed")
@@ -86,6 +91,13 @@ func (m *mockPOWChainService) LatestBlockHeight() *big.Int {
 	return m.latestBlockNumber
 }
 
+func (m *mockPOWChainService) FollowedBlockHeight() *big.Int {
+	if m.latestBlockNumber == nil {
+		return nil
+	}
+	return big.NewInt(0).Sub(m.latestBlockNumber, big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance)))
+}
+
 func (m *mockPOWChainService) DepositTrie() *trieutil.MerkleTrie {
 	return &trieutil.MerkleTrie{}
 }
@@ -172,6 +184,32 @@ func TestWaitForChainStart_AlreadyStarted(t *testing.T) {
 	}
 }
 
+func TestWaitForChainStart_NoPOWChain(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	genesisTime := uint64(time.Unix(1000, 0).Unix())
+	if err := db.InitializeState(context.Background(), genesisTime, nil, &pbp2p.Eth1Data{}); err != nil {
+		t.Fatalf("Could not initialize beacon state: %v", err)
+	}
+	beaconServer := &BeaconServer{
+		ctx:          context.Background(),
+		beaconDB:     db,
+		chainService: newMockChainService(),
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := internal.NewMockBeaconService_WaitForChainStartServer(ctrl)
+	mockStream.EXPECT().Send(
+		&pb.ChainStartResponse{
+			Started:     true,
+			GenesisTime: genesisTime,
+		},
+	).Return(nil)
+	if err := beaconServer.WaitForChainStart(&ptypes.Empty{}, mockStream); err != nil {
+		t.Errorf("Could not call RPC method: %v", err)
+	}
+}
+
 func TestWaitForChainStart_NotStartedThenLogFired(t *testing.T) {
 	hook := logTest.NewGlobal()
 	beaconServer := &BeaconServer{
@@ -288,6 +326,110 @@ func TestLatestAttestation_SendsCorrectly(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Sending attestation to RPC clients")
 }
 
+func TestChainEvents_StreamContextClosed(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconServer := &BeaconServer{
+		ctx:          context.Background(),
+		chainService: newMockChainService(),
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockStream := internal.NewMockBeaconService_ChainEventsServer(ctrl)
+	mockStream.EXPECT().Context().Return(streamCtx).AnyTimes()
+	if err := beaconServer.ChainEvents(&ptypes.Empty{}, mockStream); err != nil {
+		t.Errorf("Could not call RPC method: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Stream context closed, exiting goroutine")
+}
+
+func TestChainEvents_SendsEvents(t *testing.T) {
+	chainService := newMockChainService()
+	beaconServer := &BeaconServer{
+		ctx:          context.Background(),
+		chainService: chainService,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	event := &pb.ChainEvent{
+		Type:           pb.ChainEventType_REORG,
+		Head:           &pb.BlockRoot{Slot: 5, Root: []byte{'A'}},
+		OldHead:        &pb.BlockRoot{Slot: 4, Root: []byte{'B'}},
+		CommonAncestor: &pb.BlockRoot{Slot: 2, Root: []byte{'C'}},
+		ReorgDepth:     2,
+	}
+	sent := make(chan bool)
+	mockStream := internal.NewMockBeaconService_ChainEventsServer(ctrl)
+	mockStream.EXPECT().Context().Return(streamCtx).AnyTimes()
+	mockStream.EXPECT().Send(event).Do(func(_ *pb.ChainEvent) {
+		sent <- true
+	}).Return(nil)
+
+	exitRoutine := make(chan bool)
+	go func(tt *testing.T) {
+		if err := beaconServer.ChainEvents(&ptypes.Empty{}, mockStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		exitRoutine <- true
+	}(t)
+	// Wait for the stream to subscribe to the feed.
+	for chainService.chainEventFeed.Send(event) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	<-sent
+	cancel()
+	<-exitRoutine
+}
+
+func TestChainEvents_DropsEventsForSlowStream(t *testing.T) {
+	chainService := newMockChainService()
+	beaconServer := &BeaconServer{
+		ctx:          context.Background(),
+		chainService: chainService,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	event := &pb.ChainEvent{Type: pb.ChainEventType_HEAD}
+	unblock := make(chan bool)
+	mockStream := internal.NewMockBeaconService_ChainEventsServer(ctrl)
+	mockStream.EXPECT().Context().Return(streamCtx).AnyTimes()
+	mockStream.EXPECT().Send(event).Do(func(_ *pb.ChainEvent) {
+		<-unblock
+	}).Return(nil).AnyTimes()
+
+	exitRoutine := make(chan bool)
+	go func(tt *testing.T) {
+		if err := beaconServer.ChainEvents(&ptypes.Empty{}, mockStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		exitRoutine <- true
+	}(t)
+	for chainService.chainEventFeed.Send(event) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	sent := make(chan bool)
+	go func() {
+		for i := 0; i < 2*params.BeaconConfig().DefaultBufferSize; i++ {
+			chainService.chainEventFeed.Send(event)
+		}
+		sent <- true
+	}()
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the feed not to block on a slow stream")
+	}
+	cancel()
+	close(unblock)
+	<-exitRoutine
+}
+
 func TestPendingDeposits_UnknownBlockNum(t *testing.T) {
 	p := &mockPOWChainService{
 		latestBlockNumber: nil,
@@ -527,6 +669,43 @@ func TestPendingDeposits_CantReturnMoreThanMax(t *testing.T) {
 	}
 }
 
+func TestFinalizedCheckpoint_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	finalizedState := &pbp2p.BeaconState{Slot: params.BeaconConfig().GenesisSlot + 64}
+	finalizedBlock := &pbp2p.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 63}
+	if err := db.SaveFinalizedState(finalizedState); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveFinalizedBlock(finalizedBlock); err != nil {
+		t.Fatal(err)
+	}
+
+	beaconServer := &BeaconServer{beaconDB: db}
+	res, err := beaconServer.FinalizedCheckpoint(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	if !proto.Equal(res.State, finalizedState) {
+		t.Errorf("Expected state %v, received %v", finalizedState, res.State)
+	}
+	if !proto.Equal(res.Block, finalizedBlock) {
+		t.Errorf("Expected block %v, received %v", finalizedBlock, res.Block)
+	}
+}
+
+func TestFinalizedCheckpoint_NoFinalizedState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	beaconServer := &BeaconServer{beaconDB: db}
+	want := "could not get finalized state"
+	if _, err := beaconServer.FinalizedCheckpoint(context.Background(), &ptypes.Empty{}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error %v, received %v", want, err)
+	}
+}
+
 func TestEth1Data_EmptyVotesFetchBlockHashFailure(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -0,0 +1,35 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// NodeServer defines a server implementation of the gRPC Node service,
+// providing RPC methods for the operator of the beacon node to follow its sync.
+type NodeServer struct {
+	syncService syncService
+}
+
+// SyncStatus returns how far the node is from the head of the network, along
+// with the rate of sync and the estimated time until the node is synced.
+func (ns *NodeServer) SyncStatus(ctx context.Context, req *ptypes.Empty) (*pb.SyncStatusResponse, error) {
+	status, err := ns.syncService.SyncStatus()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve sync status: %v", err)
+	}
+	res := &pb.SyncStatusResponse{
+		Syncing:             status.Syncing,
+		HeadSlot:            status.HeadSlot,
+		HighestObservedSlot: status.HighestObservedSlot,
+		BlocksPerSecond:     status.BlocksPerSecond,
+		SecondsRemaining:    uint64(status.TimeRemaining.Seconds()),
+	}
+	if status.BestPeer != "" {
+		res.BestPeerId = status.BestPeer.Pretty()
+	}
+	return res, nil
+}
@@ -0,0 +1,40 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	peer "github.com/libp2p/go-libp2p-peer"
+	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+)
+
+func TestSyncStatus_OK(t *testing.T) {
+	pid, err := peer.IDB58Decode("QmTtFWBbtFn8bcZcBbgfRWf4fAtD1XgtosL4GYYfF4rZPN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodeServer := &NodeServer{syncService: &mockSyncService{syncStatus: &rbcsync.SyncStatus{
+		Syncing:             true,
+		HeadSlot:            10,
+		HighestObservedSlot: 110,
+		BestPeer:            pid,
+		BlocksPerSecond:     2,
+		TimeRemaining:       50 * time.Second,
+	}}}
+
+	res, err := nodeServer.SyncStatus(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not get sync status: %v", err)
+	}
+	if !res.Syncing || res.HeadSlot != 10 || res.HighestObservedSlot != 110 {
+		t.Errorf("Unexpected sync status %v", res)
+	}
+	if res.BestPeerId != pid.Pretty() {
+		t.Errorf("Expected best peer %s, received %s", pid.Pretty(), res.BestPeerId)
+	}
+	if res.BlocksPerSecond != 2 || res.SecondsRemaining != 50 {
+		t.Errorf("Expected 2 blocks per second and 50 seconds remaining, received %v", res)
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -13,7 +13,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
-	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // ProposerServer defines a server implementation of the gRPC Proposer service,
@@ -87,57 +86,52 @@ func (ps *ProposerServer) ProposeBlock(ctx context.Context, blk *pbp2p.BeaconBlo
 
 // PendingAttestations retrieves attestations kept in the beacon node's operations pool which have
 // not yet been included into the beacon chain. Proposers include these pending attestations in their
-// proposed blocks when performing their responsibility. If desired, callers can choose to filter pending
-// attestations which are ready for inclusion. That is, attestations that satisfy:
-// attestation.slot + MIN_ATTESTATION_INCLUSION_DELAY <= state.slot.
+// proposed blocks when performing their responsibility. Only the attestations which are ready and valid
+// for inclusion in a block at the requested proposal slot are returned.
 func (ps *ProposerServer) PendingAttestations(ctx context.Context, req *pb.PendingAttestationsRequest) (*pb.PendingAttestationsResponse, error) {
-	beaconState, err := ps.beaconDB.HeadState(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
-	}
-	atts, err := ps.operationService.PendingAttestations(ctx)
+	atts, err := ps.operationService.PendingAttestations(ctx, req.ProposalBlockSlot)
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve pending attestations from operations service: %v", err)
 	}
-	head, err := ps.beaconDB.ChainHead()
+	return &pb.PendingAttestationsResponse{
+		PendingAttestations: atts,
+	}, nil
+}
+
+// PendingOperations retrieves the proposer slashings, attester slashings and exit requests kept
+// in the beacon node's operations pool which can be included together in the next proposed block.
+// Attester slashings which only slash proposers already slashed by a proposer slashing, and exits
+// of validators which are about to be slashed, are left out as the block would otherwise be invalid.
+func (ps *ProposerServer) PendingOperations(ctx context.Context, _ *ptypes.Empty) (*pb.PendingOperationsResponse, error) {
+	proposerSlashings, err := ps.operationService.PendingProposerSlashings(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve chain head: %v", err)
+		return nil, fmt.Errorf("could not retrieve pending proposer slashings from operations service: %v", err)
 	}
-	blockRoot, err := hashutil.HashBeaconBlock(head)
-	if err != nil {
-		return nil, fmt.Errorf("could not hash beacon block: %v", err)
+	slashed := make(map[uint64]bool)
+	for _, slashing := range proposerSlashings {
+		slashed[slashing.ProposerIndex] = true
 	}
-
-	for beaconState.Slot < req.ProposalBlockSlot-1 {
-		beaconState, err = state.ExecuteStateTransition(
-			ctx, beaconState, nil /* block */, blockRoot, &state.TransitionConfig{},
-		)
-		if err != nil {
-			return nil, fmt.Errorf("could not execute head transition: %v", err)
-		}
+	attesterSlashings, err := ps.operationService.PendingAttesterSlashings(ctx, slashed)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending attester slashings from operations service: %v", err)
 	}
-	beaconState.Slot++
-
-	var attsReadyForInclusion []*pbp2p.Attestation
-	for _, att := range atts {
-		if att.Data.Slot+params.BeaconConfig().MinAttestationInclusionDelay <= beaconState.Slot {
-			attsReadyForInclusion = append(attsReadyForInclusion, att)
-		}
+	exits, err := ps.operationService.PendingExits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending exits from operations service: %v", err)
 	}
 
-	validAtts := make([]*pbp2p.Attestation, 0, len(attsReadyForInclusion))
-	for _, att := range attsReadyForInclusion {
-		if err := blocks.VerifyAttestation(beaconState, att, false); err != nil {
-			log.WithError(err).WithField(
-				"slot", att.Data.Slot-params.BeaconConfig().GenesisSlot).Warn(
-				"Skipping, pending attestation failed verification")
+	validExits := make([]*pbp2p.VoluntaryExit, 0, len(exits))
+	for _, exit := range exits {
+		if slashed[exit.ValidatorIndex] {
 			continue
 		}
-		validAtts = append(validAtts, att)
+		validExits = append(validExits, exit)
 	}
 
-	return &pb.PendingAttestationsResponse{
-		PendingAttestations: validAtts,
+	return &pb.PendingOperationsResponse{
+		ProposerSlashings: proposerSlashings,
+		AttesterSlashings: attesterSlashings,
+		VoluntaryExits:    validExits,
 	}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	ptypes "github.com/gogo/protobuf/types"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -136,30 +137,22 @@ func TestComputeStateRoot_OK(t *testing.T) {
 	_, _ = proposerServer.ComputeStateRoot(context.Background(), req)
 }
 
-func TestPendingAttestations_FiltersWithinInclusionDelay(t *testing.T) {
+func TestPendingAttestations_OK(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	ctx := context.Background()
 
-	stateSlot := params.BeaconConfig().GenesisSlot + params.BeaconConfig().MinAttestationInclusionDelay + 100
-	beaconState := &pbp2p.BeaconState{
-		Slot: stateSlot,
-		LatestCrosslinks: []*pbp2p.Crosslink{{
-			Epoch:                   params.BeaconConfig().GenesisEpoch + 1,
-			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-		}},
-	}
 	proposerServer := &ProposerServer{
-		operationService: &mockOperationService{
-			pendingAttestations: []*pbp2p.Attestation{
-				{Data: &pbp2p.AttestationData{
-					Slot:                    beaconState.Slot - params.BeaconConfig().MinAttestationInclusionDelay,
-					CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-				}},
-			},
-		},
-		chainService: &mockChainService{},
-		beaconDB:     db,
+		operationService: &mockOperationService{},
+		chainService:     &mockChainService{},
+		beaconDB:         db,
+	}
+	beaconState := &pbp2p.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot +
+			params.BeaconConfig().SlotsPerEpoch +
+			params.BeaconConfig().MinAttestationInclusionDelay,
+		LatestCrosslinks: []*pbp2p.Crosslink{{Epoch: params.BeaconConfig().GenesisEpoch + 1,
+			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:]}},
 	}
 	if err := db.SaveState(ctx, beaconState); err != nil {
 		t.Fatal(err)
@@ -188,181 +181,37 @@ func TestPendingAttestations_FiltersWithinInclusionDelay(t *testing.T) {
 	}
 }
 
-func TestPendingAttestations_FiltersExpiredAttestations(t *testing.T) {
-	db := internal.SetupDB(t)
-	defer internal.TeardownDB(t, db)
+func TestPendingOperations_FiltersSlashedExits(t *testing.T) {
 	ctx := context.Background()
 
-	// Edge case: current slot is at the end of an epoch. The pending attestation
-	// for the next slot should come from currentSlot + 1.
-	currentSlot := helpers.StartSlot(
-		params.BeaconConfig().GenesisEpoch+10,
-	) - 1
-
-	expectedEpoch := uint64(100)
-
-	opService := &mockOperationService{
-		pendingAttestations: []*pbp2p.Attestation{
-			//Expired attestations
-			{Data: &pbp2p.AttestationData{
-				Slot:                    0,
-				JustifiedEpoch:          expectedEpoch,
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			}},
-			{Data: &pbp2p.AttestationData{
-				Slot:                    currentSlot - 10000,
-				JustifiedEpoch:          expectedEpoch,
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			}},
-			{Data: &pbp2p.AttestationData{
-				Slot:                    currentSlot - 5000,
-				JustifiedEpoch:          expectedEpoch,
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			}},
-			{Data: &pbp2p.AttestationData{
-				Slot:                    currentSlot - 100,
-				JustifiedEpoch:          expectedEpoch,
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			}},
-			{Data: &pbp2p.AttestationData{
-				Slot:                    currentSlot - params.BeaconConfig().SlotsPerEpoch,
-				JustifiedEpoch:          expectedEpoch,
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			}},
-			// Non-expired attestation with incorrect justified epoch
-			{Data: &pbp2p.AttestationData{
-				Slot:                    currentSlot - 5,
-				JustifiedEpoch:          expectedEpoch - 1,
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			}},
-			// Non-expired attestations with correct justified epoch
-			{Data: &pbp2p.AttestationData{
-				Slot:                    currentSlot - 5,
-				JustifiedEpoch:          expectedEpoch,
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			}},
-			{Data: &pbp2p.AttestationData{
-				Slot:                    currentSlot - 2,
-				JustifiedEpoch:          expectedEpoch,
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			}},
-			{Data: &pbp2p.AttestationData{
-				Slot:                    currentSlot,
-				JustifiedEpoch:          expectedEpoch,
-				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-			}},
-		},
+	attesterSlashing := func(indices []uint64) *pbp2p.AttesterSlashing {
+		return &pbp2p.AttesterSlashing{
+			SlashableAttestation_1: &pbp2p.SlashableAttestation{ValidatorIndices: indices},
+			SlashableAttestation_2: &pbp2p.SlashableAttestation{ValidatorIndices: indices},
+		}
 	}
-	expectedNumberOfAttestations := 3
+	proposerSlashings := []*pbp2p.ProposerSlashing{{ProposerIndex: 0}}
+	attesterSlashings := []*pbp2p.AttesterSlashing{attesterSlashing([]uint64{1})}
+	exits := []*pbp2p.VoluntaryExit{{ValidatorIndex: 0}, {ValidatorIndex: 1}, {ValidatorIndex: 2}}
 	proposerServer := &ProposerServer{
-		operationService: opService,
-		chainService:     &mockChainService{},
-		beaconDB:         db,
-	}
-	beaconState := &pbp2p.BeaconState{
-		Slot:                   currentSlot + params.BeaconConfig().MinAttestationInclusionDelay,
-		JustifiedEpoch:         expectedEpoch,
-		PreviousJustifiedEpoch: expectedEpoch,
-		LatestCrosslinks: []*pbp2p.Crosslink{{
-			Epoch:                   params.BeaconConfig().GenesisEpoch + 9,
-			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-		}},
-	}
-	if err := db.SaveState(ctx, beaconState); err != nil {
-		t.Fatal(err)
-	}
-
-	blk := &pbp2p.BeaconBlock{
-		Slot: beaconState.Slot,
-	}
-
-	if err := db.SaveBlock(blk); err != nil {
-		t.Fatalf("failed to save block %v", err)
-	}
-
-	if err := db.UpdateChainHead(ctx, blk, beaconState); err != nil {
-		t.Fatalf("couldnt update chainhead: %v", err)
-	}
-
-	res, err := proposerServer.PendingAttestations(
-		context.Background(),
-		&pb.PendingAttestationsRequest{
-			ProposalBlockSlot: currentSlot,
+		operationService: &mockOperationService{
+			pendingProposerSlashings: proposerSlashings,
+			pendingAttesterSlashings: attesterSlashings,
+			pendingExits:             exits,
 		},
-	)
-	if err != nil {
-		t.Fatalf("Unexpected error fetching pending attestations: %v", err)
 	}
-	if len(res.PendingAttestations) != expectedNumberOfAttestations {
-		t.Errorf(
-			"Expected pending attestations list length %d, but was %d",
-			expectedNumberOfAttestations,
-			len(res.PendingAttestations),
-		)
-	}
-
-	expectedAtts := []*pbp2p.Attestation{
-		{Data: &pbp2p.AttestationData{
-			Slot:                    currentSlot - 5,
-			JustifiedEpoch:          expectedEpoch,
-			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-		}},
-		{Data: &pbp2p.AttestationData{
-			Slot:                    currentSlot - 2,
-			JustifiedEpoch:          expectedEpoch,
-			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-		}},
-		{Data: &pbp2p.AttestationData{
-			Slot:                    currentSlot,
-			JustifiedEpoch:          expectedEpoch,
-			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
-		}},
-	}
-	if !reflect.DeepEqual(res.PendingAttestations, expectedAtts) {
-		t.Error("Did not receive expected attestations")
-	}
-}
 
-func TestPendingAttestations_OK(t *testing.T) {
-	db := internal.SetupDB(t)
-	defer internal.TeardownDB(t, db)
-	ctx := context.Background()
-
-	proposerServer := &ProposerServer{
-		operationService: &mockOperationService{},
-		chainService:     &mockChainService{},
-		beaconDB:         db,
-	}
-	beaconState := &pbp2p.BeaconState{
-		Slot: params.BeaconConfig().GenesisSlot +
-			params.BeaconConfig().SlotsPerEpoch +
-			params.BeaconConfig().MinAttestationInclusionDelay,
-		LatestCrosslinks: []*pbp2p.Crosslink{{Epoch: params.BeaconConfig().GenesisEpoch + 1,
-			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:]}},
-	}
-	if err := db.SaveState(ctx, beaconState); err != nil {
-		t.Fatal(err)
-	}
-
-	blk := &pbp2p.BeaconBlock{
-		Slot: beaconState.Slot,
-	}
-
-	if err := db.SaveBlock(blk); err != nil {
-		t.Fatalf("failed to save block %v", err)
+	res, err := proposerServer.PendingOperations(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Unexpected error fetching pending operations: %v", err)
 	}
-
-	if err := db.UpdateChainHead(ctx, blk, beaconState); err != nil {
-		t.Fatalf("couldnt update chainhead: %v", err)
+	if !reflect.DeepEqual(res.ProposerSlashings, proposerSlashings) {
+		t.Errorf("Expected proposer slashings %v, received %v", proposerSlashings, res.ProposerSlashings)
 	}
-
-	res, err := proposerServer.PendingAttestations(context.Background(), &pb.PendingAttestationsRequest{
-		ProposalBlockSlot: blk.Slot + 1,
-	})
-	if err != nil {
-		t.Fatalf("Unexpected error fetching pending attestations: %v", err)
+	if !reflect.DeepEqual(res.AttesterSlashings, attesterSlashings) {
+		t.Errorf("Expected attester slashings %v, received %v", attesterSlashings, res.AttesterSlashings)
 	}
-	if len(res.PendingAttestations) == 0 {
-		t.Error("Expected pending attestations list to be non-empty")
+	if !reflect.DeepEqual(res.VoluntaryExits, exits[2:]) {
+		t.Errorf("Expected exits %v, received %v", exits[2:], res.VoluntaryExits)
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -15,6 +16,7 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -24,8 +26,10 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 var log logrus.FieldLogger
@@ -36,12 +40,16 @@ func init() {
 
 type chainService interface {
 	StateInitializedFeed() *event.Feed
+	ChainEventFeed() *event.Feed
 	blockchain.BlockReceiver
 	blockchain.ForkChoice
 }
 
 type operationService interface {
-	PendingAttestations(ctx context.Context) ([]*pbp2p.Attestation, error)
+	PendingAttestations(ctx context.Context, proposalSlot uint64) ([]*pbp2p.Attestation, error)
+	PendingProposerSlashings(ctx context.Context) ([]*pbp2p.ProposerSlashing, error)
+	PendingAttesterSlashings(ctx context.Context, slashed map[uint64]bool) ([]*pbp2p.AttesterSlashing, error)
+	PendingExits(ctx context.Context) ([]*pbp2p.VoluntaryExit, error)
 	HandleAttestations(context.Context, proto.Message) error
 	IncomingAttFeed() *event.Feed
 }
@@ -50,6 +58,7 @@ type powChainService interface {
 	HasChainStartLogOccurred() (bool, uint64, error)
 	ChainStartFeed() *event.Feed
 	LatestBlockHeight() *big.Int
+	FollowedBlockHeight() *big.Int
 	BlockExists(ctx context.Context, hash common.Hash) (bool, *big.Int, error)
 	BlockHashByHeight(ctx context.Context, height *big.Int) (common.Hash, error)
 	BlockTimeByHeight(ctx context.Context, height *big.Int) (uint64, error)
@@ -60,6 +69,20 @@ type powChainService interface {
 
 type syncService interface {
 	Status() error
+	SyncStatus() (*rbcsync.SyncStatus, error)
+}
+
+// dutyMethods are the services, or single methods, serving validator duties,
+// which are unavailable while the node is syncing.
+var dutyMethods = []string{
+	"/ethereum.beacon.rpc.v1.AttesterService/",
+	"/ethereum.beacon.rpc.v1.ProposerService/",
+	"/ethereum.beacon.rpc.v1.ValidatorService/",
+	"/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead",
+	"/ethereum.beacon.rpc.v1.BeaconService/LatestAttestation",
+	"/ethereum.beacon.rpc.v1.BeaconService/PendingDeposits",
+	"/ethereum.beacon.rpc.v1.BeaconService/Eth1Data",
+	"/ethereum.beacon.rpc.v1.BeaconService/ForkData",
 }
 
 // Service defining an RPC server for a beacon node.
@@ -80,6 +103,7 @@ type Service struct {
 	incomingAttestation chan *pbp2p.Attestation
 	credentialError     error
 	p2p                 p2p.Broadcaster
+	peerManager         peerManager
 }
 
 // Config options for the beacon node RPC server.
@@ -93,6 +117,7 @@ type Config struct {
 	OperationService operationService
 	SyncService      syncService
 	Broadcaster      p2p.Broadcaster
+	PeerManager      peerManager
 }
 
 // NewRPCService creates a new instance of a struct implementing the BeaconServiceServer
@@ -104,6 +129,7 @@ func NewRPCService(ctx context.Context, cfg *Config) *Service {
 		cancel:              cancel,
 		beaconDB:            cfg.BeaconDB,
 		p2p:                 cfg.Broadcaster,
+		peerManager:         cfg.PeerManager,
 		chainService:        cfg.ChainService,
 		powChainService:     cfg.POWChainService,
 		operationService:    cfg.OperationService,
@@ -131,10 +157,12 @@ func (s *Service) Start() {
 		grpc.StreamInterceptor(middleware.ChainStreamServer(
 			recovery.StreamServerInterceptor(),
 			grpc_prometheus.StreamServerInterceptor,
+			s.syncStreamInterceptor,
 		)),
 		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
 			recovery.UnaryServerInterceptor(),
 			grpc_prometheus.UnaryServerInterceptor,
+			s.syncUnaryInterceptor,
 		)),
 	}
 	// TODO(#791): Utilize a certificate for secure connections
@@ -184,14 +212,17 @@ func (s *Service) Start() {
 	pb.RegisterProposerServiceServer(s.grpcServer, proposerServer)
 	pb.RegisterAttesterServiceServer(s.grpcServer, attesterServer)
 	pb.RegisterValidatorServiceServer(s.grpcServer, validatorServer)
+	pb.RegisterNodeServiceServer(s.grpcServer, &NodeServer{syncService: s.syncService})
+	if s.peerManager != nil {
+		pb.RegisterAdminServiceServer(s.grpcServer, &AdminServer{peerManager: s.peerManager})
+	}
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
 
+	// The server is started right away so the sync status can be queried, while
+	// the duty services are unavailable until the node is synced.
 	go func() {
-		for s.syncService.Status() != nil {
-			time.Sleep(time.Second * params.BeaconConfig().RPCSyncCheck)
-		}
 		if s.listener != nil {
 			if err := s.grpcServer.Serve(s.listener); err != nil {
 				log.Errorf("Could not serve gRPC: %v", err)
@@ -200,6 +231,53 @@ func (s *Service) Start() {
 	}()
 }
 
+// checkSynced returns an Unavailable error for the duty methods while the node
+// is syncing.
+func (s *Service) checkSynced(fullMethod string) error {
+	if !isDutyMethod(fullMethod) {
+		return nil
+	}
+	if err := s.syncService.Status(); err != nil {
+		return status.Errorf(codes.Unavailable, "node is syncing: %v", err)
+	}
+	return nil
+}
+
+// isDutyMethod returns true if the method is one of the duty methods, or belongs
+// to one of the duty services.
+func isDutyMethod(fullMethod string) bool {
+	for _, method := range dutyMethods {
+		if fullMethod == method || (strings.HasSuffix(method, "/") && strings.HasPrefix(fullMethod, method)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Service) syncUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := s.checkSynced(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Service) syncStreamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := s.checkSynced(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
 // Stop the service.
 func (s *Service) Stop() error {
 	log.Info("Stopping service")
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -16,6 +17,9 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func init() {
@@ -33,7 +37,27 @@ func (t *TestLogger) Errorf(format string, args ...interface{}) {
 }
 
 type mockOperationService struct {
-	pendingAttestations []*pb.Attestation
+	pendingAttestations      []*pb.Attestation
+	pendingProposerSlashings []*pb.ProposerSlashing
+	pendingAttesterSlashings []*pb.AttesterSlashing
+	pendingExits             []*pb.VoluntaryExit
+}
+
+func (ms *mockOperationService) PendingProposerSlashings(_ context.Context) ([]*pb.ProposerSlashing, error) {
+	return ms.pendingProposerSlashings, nil
+}
+
+func (ms *mockOperationService) PendingAttesterSlashings(_ context.Context, slashed map[uint64]bool) ([]*pb.AttesterSlashing, error) {
+	for _, slashing := range ms.pendingAttesterSlashings {
+		for _, idx := range slashing.SlashableAttestation_1.ValidatorIndices {
+			slashed[idx] = true
+		}
+	}
+	return ms.pendingAttesterSlashings, nil
+}
+
+func (ms *mockOperationService) PendingExits(_ context.Context) ([]*pb.VoluntaryExit, error) {
+	return ms.pendingExits, nil
 }
 
 func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
@@ -48,7 +72,7 @@ func (ms *mockOperationService) HandleAttestations(_ context.Context, _ proto.Me
 	return nil
 }
 
-func (ms *mockOperationService) PendingAttestations(_ context.Context) ([]*pb.Attestation, error) {
+func (ms *mockOperationService) PendingAttestations(_ context.Context, _ uint64) ([]*pb.Attestation, error) {
 	if ms.pendingAttestations != nil {
 		return ms.pendingAttestations, nil
 	}
@@ -82,6 +106,7 @@ type mockChainService struct {
 	stateFeed            *event.Feed
 	attestationFeed      *event.Feed
 	stateInitializedFeed *event.Feed
+	chainEventFeed       *event.Feed
 	canonicalBlocks      map[uint64][]byte
 }
 
@@ -89,6 +114,10 @@ func (m *mockChainService) StateInitializedFeed() *event.Feed {
 	return m.stateInitializedFeed
 }
 
+func (m *mockChainService) ChainEventFeed() *event.Feed {
+	return m.chainEventFeed
+}
+
 func (m *mockChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock) (*pb.BeaconState, error) {
 	return &pb.BeaconState{}, nil
 }
@@ -123,14 +152,21 @@ func newMockChainService() *mockChainService {
 		stateFeed:            new(event.Feed),
 		attestationFeed:      new(event.Feed),
 		stateInitializedFeed: new(event.Feed),
+		chainEventFeed:       new(event.Feed),
 	}
 }
 
 type mockSyncService struct {
+	status     error
+	syncStatus *rbcsync.SyncStatus
 }
 
 func (ms *mockSyncService) Status() error {
-	return nil
+	return ms.status
+}
+
+func (ms *mockSyncService) SyncStatus() (*rbcsync.SyncStatus, error) {
+	return ms.syncStatus, nil
 }
 
 func TestLifecycle_OK(t *testing.T) {
@@ -190,6 +226,48 @@ func TestStatus_CredentialError(t *testing.T) {
 	}
 }
 
+func TestSyncInterceptor_DutiesUnavailableWhileSyncing(t *testing.T) {
+	s := &Service{syncService: &mockSyncService{status: errors.New("syncing")}}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "served", nil
+	}
+
+	_, err := s.syncUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/AttestHead",
+	}, handler)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected a duty method to be unavailable, received %v", err)
+	}
+
+	_, err = s.syncUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead",
+	}, handler)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected the canonical head to be unavailable, received %v", err)
+	}
+
+	res, err := s.syncUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{
+		FullMethod: "/ethereum.beacon.rpc.v1.NodeService/SyncStatus",
+	}, handler)
+	if err != nil || res != "served" {
+		t.Errorf("Expected the sync status to be served, received %v, %v", res, err)
+	}
+
+	res, err = s.syncUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/FinalizedCheckpoint",
+	}, handler)
+	if err != nil || res != "served" {
+		t.Errorf("Expected the finalized checkpoint to be served, received %v, %v", res, err)
+	}
+
+	s.syncService = &mockSyncService{}
+	if _, err := s.syncUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/AttestHead",
+	}, handler); err != nil {
+		t.Errorf("Expected a duty method to be served once synced, received %v", err)
+	}
+}
+
 func TestRPC_InsecureEndpoint(t *testing.T) {
 	hook := logTest.NewGlobal()
 	rpcService := NewRPCService(context.Background(), &Config{
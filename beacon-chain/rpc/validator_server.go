@@ -249,6 +249,14 @@ func (vs *ValidatorServer) validatorStatus(
 ) *pb.ValidatorStatusResponse {
 	pk := bytesutil.ToBytes32(pubKey)
 	valIdx, ok := idxMap[pk]
+	if ok && vs.powChainService == nil {
+		// Without a POW chain there are no deposits, and the validators are
+		// only known from the state, such as an interop genesis state.
+		return &pb.ValidatorStatusResponse{
+			Status:          vs.lookupValidatorStatusFlag(uint64(valIdx), beaconState),
+			ActivationEpoch: beaconState.ValidatorRegistry[valIdx].ActivationEpoch - params.BeaconConfig().GenesisEpoch,
+		}
+	}
 	_, eth1BlockNumBigInt := vs.beaconDB.DepositByPubkey(ctx, pubKey)
 	if eth1BlockNumBigInt == nil {
 		return &pb.ValidatorStatusResponse{
@@ -0,0 +1,21 @@
+package slasher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	proposerSlashingsDetected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_proposer_slashings_detected",
+		Help: "The number of double proposals detected by the slasher",
+	})
+	attesterSlashingsDetected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attester_slashings_detected",
+		Help: "The number of double votes and surround votes detected by the slasher",
+	})
+	droppedMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_dropped_messages",
+		Help: "The number of blocks and attestations dropped because the slasher was busy",
+	})
+)
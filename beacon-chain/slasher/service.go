@@ -0,0 +1,143 @@
+// Package slasher defines a service which watches the blocks and attestations
+// received by the beacon node for slashable offenses and turns them into
+// proposer and attester slashings for the operations pool.
+package slasher
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	handler "github.com/prysmaticlabs/prysm/shared/messagehandler"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "slasher")
+
+type blockFeeds interface {
+	BlockFeed() *event.Feed
+}
+
+type attestationFeeds interface {
+	IncomingAttestationFeed() *event.Feed
+}
+
+type opsPoolFeeds interface {
+	IncomingProposerSlashingFeed() *event.Feed
+	IncomingAttesterSlashingFeed() *event.Feed
+}
+
+// Service represents a service that indexes the vote history of validators
+// and detects double proposals, double votes and surround votes.
+type Service struct {
+	ctx            context.Context
+	cancel         context.CancelFunc
+	beaconDB       *db.BeaconDB
+	syncService    blockFeeds
+	attsService    attestationFeeds
+	opsPoolService opsPoolFeeds
+	incomingBlock  chan *pb.BeaconBlock
+	incomingAtt    chan *pb.Attestation
+	prunedEpoch    uint64
+}
+
+// Config options for the service.
+type Config struct {
+	BeaconDB       *db.BeaconDB
+	SyncService    blockFeeds
+	AttsService    attestationFeeds
+	OpsPoolService opsPoolFeeds
+}
+
+// NewSlasherService instantiates a new service instance that will
+// be registered into a running beacon node.
+func NewSlasherService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:            ctx,
+		cancel:         cancel,
+		beaconDB:       cfg.BeaconDB,
+		syncService:    cfg.SyncService,
+		attsService:    cfg.AttsService,
+		opsPoolService: cfg.OpsPoolService,
+		incomingBlock:  make(chan *pb.BeaconBlock, params.BeaconConfig().DefaultBufferSize),
+		incomingAtt:    make(chan *pb.Attestation, params.BeaconConfig().DefaultBufferSize),
+	}
+}
+
+// Start the slasher service's main event loop.
+func (s *Service) Start() {
+	log.Info("Starting service")
+	go s.run()
+}
+
+// Stop the slasher service's main event loop and associated goroutines.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	log.Info("Stopping service")
+	return nil
+}
+
+// Status always returns nil.
+// TODO(1201): Add service health checks.
+func (s *Service) Status() error {
+	return nil
+}
+
+// run listens for blocks processed by regular sync and for incoming
+// attestations, checking each of them against the recorded vote history.
+func (s *Service) run() {
+	feedBlocks := make(chan *pb.BeaconBlock)
+	blockSub := s.syncService.BlockFeed().Subscribe(feedBlocks)
+	defer blockSub.Unsubscribe()
+	feedAtts := make(chan *pb.Attestation)
+	attSub := s.attsService.IncomingAttestationFeed().Subscribe(feedAtts)
+	defer attSub.Unsubscribe()
+	go s.relay(feedBlocks, feedAtts)
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			log.Debug("Slasher context closed, exiting goroutine")
+			return
+		case <-blockSub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return
+		case <-attSub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return
+		case block := <-s.incomingBlock:
+			handler.SafelyHandleMessage(s.ctx, s.handleBlock, block)
+		case attestation := <-s.incomingAtt:
+			handler.SafelyHandleMessage(s.ctx, s.handleAttestation, attestation)
+		}
+	}
+}
+
+// relay forwards the blocks and attestations sent to the feeds into the buffers
+// read by run, dropping them once a buffer is full, so that regular sync and
+// the attestation service never wait for the slasher.
+func (s *Service) relay(blocks <-chan *pb.BeaconBlock, atts <-chan *pb.Attestation) {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case block := <-blocks:
+			select {
+			case s.incomingBlock <- block:
+			default:
+				droppedMessages.Inc()
+				log.WithField("slot", block.Slot-params.BeaconConfig().GenesisSlot).Warn("Dropping block, slasher is busy")
+			}
+		case attestation := <-atts:
+			select {
+			case s.incomingAtt <- attestation:
+			default:
+				droppedMessages.Inc()
+				log.WithField("slot", attestation.Data.Slot-params.BeaconConfig().GenesisSlot).Warn("Dropping attestation, slasher is busy")
+			}
+		}
+	}
+}
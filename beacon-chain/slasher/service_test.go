@@ -0,0 +1,127 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func init() {
+	logrus.SetLevel(logrus.DebugLevel)
+}
+
+type mockSyncService struct {
+	blockFeed *event.Feed
+}
+
+func (ms *mockSyncService) BlockFeed() *event.Feed {
+	return ms.blockFeed
+}
+
+type mockAttsService struct {
+	attFeed *event.Feed
+}
+
+func (ma *mockAttsService) IncomingAttestationFeed() *event.Feed {
+	return ma.attFeed
+}
+
+type mockOpsPool struct {
+	proposerSlashingFeed *event.Feed
+	attesterSlashingFeed *event.Feed
+	proposerSlashings    chan *pb.ProposerSlashing
+	attesterSlashings    chan *pb.AttesterSlashing
+}
+
+func newMockOpsPool() *mockOpsPool {
+	mo := &mockOpsPool{
+		proposerSlashingFeed: new(event.Feed),
+		attesterSlashingFeed: new(event.Feed),
+		proposerSlashings:    make(chan *pb.ProposerSlashing, 10),
+		attesterSlashings:    make(chan *pb.AttesterSlashing, 10),
+	}
+	mo.proposerSlashingFeed.Subscribe(mo.proposerSlashings)
+	mo.attesterSlashingFeed.Subscribe(mo.attesterSlashings)
+	return mo
+}
+
+func (mo *mockOpsPool) IncomingProposerSlashingFeed() *event.Feed {
+	return mo.proposerSlashingFeed
+}
+
+func (mo *mockOpsPool) IncomingAttesterSlashingFeed() *event.Feed {
+	return mo.attesterSlashingFeed
+}
+
+func TestStop_OK(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := NewSlasherService(context.Background(), &Config{})
+
+	if err := s.Stop(); err != nil {
+		t.Fatalf("Unable to stop slasher service: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Stopping service")
+
+	// The context should have been canceled.
+	if s.ctx.Err() != context.Canceled {
+		t.Error("context was not canceled")
+	}
+	hook.Reset()
+}
+
+func TestRun_ExitsOnContextClose(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	s := NewSlasherService(context.Background(), &Config{
+		BeaconDB:       beaconDB,
+		SyncService:    &mockSyncService{blockFeed: new(event.Feed)},
+		AttsService:    &mockAttsService{attFeed: new(event.Feed)},
+		OpsPoolService: newMockOpsPool(),
+	})
+
+	exitRoutine := make(chan bool)
+	go func() {
+		s.run()
+		<-exitRoutine
+	}()
+	s.cancel()
+	exitRoutine <- true
+	testutil.AssertLogsContain(t, hook, "Slasher context closed, exiting goroutine")
+}
+
+func TestRelay_DropsMessagesWhenBusy(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := NewSlasherService(context.Background(), &Config{})
+	defer s.cancel()
+	blockFeed := new(event.Feed)
+	feedBlocks := make(chan *pb.BeaconBlock)
+	sub := blockFeed.Subscribe(feedBlocks)
+	defer sub.Unsubscribe()
+	go s.relay(feedBlocks, make(chan *pb.Attestation))
+
+	sent := make(chan bool)
+	go func() {
+		for i := 0; i < 2*params.BeaconConfig().DefaultBufferSize; i++ {
+			blockFeed.Send(&pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + uint64(i)})
+		}
+		sent <- true
+	}()
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Sending blocks blocked on the slasher")
+	}
+	if len(s.incomingBlock) != params.BeaconConfig().DefaultBufferSize {
+		t.Errorf("Expected %d buffered blocks, received %d", params.BeaconConfig().DefaultBufferSize, len(s.incomingBlock))
+	}
+	testutil.AssertLogsContain(t, hook, "Dropping block, slasher is busy")
+}
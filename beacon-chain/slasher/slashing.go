@@ -0,0 +1,266 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+func (s *Service) handleBlock(ctx context.Context, msg proto.Message) error {
+	block := msg.(*pb.BeaconBlock)
+	if err := s.checkProposal(ctx, block); err != nil {
+		log.Errorf("Could not check block for slashable proposals: %v", err)
+		return err
+	}
+	if err := s.pruneHistory(ctx); err != nil {
+		log.Errorf("Could not prune slashing history: %v", err)
+		return err
+	}
+	return nil
+}
+
+// pruneHistory deletes the vote history from before the finalized epoch once
+// the chain finalizes a new epoch.
+func (s *Service) pruneHistory(ctx context.Context) error {
+	beaconState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if beaconState.FinalizedEpoch <= s.prunedEpoch {
+		return nil
+	}
+	if err := s.beaconDB.PruneSlashingHistory(ctx, beaconState.FinalizedEpoch); err != nil {
+		return err
+	}
+	s.prunedEpoch = beaconState.FinalizedEpoch
+	return nil
+}
+
+func (s *Service) handleAttestation(ctx context.Context, msg proto.Message) error {
+	attestation := msg.(*pb.Attestation)
+	if err := s.checkAttestation(ctx, attestation); err != nil {
+		log.Errorf("Could not check attestation for slashable votes: %v", err)
+		return err
+	}
+	return nil
+}
+
+// checkProposal records the block in the proposal history of its proposer and
+// submits a proposer slashing if a different block was already seen for the
+// same proposer and slot.
+func (s *Service) checkProposal(ctx context.Context, block *pb.BeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.slasher.checkProposal")
+	defer span.End()
+
+	proposerIdx, err := s.proposerIndex(ctx, block)
+	if err != nil {
+		return fmt.Errorf("could not get proposer index: %v", err)
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not hash block: %v", err)
+	}
+
+	prevRoot, prevSignature, err := s.beaconDB.ProposalHistory(proposerIdx, block.Slot)
+	if err != nil {
+		return fmt.Errorf("could not retrieve proposal history: %v", err)
+	}
+	if prevRoot == nil {
+		return s.beaconDB.SaveProposalHistory(ctx, proposerIdx, block.Slot, blockRoot, block.Signature)
+	}
+	if bytes.Equal(prevRoot, blockRoot[:]) {
+		return nil
+	}
+
+	slashing := &pb.ProposerSlashing{
+		ProposerIndex: proposerIdx,
+		ProposalData_1: &pb.ProposalSignedData{
+			Slot:            block.Slot,
+			Shard:           params.BeaconConfig().BeaconChainShardNumber,
+			BlockRootHash32: prevRoot,
+		},
+		ProposalSignature_1: prevSignature,
+		ProposalData_2: &pb.ProposalSignedData{
+			Slot:            block.Slot,
+			Shard:           params.BeaconConfig().BeaconChainShardNumber,
+			BlockRootHash32: blockRoot[:],
+		},
+		ProposalSignature_2: block.Signature,
+	}
+	log.WithFields(logrus.Fields{
+		"proposerIndex": proposerIdx,
+		"slot":          block.Slot - params.BeaconConfig().GenesisSlot,
+	}).Warn("Detected double proposal")
+	proposerSlashingsDetected.Inc()
+	s.opsPoolService.IncomingProposerSlashingFeed().Send(slashing)
+	return nil
+}
+
+// proposerIndex returns the proposer of the block, computed from the latest saved
+// state at or before the block's slot. The state is advanced through the skipped
+// slots up to the block's epoch, as the proposer can only be computed from a state
+// in the same or the next epoch.
+func (s *Service) proposerIndex(ctx context.Context, block *pb.BeaconBlock) (uint64, error) {
+	beaconState, err := s.beaconDB.HistoricalStateFromSlot(ctx, block.Slot)
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve state at slot %d: %v",
+			block.Slot-params.BeaconConfig().GenesisSlot, err)
+	}
+	epochStart := helpers.StartSlot(helpers.SlotToEpoch(block.Slot))
+	for beaconState.Slot < epochStart {
+		beaconState, err = state.ExecuteStateTransition(
+			ctx,
+			beaconState,
+			nil,
+			bytesutil.ToBytes32(block.ParentRootHash32),
+			&state.TransitionConfig{
+				VerifySignatures: false,
+				Logging:          false,
+			},
+		)
+		if err != nil {
+			return 0, fmt.Errorf("could not process skipped slot: %v", err)
+		}
+	}
+	return helpers.BeaconProposerIndex(beaconState, block.Slot)
+}
+
+// checkAttestation compares the attestation against the vote history of every
+// participating validator and submits an attester slashing for each recorded
+// attestation it double votes or surround votes with. The attestation is then
+// added to the vote history.
+func (s *Service) checkAttestation(ctx context.Context, attestation *pb.Attestation) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.slasher.checkAttestation")
+	defer span.End()
+
+	beaconState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	slashableAtt, err := slashableAttestation(beaconState, attestation)
+	if err != nil {
+		return fmt.Errorf("could not convert to slashable attestation: %v", err)
+	}
+
+	// The same conflicting attestation may be recorded for several of the
+	// participants, but a single slashing covers all of them.
+	seen := make(map[[32]byte]bool)
+	var slashings []*pb.AttesterSlashing
+	for _, idx := range slashableAtt.ValidatorIndices {
+		history, err := s.beaconDB.AttestationHistory(idx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve attestation history of validator %d: %v", idx, err)
+		}
+		for _, prevAtt := range history {
+			hash, err := hashutil.HashProto(prevAtt)
+			if err != nil {
+				return err
+			}
+			if seen[hash] {
+				continue
+			}
+			seen[hash] = true
+			if slashing := attesterSlashing(prevAtt, slashableAtt); slashing != nil {
+				slashings = append(slashings, slashing)
+			}
+		}
+	}
+
+	for _, slashing := range slashings {
+		log.WithFields(logrus.Fields{
+			"validatorIndices": intersection(
+				slashing.SlashableAttestation_1.ValidatorIndices,
+				slashing.SlashableAttestation_2.ValidatorIndices,
+			),
+			"targetEpoch": helpers.SlotToEpoch(attestation.Data.Slot) - params.BeaconConfig().GenesisEpoch,
+		}).Warn("Detected slashable attestation")
+		attesterSlashingsDetected.Inc()
+		s.opsPoolService.IncomingAttesterSlashingFeed().Send(slashing)
+	}
+	return s.beaconDB.SaveAttestationHistory(ctx, slashableAtt)
+}
+
+// slashableAttestation converts an attestation into a slashable attestation
+// holding the sorted indices of its participants.
+func slashableAttestation(beaconState *pb.BeaconState, attestation *pb.Attestation) (*pb.SlashableAttestation, error) {
+	participants, err := helpers.AttestationParticipants(beaconState, attestation.Data, attestation.AggregationBitfield)
+	if err != nil {
+		return nil, fmt.Errorf("could not get attestation participants: %v", err)
+	}
+	if len(participants) == 0 {
+		return nil, fmt.Errorf("attestation at slot %d has no participants",
+			attestation.Data.Slot-params.BeaconConfig().GenesisSlot)
+	}
+	indices := append([]uint64{}, participants...)
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return &pb.SlashableAttestation{
+		ValidatorIndices: indices,
+		// Custody bits are not used in phase 0, so they are all 0s.
+		CustodyBitfield:    make([]byte, mathutil.CeilDiv8(len(indices))),
+		Data:               attestation.Data,
+		AggregateSignature: attestation.AggregateSignature,
+	}, nil
+}
+
+// attesterSlashing returns an attester slashing if the two slashable attestations
+// are a double vote or a surround vote, and nil otherwise.
+func attesterSlashing(att1 *pb.SlashableAttestation, att2 *pb.SlashableAttestation) *pb.AttesterSlashing {
+	if proto.Equal(att1.Data, att2.Data) {
+		return nil
+	}
+	if isDoubleVote(att1.Data, att2.Data) || isSurroundVote(att1.Data, att2.Data) {
+		return &pb.AttesterSlashing{SlashableAttestation_1: att1, SlashableAttestation_2: att2}
+	}
+	if isSurroundVote(att2.Data, att1.Data) {
+		return &pb.AttesterSlashing{SlashableAttestation_1: att2, SlashableAttestation_2: att1}
+	}
+	return nil
+}
+
+// isDoubleVote checks if two different attestations share the same target epoch.
+func isDoubleVote(data1 *pb.AttestationData, data2 *pb.AttestationData) bool {
+	return helpers.SlotToEpoch(data1.Slot) == helpers.SlotToEpoch(data2.Slot)
+}
+
+// isSurroundVote checks if the source and target epochs of attestation 1
+// surround those of attestation 2.
+func isSurroundVote(data1 *pb.AttestationData, data2 *pb.AttestationData) bool {
+	sourceEpoch1 := data1.JustifiedEpoch
+	sourceEpoch2 := data2.JustifiedEpoch
+	targetEpoch1 := helpers.SlotToEpoch(data1.Slot)
+	targetEpoch2 := helpers.SlotToEpoch(data2.Slot)
+	return sourceEpoch1 < sourceEpoch2 && targetEpoch2 < targetEpoch1
+}
+
+// intersection returns the indices present in both sorted lists.
+func intersection(indices1 []uint64, indices2 []uint64) []uint64 {
+	var common []uint64
+	i, j := 0, 0
+	for i < len(indices1) && j < len(indices2) {
+		switch {
+		case indices1[i] < indices2[j]:
+			i++
+		case indices1[i] > indices2[j]:
+			j++
+		default:
+			common = append(common, indices1[i])
+			i++
+			j++
+		}
+	}
+	return common
+}
@@ -0,0 +1,318 @@
+package slasher
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// setupHeadState saves a genesis state advanced to the given slot as the head of the chain.
+func setupHeadState(t *testing.T, beaconDB *db.BeaconDB, slot uint64) *pb.BeaconState {
+	deposits := make([]*pb.Deposit, params.BeaconConfig().SlotsPerEpoch)
+	for i := 0; i < len(deposits); i++ {
+		depositInput := &pb.DepositInput{
+			Pubkey: []byte(strconv.Itoa(i)),
+		}
+		depositData, err := helpers.EncodeDepositData(
+			depositInput, params.BeaconConfig().MaxDepositAmount, time.Now().Unix(),
+		)
+		if err != nil {
+			t.Fatalf("Cannot encode data: %v", err)
+		}
+		deposits[i] = &pb.Deposit{DepositData: depositData}
+	}
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(time.Now().Unix()), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not create genesis state: %v", err)
+	}
+	beaconState.Slot = slot
+	head := &pb.BeaconBlock{Slot: slot}
+	if err := beaconDB.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.UpdateChainHead(context.Background(), head, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	return beaconState
+}
+
+// attestationFor returns an attestation in the given epoch with the validator as its single participant.
+func attestationFor(t *testing.T, beaconState *pb.BeaconState, validatorIdx uint64, epoch uint64) *pb.Attestation {
+	startSlot := helpers.StartSlot(epoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false /* registryChange */)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, committee := range committees {
+			for i, idx := range committee.Committee {
+				if idx != validatorIdx {
+					continue
+				}
+				bitfield := make([]byte, (len(committee.Committee)+7)/8)
+				bitfield[i/8] |= 1 << (7 - uint(i%8))
+				return &pb.Attestation{
+					AggregationBitfield: bitfield,
+					Data: &pb.AttestationData{
+						Slot:  slot,
+						Shard: committee.Shard,
+					},
+				}
+			}
+		}
+	}
+	t.Fatalf("Validator %d is not assigned to a committee in epoch %d", validatorIdx, epoch)
+	return nil
+}
+
+func TestCheckProposal_DetectsDoubleProposal(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	beaconState := setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+5)
+	opsPool := newMockOpsPool()
+	s := NewSlasherService(ctx, &Config{BeaconDB: beaconDB, OpsPoolService: opsPool})
+
+	// The conflicting blocks are not saved, as a block rejected by the chain
+	// service can still be part of a double proposal.
+	block1 := &pb.BeaconBlock{Slot: beaconState.Slot, ParentRootHash32: []byte{'A'}, Signature: []byte{'1'}}
+	block2 := &pb.BeaconBlock{Slot: beaconState.Slot, ParentRootHash32: []byte{'B'}, Signature: []byte{'2'}}
+	for _, block := range []*pb.BeaconBlock{block1, block1, block2} {
+		if err := s.checkProposal(ctx, block); err != nil {
+			t.Fatalf("Could not check proposal: %v", err)
+		}
+	}
+
+	if len(opsPool.proposerSlashings) != 1 {
+		t.Fatalf("Expected 1 proposer slashing, received %d", len(opsPool.proposerSlashings))
+	}
+	slashing := <-opsPool.proposerSlashings
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slashing.ProposerIndex != proposerIdx {
+		t.Errorf("Expected proposer index %d, received %d", proposerIdx, slashing.ProposerIndex)
+	}
+	root1, err := hashutil.HashBeaconBlock(block1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root2, err := hashutil.HashBeaconBlock(block2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(slashing.ProposalData_1.BlockRootHash32, root1[:]) ||
+		!reflect.DeepEqual(slashing.ProposalData_2.BlockRootHash32, root2[:]) {
+		t.Errorf("Unexpected block roots in proposer slashing: %v", slashing)
+	}
+	if !reflect.DeepEqual(slashing.ProposalSignature_1, block1.Signature) ||
+		!reflect.DeepEqual(slashing.ProposalSignature_2, block2.Signature) {
+		t.Errorf("Unexpected signatures in proposer slashing: %v", slashing)
+	}
+	if slashing.ProposalData_1.Slot != slashing.ProposalData_2.Slot {
+		t.Errorf("Expected proposal slots to match: %v", slashing)
+	}
+	testutil.AssertLogsContain(t, hook, "Detected double proposal")
+}
+
+func TestCheckProposal_ProposerFromBlockEpoch(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	beaconState := setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+5)
+	s := NewSlasherService(ctx, &Config{BeaconDB: beaconDB, OpsPoolService: newMockOpsPool()})
+
+	// The block is two epochs past the head state, out of range of its committees.
+	block := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 2*params.BeaconConfig().SlotsPerEpoch + 3}
+	if err := s.checkProposal(ctx, block); err != nil {
+		t.Fatalf("Could not check proposal: %v", err)
+	}
+
+	for beaconState.Slot < helpers.StartSlot(helpers.SlotToEpoch(block.Slot)) {
+		var err error
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, nil, [32]byte{}, &state.TransitionConfig{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, block.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, _, err := beaconDB.ProposalHistory(proposerIdx, block.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root == nil {
+		t.Errorf("Expected the proposal to be recorded for proposer %d", proposerIdx)
+	}
+}
+
+func TestCheckAttestation_DetectsDoubleVote(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	beaconState := setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+params.BeaconConfig().SlotsPerEpoch)
+	opsPool := newMockOpsPool()
+	s := NewSlasherService(ctx, &Config{BeaconDB: beaconDB, OpsPoolService: opsPool})
+
+	att1 := attestationFor(t, beaconState, 3, params.BeaconConfig().GenesisEpoch+1)
+	att1.Data.BeaconBlockRootHash32 = []byte{'A'}
+	att2 := proto.Clone(att1).(*pb.Attestation)
+	att2.Data.BeaconBlockRootHash32 = []byte{'B'}
+
+	for _, att := range []*pb.Attestation{att1, att1, att2} {
+		if err := s.checkAttestation(ctx, att); err != nil {
+			t.Fatalf("Could not check attestation: %v", err)
+		}
+	}
+
+	if len(opsPool.attesterSlashings) != 1 {
+		t.Fatalf("Expected 1 attester slashing, received %d", len(opsPool.attesterSlashings))
+	}
+	slashing := <-opsPool.attesterSlashings
+	if !proto.Equal(slashing.SlashableAttestation_1.Data, att1.Data) ||
+		!proto.Equal(slashing.SlashableAttestation_2.Data, att2.Data) {
+		t.Errorf("Unexpected attestation data in attester slashing: %v", slashing)
+	}
+	if !reflect.DeepEqual(slashing.SlashableAttestation_1.ValidatorIndices, []uint64{3}) {
+		t.Errorf("Expected validator indices [3], received %v", slashing.SlashableAttestation_1.ValidatorIndices)
+	}
+	if !reflect.DeepEqual(slashing.SlashableAttestation_1.CustodyBitfield, []byte{0}) {
+		t.Errorf("Expected empty custody bitfield, received %v", slashing.SlashableAttestation_1.CustodyBitfield)
+	}
+}
+
+func TestCheckAttestation_DetectsSurroundVote(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	beaconState := setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+2*params.BeaconConfig().SlotsPerEpoch)
+	opsPool := newMockOpsPool()
+	s := NewSlasherService(ctx, &Config{BeaconDB: beaconDB, OpsPoolService: opsPool})
+
+	// The inner vote has source epoch 1 and target epoch 1, while the outer vote has
+	// source epoch 0 and target epoch 2, surrounding the inner vote.
+	inner := attestationFor(t, beaconState, 7, params.BeaconConfig().GenesisEpoch+1)
+	inner.Data.JustifiedEpoch = params.BeaconConfig().GenesisEpoch + 1
+	outer := attestationFor(t, beaconState, 7, params.BeaconConfig().GenesisEpoch+2)
+	outer.Data.JustifiedEpoch = params.BeaconConfig().GenesisEpoch
+
+	for _, att := range []*pb.Attestation{inner, outer} {
+		if err := s.checkAttestation(ctx, att); err != nil {
+			t.Fatalf("Could not check attestation: %v", err)
+		}
+	}
+
+	if len(opsPool.attesterSlashings) != 1 {
+		t.Fatalf("Expected 1 attester slashing, received %d", len(opsPool.attesterSlashings))
+	}
+	slashing := <-opsPool.attesterSlashings
+	// The surrounding vote must come first for the slashing to verify.
+	if !proto.Equal(slashing.SlashableAttestation_1.Data, outer.Data) ||
+		!proto.Equal(slashing.SlashableAttestation_2.Data, inner.Data) {
+		t.Errorf("Unexpected attestation data order in attester slashing: %v", slashing)
+	}
+}
+
+func TestCheckAttestation_IgnoresDifferentValidators(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	beaconState := setupHeadState(t, beaconDB, params.BeaconConfig().GenesisSlot+params.BeaconConfig().SlotsPerEpoch)
+	opsPool := newMockOpsPool()
+	s := NewSlasherService(ctx, &Config{BeaconDB: beaconDB, OpsPoolService: opsPool})
+
+	att1 := attestationFor(t, beaconState, 1, params.BeaconConfig().GenesisEpoch+1)
+	att1.Data.BeaconBlockRootHash32 = []byte{'A'}
+	att2 := attestationFor(t, beaconState, 2, params.BeaconConfig().GenesisEpoch+1)
+	att2.Data.BeaconBlockRootHash32 = []byte{'B'}
+
+	for _, att := range []*pb.Attestation{att1, att2} {
+		if err := s.checkAttestation(ctx, att); err != nil {
+			t.Fatalf("Could not check attestation: %v", err)
+		}
+	}
+	if len(opsPool.attesterSlashings) != 0 {
+		t.Errorf("Expected no attester slashings, received %d", len(opsPool.attesterSlashings))
+	}
+}
+
+func TestAttesterSlashing_Conditions(t *testing.T) {
+	epochSlot := func(epoch uint64) uint64 {
+		return helpers.StartSlot(params.BeaconConfig().GenesisEpoch + epoch)
+	}
+	tests := []struct {
+		name       string
+		data1      *pb.AttestationData
+		data2      *pb.AttestationData
+		slashable  bool
+		swapsOrder bool
+	}{
+		{
+			name:      "same data",
+			data1:     &pb.AttestationData{Slot: epochSlot(2), JustifiedEpoch: 1},
+			data2:     &pb.AttestationData{Slot: epochSlot(2), JustifiedEpoch: 1},
+			slashable: false,
+		},
+		{
+			name:      "double vote",
+			data1:     &pb.AttestationData{Slot: epochSlot(2), JustifiedEpoch: 1},
+			data2:     &pb.AttestationData{Slot: epochSlot(2) + 1, JustifiedEpoch: 1},
+			slashable: true,
+		},
+		{
+			name:      "first surrounds second",
+			data1:     &pb.AttestationData{Slot: epochSlot(4), JustifiedEpoch: 1},
+			data2:     &pb.AttestationData{Slot: epochSlot(3), JustifiedEpoch: 2},
+			slashable: true,
+		},
+		{
+			name:       "second surrounds first",
+			data1:      &pb.AttestationData{Slot: epochSlot(3), JustifiedEpoch: 2},
+			data2:      &pb.AttestationData{Slot: epochSlot(4), JustifiedEpoch: 1},
+			slashable:  true,
+			swapsOrder: true,
+		},
+		{
+			name:      "consecutive votes",
+			data1:     &pb.AttestationData{Slot: epochSlot(3), JustifiedEpoch: 2},
+			data2:     &pb.AttestationData{Slot: epochSlot(4), JustifiedEpoch: 3},
+			slashable: false,
+		},
+	}
+	for _, tt := range tests {
+		att1 := &pb.SlashableAttestation{Data: tt.data1}
+		att2 := &pb.SlashableAttestation{Data: tt.data2}
+		slashing := attesterSlashing(att1, att2)
+		if (slashing != nil) != tt.slashable {
+			t.Errorf("%s: expected slashable %t, received %v", tt.name, tt.slashable, slashing)
+			continue
+		}
+		if slashing == nil {
+			continue
+		}
+		first, second := att1, att2
+		if tt.swapsOrder {
+			first, second = att2, att1
+		}
+		if slashing.SlashableAttestation_1 != first || slashing.SlashableAttestation_2 != second {
+			t.Errorf("%s: unexpected slashable attestation order", tt.name)
+		}
+	}
+}
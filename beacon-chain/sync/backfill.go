@@ -0,0 +1,308 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var backfillLog = logrus.WithField("prefix", "backfill")
+
+// errBackfillExhausted is returned once every known peer was asked for the batch of blocks
+// below the lowest backfilled block without serving its parent.
+var errBackfillExhausted = errors.New("no peer served the parent of the lowest backfilled block")
+
+// BackfillConfig defines the configurable properties of Backfill.
+type BackfillConfig struct {
+	ResponseBufferSize int
+	RetryInterval      time.Duration
+	P2P                p2pAPI
+	BeaconDB           *db.BeaconDB
+	PeerStatuses       *p2p.PeerStatuses
+}
+
+// DefaultBackfillConfig provides the default configuration for a backfill service.
+// RetryInterval determines how long to wait for a batch of blocks before requesting it again.
+func DefaultBackfillConfig() *BackfillConfig {
+	return &BackfillConfig{
+		ResponseBufferSize: params.BeaconConfig().DefaultBufferSize,
+		RetryInterval:      10 * time.Second,
+	}
+}
+
+// Backfill downloads the blocks below the checkpoint a node was started from,
+// walking backwards from the checkpoint block towards genesis. Blocks are only
+// verified to be the parent of the block above them and saved without running the
+// state transition, so that the node can serve them to its peers.
+type Backfill struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	p2p           p2pAPI
+	db            *db.BeaconDB
+	responseBuf   chan p2p.Message
+	retryInterval time.Duration
+	peerStatuses  *p2p.PeerStatuses
+	peer          peer.ID
+	// triedPeers are the peers which answered the request for the current batch
+	// without the parent of the lowest block.
+	triedPeers map[peer.ID]bool
+	// emptyBatch is true as long as every answer for the current batch was empty.
+	emptyBatch bool
+	// lowestBlock is the lowest block of the contiguous chain stored in the db.
+	lowestBlock *pb.BeaconBlock
+	// endSlot is the highest slot of the next batch of blocks to request.
+	endSlot uint64
+}
+
+// NewBackfillService constructs a new Backfill service.
+func NewBackfillService(ctx context.Context, cfg *BackfillConfig) *Backfill {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Backfill{
+		ctx:           ctx,
+		cancel:        cancel,
+		p2p:           cfg.P2P,
+		db:            cfg.BeaconDB,
+		responseBuf:   make(chan p2p.Message, cfg.ResponseBufferSize),
+		retryInterval: cfg.RetryInterval,
+		peerStatuses:  cfg.PeerStatuses,
+		triedPeers:    make(map[peer.ID]bool),
+		emptyBatch:    true,
+	}
+}
+
+// InitializePeer sets the peer blocks are first requested from. Other peers of the
+// peer status table are asked when it cannot serve a batch.
+func (b *Backfill) InitializePeer(p peer.ID) {
+	b.peer = p
+}
+
+// Start begins the goroutine.
+func (b *Backfill) Start() {
+	block, err := b.db.BackfillBlock()
+	if err != nil {
+		backfillLog.Errorf("Could not retrieve backfill block: %v", err)
+		return
+	}
+	if block == nil {
+		return
+	}
+	b.lowestBlock = block
+	backfillLowestSlot.Set(float64(block.Slot - params.BeaconConfig().GenesisSlot))
+	if b.isComplete() {
+		return
+	}
+	b.endSlot = block.Slot - 1
+	go b.run()
+}
+
+// Stop kills the backfill goroutine.
+func (b *Backfill) Stop() error {
+	backfillLog.Info("Stopping service")
+	b.cancel()
+	return nil
+}
+
+func (b *Backfill) run() {
+	responseSub := b.p2p.Subscribe(&pb.BatchedBeaconBlockResponse{}, b.responseBuf)
+	ticker := time.NewTicker(b.retryInterval)
+	defer func() {
+		responseSub.Unsubscribe()
+		ticker.Stop()
+	}()
+
+	backfillLog.WithField("slot", b.lowestBlock.Slot-params.BeaconConfig().GenesisSlot).Info(
+		"Backfilling blocks below checkpoint")
+	b.requestBatchedBlocks()
+	for {
+		select {
+		case <-b.ctx.Done():
+			backfillLog.Debug("Backfill context closed, exiting goroutine")
+			return
+		case <-ticker.C:
+			// The peer did not answer in time, ask another one.
+			if p, ok := b.nextPeer(); ok {
+				b.peer = p
+			}
+			b.requestBatchedBlocks()
+		case msg := <-b.responseBuf:
+			if err := b.processBatchedBlocks(msg); err != nil {
+				if err == errBackfillExhausted {
+					backfillLog.WithField("slot", b.lowestBlock.Slot-params.BeaconConfig().GenesisSlot).Warn(
+						"Stopping backfill, no peer could serve the blocks below the lowest backfilled block")
+					return
+				}
+				backfillLog.Errorf("Could not process batched blocks: %v", err)
+				continue
+			}
+			if b.isComplete() {
+				backfillLog.Info("Finished backfilling blocks")
+				return
+			}
+			b.requestBatchedBlocks()
+		}
+	}
+}
+
+// isComplete returns true once the backfilled chain reaches genesis or a block
+// which was already in the db.
+func (b *Backfill) isComplete() bool {
+	return b.lowestBlock.Slot <= params.BeaconConfig().GenesisSlot ||
+		b.db.HasBlock(bytesutil.ToBytes32(b.lowestBlock.ParentRootHash32))
+}
+
+// requestBatchedBlocks sends out a request for the batch of blocks below the
+// lowest block requested so far.
+func (b *Backfill) requestBatchedBlocks() {
+	ctx, span := trace.StartSpan(b.ctx, "beacon-chain.sync.Backfill.requestBatchedBlocks")
+	defer span.End()
+
+	startSlot := params.BeaconConfig().GenesisSlot
+	if b.endSlot >= startSlot+params.BeaconConfig().BatchBlockLimit {
+		startSlot = b.endSlot - params.BeaconConfig().BatchBlockLimit
+	}
+	backfillLog.WithFields(logrus.Fields{
+		"startSlot": startSlot - params.BeaconConfig().GenesisSlot,
+		"endSlot":   b.endSlot - params.BeaconConfig().GenesisSlot},
+	).Debug("Requesting batched blocks")
+	backfillSentBatchedBlockReq.Inc()
+	if err := b.p2p.Send(ctx, &pb.BatchedBeaconBlockRequest{
+		StartSlot: startSlot,
+		EndSlot:   b.endSlot,
+	}, b.peer); err != nil {
+		backfillLog.Errorf("Could not send batch block request to peer %s: %v", b.peer.Pretty(), err)
+	}
+}
+
+// nextPeer returns the peer following the current one, among the peers of the peer
+// status table, which was not asked for the current batch yet. The current peer is
+// only returned if no other peer is left.
+func (b *Backfill) nextPeer() (peer.ID, bool) {
+	candidates := []peer.ID{b.peer}
+	if b.peerStatuses != nil {
+		for pid := range b.peerStatuses.All() {
+			if pid != b.peer {
+				candidates = append(candidates, pid)
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i] < candidates[j]
+	})
+	current := sort.Search(len(candidates), func(i int) bool {
+		return candidates[i] >= b.peer
+	})
+	for i := 1; i <= len(candidates); i++ {
+		pid := candidates[(current+i)%len(candidates)]
+		if !b.triedPeers[pid] {
+			return pid, true
+		}
+	}
+	return "", false
+}
+
+// batchNotServed records that the peer answered without the parent of the lowest block
+// and moves on to the next peer. Once every peer answered, the batch is skipped if all
+// of them found its slots empty, otherwise errBackfillExhausted is returned.
+func (b *Backfill) batchNotServed(pid peer.ID, empty bool) error {
+	b.triedPeers[pid] = true
+	b.emptyBatch = b.emptyBatch && empty
+	if p, ok := b.nextPeer(); ok {
+		backfillLog.WithFields(logrus.Fields{
+			"peerID":     pid.Pretty(),
+			"nextPeerID": p.Pretty(),
+		}).Debug("Peer could not serve the batch, requesting it from another peer")
+		b.peer = p
+		return nil
+	}
+	if !b.emptyBatch || b.endSlot <= params.BeaconConfig().GenesisSlot {
+		return errBackfillExhausted
+	}
+	// Every slot of the requested range was skipped, move on to the next range.
+	if b.endSlot > params.BeaconConfig().GenesisSlot+params.BeaconConfig().BatchBlockLimit {
+		b.endSlot -= params.BeaconConfig().BatchBlockLimit + 1
+	} else {
+		b.endSlot = params.BeaconConfig().GenesisSlot
+	}
+	b.resetPeers()
+	return nil
+}
+
+// resetPeers starts a new batch, which every peer can be asked for again.
+func (b *Backfill) resetPeers() {
+	b.triedPeers = make(map[peer.ID]bool)
+	b.emptyBatch = true
+}
+
+// processBatchedBlocks saves the blocks of the response which extend the backfilled
+// chain downwards, each block root being checked against the parent root of the
+// block above it. A batch which does not hold the parent of the lowest block is
+// requested from another peer.
+func (b *Backfill) processBatchedBlocks(msg p2p.Message) error {
+	_, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.Backfill.processBatchedBlocks")
+	defer span.End()
+
+	if msg.Peer != b.peer {
+		backfillLog.WithField("peerID", msg.Peer.Pretty()).Debug("Received batch blocks from a different peer")
+		return nil
+	}
+	response := msg.Data.(*pb.BatchedBeaconBlockResponse)
+	blocks := make([]*pb.BeaconBlock, 0, len(response.BatchedBlocks))
+	for _, block := range response.BatchedBlocks {
+		if block.Slot < b.lowestBlock.Slot {
+			blocks = append(blocks, block)
+		}
+	}
+	if len(blocks) == 0 {
+		return b.batchNotServed(msg.Peer, true /* empty */)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Slot > blocks[j].Slot
+	})
+
+	lowestBlock := b.lowestBlock
+	for _, block := range blocks {
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(root[:], lowestBlock.ParentRootHash32) {
+			backfillLog.WithField("slot", block.Slot-params.BeaconConfig().GenesisSlot).Debug(
+				"Received block which is not the parent of the lowest backfilled block")
+			continue
+		}
+		if err := b.db.SaveBlock(block); err != nil {
+			return err
+		}
+		lowestBlock = block
+		backfilledBlocks.Inc()
+	}
+	if lowestBlock == b.lowestBlock {
+		backfillLog.WithField("peerID", msg.Peer.Pretty()).Debug(
+			"Received batch without the parent of the lowest backfilled block")
+		return b.batchNotServed(msg.Peer, false /* empty */)
+	}
+	if err := b.db.SaveBackfillBlock(lowestBlock); err != nil {
+		return err
+	}
+	b.lowestBlock = lowestBlock
+	b.resetPeers()
+	if lowestBlock.Slot > params.BeaconConfig().GenesisSlot {
+		b.endSlot = lowestBlock.Slot - 1
+	}
+	backfillLowestSlot.Set(float64(lowestBlock.Slot - params.BeaconConfig().GenesisSlot))
+	backfillLog.WithField("slot", lowestBlock.Slot-params.BeaconConfig().GenesisSlot).Debug(
+		"Backfilled blocks")
+	return nil
+}
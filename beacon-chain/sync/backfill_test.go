@@ -0,0 +1,203 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// setupBackfillChain saves a checkpoint block as the backfill block and returns it
+// along with the chain of blocks below it, lowest first.
+func setupBackfillChain(t *testing.T, beaconDB *db.BeaconDB) (*pb.BeaconBlock, []*pb.BeaconBlock) {
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	var chain []*pb.BeaconBlock
+	parentRoot := []byte{}
+	for _, slot := range []uint64{genesisSlot, genesisSlot + 1, genesisSlot + 3} {
+		block := &pb.BeaconBlock{Slot: slot, ParentRootHash32: parentRoot}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain = append(chain, block)
+		parentRoot = root[:]
+	}
+	checkpoint := &pb.BeaconBlock{Slot: genesisSlot + 4, ParentRootHash32: parentRoot}
+	if err := beaconDB.SaveBlock(checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveBackfillBlock(checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	return checkpoint, chain
+}
+
+func setupBackfill(beaconDB *db.BeaconDB, checkpoint *pb.BeaconBlock) (*Backfill, *mockP2P) {
+	mp := &mockP2P{}
+	cfg := DefaultBackfillConfig()
+	cfg.BeaconDB = beaconDB
+	cfg.P2P = mp
+	b := NewBackfillService(context.Background(), cfg)
+	b.InitializePeer("A")
+	b.lowestBlock = checkpoint
+	b.endSlot = checkpoint.Slot - 1
+	return b, mp
+}
+
+func TestBackfill_RequestsBatchBelowLowestBlock(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	b, mp := setupBackfill(beaconDB, &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 1000})
+
+	b.requestBatchedBlocks()
+	req, ok := mp.sentMsg.(*pb.BatchedBeaconBlockRequest)
+	if !ok {
+		t.Fatalf("Expected batched block request, received %v", mp.sentMsg)
+	}
+	wantEnd := params.BeaconConfig().GenesisSlot + 999
+	wantStart := wantEnd - params.BeaconConfig().BatchBlockLimit
+	if req.StartSlot != wantStart || req.EndSlot != wantEnd {
+		t.Errorf("Expected request from slot %d to %d, received %d to %d", wantStart, wantEnd, req.StartSlot, req.EndSlot)
+	}
+}
+
+func TestBackfill_ProcessBatchedBlocks_SavesParentChain(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	checkpoint, chain := setupBackfillChain(t, beaconDB)
+	b, _ := setupBackfill(beaconDB, checkpoint)
+
+	fork := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 2, ParentRootHash32: []byte{'A'}}
+	msg := p2p.Message{
+		Ctx:  context.Background(),
+		Peer: "A",
+		Data: &pb.BatchedBeaconBlockResponse{
+			BatchedBlocks: []*pb.BeaconBlock{chain[0], chain[1], fork, chain[2], checkpoint},
+		},
+	}
+	if err := b.processBatchedBlocks(msg); err != nil {
+		t.Fatalf("Could not process batched blocks: %v", err)
+	}
+
+	for _, block := range chain {
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !beaconDB.HasBlock(root) {
+			t.Errorf("Expected block at slot %d to be saved", block.Slot)
+		}
+	}
+	forkRoot, err := hashutil.HashBeaconBlock(fork)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconDB.HasBlock(forkRoot) {
+		t.Error("Expected block which is not in the parent chain not to be saved")
+	}
+	lowest, err := beaconDB.BackfillBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(lowest, chain[0]) {
+		t.Errorf("Expected backfill block %v, received %v", chain[0], lowest)
+	}
+	if !b.isComplete() {
+		t.Error("Expected backfill to be complete once genesis is reached")
+	}
+}
+
+func TestBackfill_ProcessBatchedBlocks_IgnoresMismatchedBlocks(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	checkpoint, chain := setupBackfillChain(t, beaconDB)
+	b, _ := setupBackfill(beaconDB, checkpoint)
+
+	// The block at slot 1 is not the parent of the checkpoint block, and no other
+	// peer can serve the batch.
+	msg := p2p.Message{
+		Ctx:  context.Background(),
+		Peer: "A",
+		Data: &pb.BatchedBeaconBlockResponse{
+			BatchedBlocks: []*pb.BeaconBlock{chain[0], chain[1]},
+		},
+	}
+	if err := b.processBatchedBlocks(msg); err != errBackfillExhausted {
+		t.Errorf("Expected %v, received %v", errBackfillExhausted, err)
+	}
+	root, err := hashutil.HashBeaconBlock(chain[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconDB.HasBlock(root) {
+		t.Error("Expected block which is not the parent of the lowest block not to be saved")
+	}
+	if b.lowestBlock != checkpoint {
+		t.Errorf("Expected lowest block to remain %v, received %v", checkpoint, b.lowestBlock)
+	}
+	if b.endSlot != checkpoint.Slot-1 {
+		t.Errorf("Expected end slot to remain %d, received %d", checkpoint.Slot-1, b.endSlot)
+	}
+}
+
+func TestBackfill_ProcessBatchedBlocks_EmptyBatchAsksAnotherPeer(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	checkpoint := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 1000}
+	b, _ := setupBackfill(beaconDB, checkpoint)
+	b.peerStatuses = p2p.NewPeerStatuses()
+	b.peerStatuses.Set("A", &pb.Handshake{})
+	b.peerStatuses.Set("B", &pb.Handshake{})
+
+	msg := p2p.Message{
+		Ctx:  context.Background(),
+		Peer: "A",
+		Data: &pb.BatchedBeaconBlockResponse{},
+	}
+	if err := b.processBatchedBlocks(msg); err != nil {
+		t.Fatalf("Could not process batched blocks: %v", err)
+	}
+	// A peer lacking the blocks must not make the range be skipped.
+	if b.endSlot != checkpoint.Slot-1 {
+		t.Errorf("Expected end slot to remain %d, received %d", checkpoint.Slot-1, b.endSlot)
+	}
+	if b.peer != "B" {
+		t.Errorf("Expected batch to be requested from peer B, received %s", b.peer)
+	}
+
+	// Once every peer found the range empty, the next range is requested.
+	msg.Peer = "B"
+	if err := b.processBatchedBlocks(msg); err != nil {
+		t.Fatalf("Could not process batched blocks: %v", err)
+	}
+	want := checkpoint.Slot - 1 - params.BeaconConfig().BatchBlockLimit - 1
+	if b.endSlot != want {
+		t.Errorf("Expected next end slot %d, received %d", want, b.endSlot)
+	}
+	if len(b.triedPeers) != 0 {
+		t.Errorf("Expected every peer to be asked for the next range, received tried peers %v", b.triedPeers)
+	}
+}
+
+func TestBackfill_ProcessBatchedBlocks_StopsAtGenesis(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	checkpoint := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 1}
+	b, _ := setupBackfill(beaconDB, checkpoint)
+	b.endSlot = params.BeaconConfig().GenesisSlot
+
+	msg := p2p.Message{
+		Ctx:  context.Background(),
+		Peer: "A",
+		Data: &pb.BatchedBeaconBlockResponse{},
+	}
+	if err := b.processBatchedBlocks(msg); err != errBackfillExhausted {
+		t.Errorf("Expected %v, received %v", errBackfillExhausted, err)
+	}
+}
@@ -0,0 +1,158 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// GossipValidator makes cheap checks on the block and attestation announcements
+// gossiped by peers, so the messages which fail them are dropped instead of being
+// forwarded to the rest of the network. Blocks and attestations themselves are
+// sent to the requesting peer only, so they are not checked here. Peers which
+// send invalid messages are penalized, while messages which are only stale or
+// early are dropped without penalty.
+type GossipValidator struct {
+	db            *db.BeaconDB
+	reporter      p2p.PeerReporter
+	lock          sync.Mutex
+	headState     *pb.BeaconState
+	headStateRoot [32]byte
+	now           func() time.Time
+}
+
+// NewGossipValidator creates a gossip validator checking messages against the
+// beacon chain stored in the given db.
+func NewGossipValidator(beaconDB *db.BeaconDB) *GossipValidator {
+	return &GossipValidator{
+		db:  beaconDB,
+		now: time.Now,
+	}
+}
+
+// invalidGossipErr is the error of a gossip message which is invalid, rather
+// than stale or early, for which the peer which sent it is penalized.
+type invalidGossipErr struct {
+	err error
+}
+
+func (e *invalidGossipErr) Error() string {
+	return e.err.Error()
+}
+
+// InitializeReporter sets the p2p service which is told about the peers that
+// sent invalid messages.
+func (gv *GossipValidator) InitializeReporter(reporter p2p.PeerReporter) {
+	gv.reporter = reporter
+}
+
+// TopicValidators returns the validator of each gossip topic it checks.
+func (gv *GossipValidator) TopicValidators() map[pb.Topic]p2p.TopicValidator {
+	return map[pb.Topic]p2p.TopicValidator{
+		pb.Topic_BEACON_BLOCK_ANNOUNCE: gv.topicValidator(pb.Topic_BEACON_BLOCK_ANNOUNCE, gv.validateBlockAnnounce, p2p.ScoreInvalidBlock),
+		pb.Topic_ATTESTATION_ANNOUNCE:  gv.topicValidator(pb.Topic_ATTESTATION_ANNOUNCE, gv.validateAttestationAnnounce, p2p.ScoreInvalidAttestation),
+	}
+}
+
+func (gv *GossipValidator) topicValidator(
+	topic pb.Topic,
+	validate func(ctx context.Context, msg proto.Message) error,
+	penalty int,
+) p2p.TopicValidator {
+	return func(ctx context.Context, msg proto.Message, pid peer.ID) bool {
+		if err := validate(ctx, msg); err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"peer":  pid.Pretty(),
+				"topic": topic,
+			}).Debug("Dropping gossip message which failed validation")
+			rejectedGossip.WithLabelValues(topic.String()).Inc()
+			if _, ok := err.(*invalidGossipErr); ok && gv.reporter != nil {
+				gv.reporter.ReportPeer(pid, penalty)
+			}
+			return false
+		}
+		return true
+	}
+}
+
+// state returns the head state, which is only read from the db again once the
+// head changes.
+func (gv *GossipValidator) state(ctx context.Context) (*pb.BeaconState, error) {
+	gv.lock.Lock()
+	defer gv.lock.Unlock()
+	if gv.headState != nil && gv.headStateRoot == gv.db.HeadStateRoot() {
+		return gv.headState, nil
+	}
+	headState, err := gv.db.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return nil, errors.New("chain has not started")
+	}
+	gv.headState = headState
+	gv.headStateRoot = gv.db.HeadStateRoot()
+	return headState, nil
+}
+
+// checkSlot returns an error if the slot is not after the finalized slot or is
+// later than the next slot, which allows for clock disparity between nodes.
+func (gv *GossipValidator) checkSlot(headState *pb.BeaconState, slot uint64) error {
+	finalizedSlot := helpers.StartSlot(headState.FinalizedEpoch)
+	if slot <= finalizedSlot && slot != params.BeaconConfig().GenesisSlot {
+		return fmt.Errorf("slot %d is not after the finalized slot %d",
+			slot-params.BeaconConfig().GenesisSlot, finalizedSlot-params.BeaconConfig().GenesisSlot)
+	}
+	genesisTime := time.Unix(int64(headState.GenesisTime), 0)
+	currentSlot := params.BeaconConfig().GenesisSlot
+	if now := gv.now(); now.After(genesisTime) {
+		currentSlot += uint64(now.Sub(genesisTime).Seconds()) / params.BeaconConfig().SecondsPerSlot
+	}
+	if slot > currentSlot+1 {
+		return fmt.Errorf("slot %d is later than the current slot %d",
+			slot-params.BeaconConfig().GenesisSlot, currentSlot-params.BeaconConfig().GenesisSlot)
+	}
+	return nil
+}
+
+func (gv *GossipValidator) validateBlockAnnounce(ctx context.Context, msg proto.Message) error {
+	announce := msg.(*pb.BeaconBlockAnnounce)
+	if len(announce.Hash) != 32 {
+		return &invalidGossipErr{fmt.Errorf("block root has length %d", len(announce.Hash))}
+	}
+	root := bytesutil.ToBytes32(announce.Hash)
+	if gv.db.IsEvilBlockHash(root) {
+		return &invalidGossipErr{errors.New("block is blacklisted")}
+	}
+	if gv.db.HasBlock(root) {
+		return errors.New("block is already known")
+	}
+	headState, err := gv.state(ctx)
+	if err != nil {
+		return err
+	}
+	return gv.checkSlot(headState, announce.SlotNumber)
+}
+
+func (gv *GossipValidator) validateAttestationAnnounce(ctx context.Context, msg proto.Message) error {
+	announce := msg.(*pb.AttestationAnnounce)
+	if len(announce.Hash) != 32 {
+		return &invalidGossipErr{fmt.Errorf("attestation root has length %d", len(announce.Hash))}
+	}
+	if gv.db.HasAttestation(bytesutil.ToBytes32(announce.Hash)) {
+		return errors.New("attestation is already known")
+	}
+	return nil
+}
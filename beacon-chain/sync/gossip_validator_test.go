@@ -0,0 +1,121 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// setupGossipChain stores a genesis block as the chain head, with the clock of the
+// returned validator 10 slots after genesis, and returns the genesis block root.
+func setupGossipChain(t *testing.T, beaconDB *db.BeaconDB) (*GossipValidator, [32]byte) {
+	genesis := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	beaconState := &pb.BeaconState{
+		Slot:           params.BeaconConfig().GenesisSlot,
+		GenesisTime:    1000,
+		FinalizedEpoch: params.BeaconConfig().GenesisEpoch,
+	}
+	if err := beaconDB.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save block: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(context.Background(), genesis, beaconState); err != nil {
+		t.Fatalf("Could not update chain head: %v", err)
+	}
+	root, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gv := NewGossipValidator(beaconDB)
+	gv.now = func() time.Time {
+		return time.Unix(int64(1000+10*params.BeaconConfig().SecondsPerSlot), 0)
+	}
+	return gv, root
+}
+
+func TestGossipValidator_BlockAnnounce(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	gv, genesisRoot := setupGossipChain(t, beaconDB)
+	validate := gv.TopicValidators()[pb.Topic_BEACON_BLOCK_ANNOUNCE]
+	genesisSlot := params.BeaconConfig().GenesisSlot
+
+	tests := []struct {
+		name     string
+		announce *pb.BeaconBlockAnnounce
+		valid    bool
+	}{
+		{
+			name:     "new block",
+			announce: &pb.BeaconBlockAnnounce{Hash: make([]byte, 32), SlotNumber: genesisSlot + 5},
+			valid:    true,
+		},
+		{
+			name:     "next slot",
+			announce: &pb.BeaconBlockAnnounce{Hash: make([]byte, 32), SlotNumber: genesisSlot + 11},
+			valid:    true,
+		},
+		{
+			name:     "short hash",
+			announce: &pb.BeaconBlockAnnounce{Hash: []byte{'A'}, SlotNumber: genesisSlot + 5},
+		},
+		{
+			name:     "known block",
+			announce: &pb.BeaconBlockAnnounce{Hash: genesisRoot[:], SlotNumber: genesisSlot},
+		},
+		{
+			name:     "future slot",
+			announce: &pb.BeaconBlockAnnounce{Hash: make([]byte, 32), SlotNumber: genesisSlot + 12},
+		},
+	}
+	for _, tt := range tests {
+		if valid := validate(context.Background(), tt.announce, ""); valid != tt.valid {
+			t.Errorf("%s: expected valid %v, received %v", tt.name, tt.valid, valid)
+		}
+	}
+}
+
+func TestGossipValidator_AttestationAnnounce(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	gv, _ := setupGossipChain(t, beaconDB)
+	mp := &mockP2P{}
+	gv.InitializeReporter(mp)
+	validate := gv.TopicValidators()[pb.Topic_ATTESTATION_ANNOUNCE]
+
+	if validate(context.Background(), &pb.AttestationAnnounce{Hash: []byte{'A'}}, "") {
+		t.Error("Expected an announcement with a short hash to be invalid")
+	}
+	if len(mp.reports) != 1 || mp.reports[0] != p2p.ScoreInvalidAttestation {
+		t.Errorf("Expected the peer to be penalized for an invalid announcement, received %v", mp.reports)
+	}
+	if !validate(context.Background(), &pb.AttestationAnnounce{Hash: make([]byte, 32)}, "") {
+		t.Error("Expected an announcement of a new attestation to be valid")
+	}
+
+	attestation := &pb.Attestation{Data: &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1}}
+	if err := beaconDB.SaveAttestation(context.Background(), attestation); err != nil {
+		t.Fatal(err)
+	}
+	root, err := hashutil.HashProto(attestation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if validate(context.Background(), &pb.AttestationAnnounce{Hash: root[:]}, "") {
+		t.Error("Expected an announcement of a known attestation to be invalid")
+	}
+	if len(mp.reports) != 1 {
+		t.Errorf("Expected no penalty for a known attestation, received %v", mp.reports)
+	}
+	for _, topic := range []pb.Topic{pb.Topic_BEACON_BLOCK_RESPONSE, pb.Topic_ATTESTATION_RESPONSE} {
+		if _, ok := gv.TopicValidators()[topic]; ok {
+			t.Errorf("Expected no validator for %v, which is not gossiped", topic)
+		}
+	}
+}
@@ -11,6 +11,10 @@ var (
 		Name: "initsync_sent_batched_block_req",
 		Help: "The number of sent batched block req",
 	})
+	failedBatchedBlockReq = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "initsync_failed_batched_block_req",
+		Help: "The number of batched block requests which timed out or were answered with invalid blocks",
+	})
 	batchedBlockReq = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "initsync_batched_block_req",
 		Help: "The number of received batch blocks responses",
@@ -23,6 +27,18 @@ var (
 		Name: "initsync_state_req",
 		Help: "The number of sent state requests",
 	})
+	sentStateChunkReq = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "initsync_sent_state_chunk_req",
+		Help: "The number of sent state chunk requests",
+	})
+	failedStateChunkReq = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "initsync_failed_state_chunk_req",
+		Help: "The number of state chunk requests which timed out or were answered with invalid chunks",
+	})
+	recStateChunk = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "initsync_received_state_chunks",
+		Help: "The number of received state chunks",
+	})
 	recState = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "initsync_received_state",
 		Help: "The number of received state",
@@ -0,0 +1,235 @@
+package initialsync
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// maxRangeAttempts is the number of peers which must return an empty batch for a
+// range before the range is accepted as made only of skipped slots.
+const maxRangeAttempts = 3
+
+var (
+	errUnrequestedRange = errors.New("peer sent blocks which were not requested from it")
+	errEmptyRange       = errors.New("peer sent no blocks for the requested range")
+	errInvalidRange     = errors.New("peer sent blocks outside of the requested range or out of order")
+)
+
+// rangeRequest is an inclusive range of slots requested from a single peer.
+type rangeRequest struct {
+	start       uint64
+	end         uint64
+	peer        peer.ID
+	deadline    time.Time
+	attempts    int
+	failedPeers map[peer.ID]bool
+}
+
+// receivedRange holds the blocks of a range until every range before it has
+// been processed.
+type receivedRange struct {
+	request *rangeRequest
+	blocks  []*pb.BeaconBlock
+}
+
+// rangeScheduler splits the slots to sync into ranges, requests them from several
+// peers at once and hands back the received blocks in slot order. A range which
+// times out or is answered with an invalid batch is requested again from another
+// peer. The scheduler is not safe for concurrent use.
+type rangeScheduler struct {
+	rangeSize     uint64
+	timeout       time.Duration
+	nextSlot      uint64
+	endSlot       uint64
+	processedSlot uint64
+	queue         []*rangeRequest
+	inFlight      map[peer.ID]*rangeRequest
+	received      map[uint64]*receivedRange
+	// processed are the ranges handed out for processing which may still hold a
+	// gap, as no block after their start slot has been saved yet.
+	processed []*rangeRequest
+}
+
+func newRangeScheduler(startSlot uint64, endSlot uint64, rangeSize uint64, timeout time.Duration) *rangeScheduler {
+	if rangeSize == 0 {
+		rangeSize = 1
+	}
+	return &rangeScheduler{
+		rangeSize:     rangeSize,
+		timeout:       timeout,
+		nextSlot:      startSlot,
+		endSlot:       endSlot,
+		processedSlot: startSlot,
+		inFlight:      make(map[peer.ID]*rangeRequest),
+		received:      make(map[uint64]*receivedRange),
+	}
+}
+
+// assign gives a range to each idle peer whose head slot is high enough to serve
+// it, retrying failed ranges first, and returns the requests to send.
+func (rs *rangeScheduler) assign(peers map[peer.ID]uint64, now time.Time) []*rangeRequest {
+	pids := make([]peer.ID, 0, len(peers))
+	for pid := range peers {
+		pids = append(pids, pid)
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		return pids[i] < pids[j]
+	})
+
+	var requests []*rangeRequest
+	for _, pid := range pids {
+		if _, ok := rs.inFlight[pid]; ok {
+			continue
+		}
+		req := rs.nextRequest(pid, peers[pid], len(peers))
+		if req == nil {
+			continue
+		}
+		req.peer = pid
+		req.deadline = now.Add(rs.timeout)
+		rs.inFlight[pid] = req
+		requests = append(requests, req)
+	}
+	return requests
+}
+
+// nextRequest returns the first queued range the peer has not failed to serve, or
+// else a new range. A range every peer failed to serve may be given to any peer.
+func (rs *rangeScheduler) nextRequest(pid peer.ID, headSlot uint64, numPeers int) *rangeRequest {
+	for i, req := range rs.queue {
+		if req.start > headSlot || (req.failedPeers[pid] && len(req.failedPeers) < numPeers) {
+			continue
+		}
+		rs.queue = append(rs.queue[:i], rs.queue[i+1:]...)
+		return req
+	}
+	if rs.nextSlot > rs.endSlot || rs.nextSlot > headSlot {
+		return nil
+	}
+	req := &rangeRequest{
+		start:       rs.nextSlot,
+		end:         rs.nextSlot + rs.rangeSize - 1,
+		failedPeers: make(map[peer.ID]bool),
+	}
+	if req.end > rs.endSlot {
+		req.end = rs.endSlot
+	}
+	rs.nextSlot = req.end + 1
+	return req
+}
+
+// receive records the blocks sent by a peer for the range requested from it. An
+// error is returned if the batch was not requested or is invalid, in which case
+// the range is requested again from another peer.
+func (rs *rangeScheduler) receive(pid peer.ID, blocks []*pb.BeaconBlock) (*rangeRequest, error) {
+	req, ok := rs.inFlight[pid]
+	if !ok {
+		return nil, errUnrequestedRange
+	}
+	delete(rs.inFlight, pid)
+	if len(blocks) == 0 && req.attempts < maxRangeAttempts-1 {
+		rs.retry(req)
+		return req, errEmptyRange
+	}
+	slot := req.start
+	for i, block := range blocks {
+		if block == nil || block.Slot < slot || block.Slot > req.end || (i > 0 && block.Slot == slot) {
+			rs.retry(req)
+			return req, errInvalidRange
+		}
+		slot = block.Slot
+	}
+	rs.received[req.start] = &receivedRange{request: req, blocks: blocks}
+	return req, nil
+}
+
+// expire requests again the ranges whose peer did not answer in time, and returns
+// the expired requests.
+func (rs *rangeScheduler) expire(now time.Time) []*rangeRequest {
+	var expired []*rangeRequest
+	for pid, req := range rs.inFlight {
+		if now.Before(req.deadline) {
+			continue
+		}
+		delete(rs.inFlight, pid)
+		rs.retry(req)
+		expired = append(expired, req)
+	}
+	return expired
+}
+
+// cancel requests again from another peer a range which could not be sent.
+func (rs *rangeScheduler) cancel(req *rangeRequest) {
+	delete(rs.inFlight, req.peer)
+	rs.retry(req)
+}
+
+// retry queues the range to be requested from a peer other than the ones which
+// already failed to serve it.
+func (rs *rangeScheduler) retry(req *rangeRequest) {
+	req.failedPeers[req.peer] = true
+	rs.queue = append(rs.queue, &rangeRequest{
+		start:       req.start,
+		end:         req.end,
+		attempts:    req.attempts + 1,
+		failedPeers: req.failedPeers,
+	})
+	sort.Slice(rs.queue, func(i, j int) bool {
+		return rs.queue[i].start < rs.queue[j].start
+	})
+}
+
+// retryFrom requests again the part of a received range from the given slot,
+// after a block failed processing. The peer which served the failing part is
+// not given the range again.
+func (rs *rangeScheduler) retryFrom(req *rangeRequest, slot uint64, pid peer.ID) {
+	rs.processedSlot = slot
+	for i, processed := range rs.processed {
+		if processed.start >= slot {
+			rs.processed = rs.processed[:i]
+			break
+		}
+	}
+	rs.retry(&rangeRequest{
+		start:       slot,
+		end:         req.end,
+		peer:        pid,
+		attempts:    req.attempts,
+		failedPeers: req.failedPeers,
+	})
+}
+
+// next returns the blocks of the range following the last processed one, if they
+// have been received.
+func (rs *rangeScheduler) next() (*rangeRequest, []*pb.BeaconBlock, bool) {
+	received, ok := rs.received[rs.processedSlot]
+	if !ok {
+		return nil, nil, false
+	}
+	delete(rs.received, rs.processedSlot)
+	rs.processedSlot = received.request.end + 1
+	rs.processed = append(rs.processed, received.request)
+	return received.request, received.blocks, true
+}
+
+// settle forgets the processed ranges which end before the given slot, as a
+// block after them was saved.
+func (rs *rangeScheduler) settle(slot uint64) {
+	for len(rs.processed) > 0 && rs.processed[0].end < slot {
+		rs.processed = rs.processed[1:]
+	}
+}
+
+// servedBy returns the processed range holding the slot, if any.
+func (rs *rangeScheduler) servedBy(slot uint64) *rangeRequest {
+	for _, req := range rs.processed {
+		if req.start <= slot && slot <= req.end {
+			return req
+		}
+	}
+	return nil
+}
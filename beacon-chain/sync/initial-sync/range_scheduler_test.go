@@ -0,0 +1,97 @@
+package initialsync
+
+import (
+	"testing"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestRangeScheduler_AssignsRangesToIdlePeers(t *testing.T) {
+	rs := newRangeScheduler(1, 25, 10, time.Second)
+	now := time.Now()
+
+	reqs := rs.assign(map[peer.ID]uint64{"a": 25, "b": 25, "c": 5}, now)
+	if len(reqs) != 2 {
+		t.Fatalf("Expected 2 requests, received %d", len(reqs))
+	}
+	if reqs[0].peer != "a" || reqs[0].start != 1 || reqs[0].end != 10 {
+		t.Errorf("Unexpected first request %+v", reqs[0])
+	}
+	if reqs[1].peer != "b" || reqs[1].start != 11 || reqs[1].end != 20 {
+		t.Errorf("Unexpected second request %+v", reqs[1])
+	}
+	if reqs := rs.assign(map[peer.ID]uint64{"a": 25, "b": 25}, now); len(reqs) != 0 {
+		t.Errorf("Expected no request to busy peers, received %d", len(reqs))
+	}
+}
+
+func TestRangeScheduler_ReordersResponses(t *testing.T) {
+	rs := newRangeScheduler(1, 20, 10, time.Second)
+	rs.assign(map[peer.ID]uint64{"a": 20, "b": 20}, time.Now())
+
+	if _, err := rs.receive("b", []*pb.BeaconBlock{{Slot: 12}, {Slot: 20}}); err != nil {
+		t.Fatalf("Could not receive blocks: %v", err)
+	}
+	if _, _, ok := rs.next(); ok {
+		t.Fatal("Expected the second range to wait for the first one")
+	}
+	if _, err := rs.receive("a", []*pb.BeaconBlock{{Slot: 1}, {Slot: 3}}); err != nil {
+		t.Fatalf("Could not receive blocks: %v", err)
+	}
+	for _, want := range []uint64{1, 12} {
+		_, blocks, ok := rs.next()
+		if !ok || blocks[0].Slot != want {
+			t.Fatalf("Expected the range starting with slot %d, received %v", want, blocks)
+		}
+	}
+	if rs.processedSlot <= rs.endSlot {
+		t.Error("Expected every range to be processed")
+	}
+}
+
+func TestRangeScheduler_RetriesOnOtherPeers(t *testing.T) {
+	rs := newRangeScheduler(1, 10, 10, time.Second)
+	now := time.Now()
+	peers := map[peer.ID]uint64{"a": 10, "b": 10}
+	rs.assign(peers, now)
+
+	if _, err := rs.receive("a", []*pb.BeaconBlock{{Slot: 11}}); err != errInvalidRange {
+		t.Fatalf("Expected %v, received %v", errInvalidRange, err)
+	}
+	reqs := rs.assign(peers, now)
+	if len(reqs) != 1 || reqs[0].peer != "b" || reqs[0].start != 1 {
+		t.Fatalf("Expected the range to be requested from the other peer, received %v", reqs)
+	}
+
+	expired := rs.expire(now.Add(2 * time.Second))
+	if len(expired) != 1 || expired[0].peer != "b" {
+		t.Fatalf("Expected the request to the other peer to expire, received %v", expired)
+	}
+	// Every peer failed to serve the range, so it is given to any of them.
+	if reqs := rs.assign(peers, now); len(reqs) != 1 || reqs[0].peer != "a" {
+		t.Fatalf("Expected the range to be requested again, received %v", reqs)
+	}
+	if _, err := rs.receive("b", []*pb.BeaconBlock{{Slot: 1}}); err != errUnrequestedRange {
+		t.Errorf("Expected %v for a late response, received %v", errUnrequestedRange, err)
+	}
+}
+
+func TestRangeScheduler_AcceptsEmptyRangeAfterAttempts(t *testing.T) {
+	rs := newRangeScheduler(1, 10, 10, time.Second)
+	peers := map[peer.ID]uint64{"a": 10}
+	for i := 0; i < maxRangeAttempts-1; i++ {
+		rs.assign(peers, time.Now())
+		if _, err := rs.receive("a", nil); err != errEmptyRange {
+			t.Fatalf("Expected %v, received %v", errEmptyRange, err)
+		}
+	}
+	rs.assign(peers, time.Now())
+	if _, err := rs.receive("a", nil); err != nil {
+		t.Fatalf("Expected the empty range to be accepted, received %v", err)
+	}
+	if _, _, ok := rs.next(); !ok || rs.processedSlot <= rs.endSlot {
+		t.Error("Expected the empty range to be processed")
+	}
+}
@@ -1,9 +1,13 @@
 // Package initialsync is run by the beacon node when the local chain is
 // behind the network's longest chain. Initial sync works as follows:
 // The node requests for the slot number of the most recent finalized block.
+// The finalized state is requested in chunks from several peers, each chunk proven by a
+// merkle branch against the state's tree root announced in a header.
 // The node then builds from the most recent finalized block by requesting for subsequent
 // blocks by slot number. Once the service detects that the local chain is caught up with
 // the network, the service hands over control to the regular sync service.
+// The progress of initial sync is persisted, so a node restarted mid-sync resumes
+// from the last processed block instead of requesting the finalized state again.
 // Note: The behavior of initialsync will likely change as the specification changes.
 // The most significant and highly probable change will be determining where to sync from.
 // The beacon chain may sync from a block in the pasts X months in order to combat long-range attacks
@@ -15,6 +19,7 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -22,6 +27,7 @@ import (
 	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	statetransfer "github.com/prysmaticlabs/prysm/beacon-chain/sync/state-transfer"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -46,12 +52,23 @@ type Config struct {
 	SyncService             syncService
 	ChainService            chainService
 	PowChain                powChainService
+	FromCheckpoint          bool
+	PeerStatuses            *p2p.PeerStatuses
+	RangeSize               uint64
+	RangeTimeout            time.Duration
 }
 
 // DefaultConfig provides the default configuration for a sync service.
 // SyncPollingInterval determines how frequently the service checks that initial sync is complete.
 // BlockBufferSize determines that buffer size of the `blockBuf` channel.
 // StateBufferSize determines the buffer size of the `stateBuf` channel.
+// FromCheckpoint determines whether the db was seeded with a trusted finalized
+// checkpoint, in which case the finalized state is not requested from peers.
+// PeerStatuses is the table of peer chain statuses used to pick the peers blocks
+// are requested from. Only the best peer is used if it is not set.
+// RangeSize determines the number of slots requested from a peer at once.
+// RangeTimeout determines how long a peer has to answer a request before the
+// range is requested from another peer.
 func DefaultConfig() *Config {
 	return &Config{
 		SyncPollingInterval:     time.Duration(params.BeaconConfig().SyncPollingInterval) * time.Second,
@@ -59,11 +76,14 @@ func DefaultConfig() *Config {
 		BatchedBlockBufferSize:  params.BeaconConfig().DefaultBufferSize,
 		BlockAnnounceBufferSize: params.BeaconConfig().DefaultBufferSize,
 		StateBufferSize:         params.BeaconConfig().DefaultBufferSize,
+		RangeSize:               params.BeaconConfig().BatchBlockLimit / 4,
+		RangeTimeout:            10 * time.Second,
 	}
 }
 
 type p2pAPI interface {
 	p2p.Sender
+	p2p.PeerReporter
 	Subscribe(msg proto.Message, channel chan p2p.Message) event.Subscription
 }
 
@@ -97,6 +117,8 @@ type InitialSync struct {
 	batchedBlockBuf     chan p2p.Message
 	blockBuf            chan p2p.Message
 	stateBuf            chan p2p.Message
+	stateHeaderBuf      chan p2p.Message
+	stateChunkBuf       chan p2p.Message
 	currentSlot         uint64
 	highestObservedSlot uint64
 	highestObservedRoot [32]byte
@@ -105,11 +127,19 @@ type InitialSync struct {
 	inMemoryBlocks      map[uint64]*pb.BeaconBlock
 	syncedFeed          *event.Feed
 	stateReceived       bool
-	lastRequestedSlot   uint64
 	finalizedStateRoot  [32]byte
 	mutex               *sync.Mutex
 	nodeIsSynced        bool
 	bestPeer            peer.ID
+	fromCheckpoint      bool
+	peerStatuses        *p2p.PeerStatuses
+	ranges              *rangeScheduler
+	rangeSize           uint64
+	rangeTimeout        time.Duration
+	stateAssembler      *statetransfer.Assembler
+	stateHeaderPeer     peer.ID
+	stateChunks         *chunkScheduler
+	failedHeaderPeers   map[peer.ID]bool
 }
 
 // NewInitialSyncService constructs a new InitialSyncService.
@@ -121,6 +151,8 @@ func NewInitialSyncService(ctx context.Context,
 
 	blockBuf := make(chan p2p.Message, cfg.BlockBufferSize)
 	stateBuf := make(chan p2p.Message, cfg.StateBufferSize)
+	stateHeaderBuf := make(chan p2p.Message, cfg.StateBufferSize)
+	stateChunkBuf := make(chan p2p.Message, cfg.StateBufferSize)
 	blockAnnounceBuf := make(chan p2p.Message, cfg.BlockAnnounceBufferSize)
 	batchedBlockBuf := make(chan p2p.Message, cfg.BatchedBlockBufferSize)
 
@@ -137,6 +169,8 @@ func NewInitialSyncService(ctx context.Context,
 		beaconStateSlot:     params.BeaconConfig().GenesisSlot,
 		blockBuf:            blockBuf,
 		stateBuf:            stateBuf,
+		stateHeaderBuf:      stateHeaderBuf,
+		stateChunkBuf:       stateChunkBuf,
 		batchedBlockBuf:     batchedBlockBuf,
 		blockAnnounceBuf:    blockAnnounceBuf,
 		syncPollingInterval: cfg.SyncPollingInterval,
@@ -144,6 +178,11 @@ func NewInitialSyncService(ctx context.Context,
 		syncedFeed:          new(event.Feed),
 		stateReceived:       false,
 		mutex:               new(sync.Mutex),
+		fromCheckpoint:      cfg.FromCheckpoint,
+		peerStatuses:        cfg.PeerStatuses,
+		rangeSize:           cfg.RangeSize,
+		rangeTimeout:        cfg.RangeTimeout,
+		failedHeaderPeers:   make(map[peer.ID]bool),
 	}
 }
 
@@ -167,7 +206,7 @@ func (s *InitialSync) Stop() error {
 
 // InitializeObservedSlot sets the highest observed slot.
 func (s *InitialSync) InitializeObservedSlot(slot uint64) {
-	s.highestObservedSlot = slot
+	atomic.StoreUint64(&s.highestObservedSlot, slot)
 }
 
 // InitializeObservedStateRoot sets the highest observed state root.
@@ -185,9 +224,10 @@ func (s *InitialSync) InitializeBestPeer(p peer.ID) {
 	s.bestPeer = p
 }
 
-// HighestObservedSlot returns the highest observed slot.
+// HighestObservedSlot returns the highest observed slot. It is read atomically,
+// as the sync status reads it while the node syncs.
 func (s *InitialSync) HighestObservedSlot() uint64 {
-	return s.highestObservedSlot
+	return atomic.LoadUint64(&s.highestObservedSlot)
 }
 
 // NodeIsSynced checks that the node has been caught up with the network.
@@ -240,6 +280,9 @@ func (s *InitialSync) exitInitialSync(ctx context.Context, block *pb.BeaconBlock
 	if err := s.db.UpdateChainHead(ctx, block, state); err != nil {
 		return err
 	}
+	if err := s.db.DeleteSyncProgress(ctx); err != nil {
+		return fmt.Errorf("could not delete sync progress: %v", err)
+	}
 
 	stateRoot := s.db.HeadStateRoot()
 
@@ -287,25 +330,49 @@ func (s *InitialSync) run() {
 	blockSub := s.p2p.Subscribe(&pb.BeaconBlockResponse{}, s.blockBuf)
 	batchedBlocksub := s.p2p.Subscribe(&pb.BatchedBeaconBlockResponse{}, s.batchedBlockBuf)
 	beaconStateSub := s.p2p.Subscribe(&pb.BeaconStateResponse{}, s.stateBuf)
+	stateHeaderSub := s.p2p.Subscribe(&pb.BeaconStateHeaderResponse{}, s.stateHeaderBuf)
+	stateChunkSub := s.p2p.Subscribe(&pb.BeaconStateChunkResponse{}, s.stateChunkBuf)
 	defer func() {
 		blockSub.Unsubscribe()
 		beaconStateSub.Unsubscribe()
+		stateHeaderSub.Unsubscribe()
+		stateChunkSub.Unsubscribe()
 		batchedBlocksub.Unsubscribe()
 		close(s.batchedBlockBuf)
 		close(s.blockBuf)
 		close(s.stateBuf)
+		close(s.stateHeaderBuf)
+		close(s.stateChunkBuf)
 	}()
 
-	// We send out a state request to all peers.
-	if err := s.requestStateFromPeer(s.ctx, s.finalizedStateRoot); err != nil {
+	if s.resumeSync(s.ctx) {
+		// Initial sync was interrupted by a restart, so we only request the
+		// blocks after the ones already processed.
+		if !s.nodeIsSynced {
+			s.requestBlockRanges()
+		}
+	} else if s.fromCheckpoint {
+		// The finalized state was seeded from a trusted checkpoint, so we only
+		// request the blocks after it.
+		s.stateReceived = true
+		log.WithField("slot", s.currentSlot-params.BeaconConfig().GenesisSlot).Info("Syncing from checkpoint")
+		s.requestBlockRanges()
+	} else if err := s.requestStateFromPeer(s.ctx, s.finalizedStateRoot, s.bestPeer); err != nil {
+		// We send out a state request to all peers.
 		log.Errorf("Could not request state from peer %v", err)
 	}
 
+	rangeTicker := time.NewTicker(rangeCheckInterval)
+	defer rangeTicker.Stop()
+
 	for {
 		select {
 		case <-s.ctx.Done():
 			log.Debug("Exiting goroutine")
 			return
+		case <-rangeTicker.C:
+			s.checkBlockRanges()
+			s.checkStateChunks()
 		case msg := <-s.blockBuf:
 			safelyHandleMessage(func(message p2p.Message) {
 				data := message.Data.(*pb.BeaconBlockResponse)
@@ -313,6 +380,10 @@ func (s *InitialSync) run() {
 			}, msg)
 		case msg := <-s.stateBuf:
 			safelyHandleMessage(s.processState, msg)
+		case msg := <-s.stateHeaderBuf:
+			safelyHandleMessage(s.processStateHeader, msg)
+		case msg := <-s.stateChunkBuf:
+			safelyHandleMessage(s.processStateChunk, msg)
 		case msg := <-s.batchedBlockBuf:
 			safelyHandleMessage(s.processBatchedBlocks, msg)
 		}
@@ -2,6 +2,7 @@ package initialsync
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -34,6 +35,30 @@ func (mp *mockP2P) Send(ctx context.Context, msg proto.Message, peerID peer.ID)
 	return nil
 }
 
+func (mp *mockP2P) ReportPeer(pid peer.ID, delta int) {}
+
+type recordingP2P struct {
+	mockP2P
+	sent       chan proto.Message
+	recipients chan peer.ID
+	reports    map[peer.ID]int
+}
+
+func (rp *recordingP2P) ReportPeer(pid peer.ID, delta int) {
+	if rp.reports == nil {
+		rp.reports = make(map[peer.ID]int)
+	}
+	rp.reports[pid] += delta
+}
+
+func (rp *recordingP2P) Send(ctx context.Context, msg proto.Message, peerID peer.ID) error {
+	rp.sent <- msg
+	if rp.recipients != nil {
+		rp.recipients <- peerID
+	}
+	return nil
+}
+
 type mockSyncService struct {
 	hasStarted bool
 	isSynced   bool
@@ -57,7 +82,10 @@ func (mp *mockPowchain) BlockExists(ctx context.Context, hash common.Hash) (bool
 	return true, nil, nil
 }
 
-type mockChainService struct{}
+type mockChainService struct {
+	// badBlocks are the roots of the blocks which fail the state transition.
+	badBlocks map[[32]byte]bool
+}
 
 func (ms *mockChainService) CanonicalBlockFeed() *event.Feed {
 	return new(event.Feed)
@@ -70,6 +98,13 @@ func (ms *mockChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBl
 func (ms *mockChainService) ApplyBlockStateTransition(
 	ctx context.Context, block *pb.BeaconBlock, beaconState *pb.BeaconState,
 ) (*pb.BeaconState, error) {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	if ms.badBlocks[root] {
+		return nil, errors.New("bad block")
+	}
 	return &pb.BeaconState{}, nil
 }
 
@@ -243,18 +278,15 @@ func TestProcessingBatchedBlocks_OK(t *testing.T) {
 		SyncService:  &mockSyncService{},
 		ChainService: &mockChainService{},
 		BeaconDB:     db,
+		RangeSize:    64,
 	}
 	ss := NewInitialSyncService(context.Background(), cfg)
 
 	batchSize := 20
-	batchedBlocks := make([]*pb.BeaconBlock, batchSize)
 	expectedSlot := params.BeaconConfig().GenesisSlot + uint64(batchSize)
-
-	for i := 1; i <= batchSize; i++ {
-		batchedBlocks[i-1] = &pb.BeaconBlock{
-			Slot: params.BeaconConfig().GenesisSlot + uint64(i),
-		}
-	}
+	ss.highestObservedSlot = expectedSlot + 10
+	ss.requestBlockRanges()
+	batchedBlocks := chainBlocks(t, db, params.BeaconConfig().GenesisSlot+1, expectedSlot)
 
 	msg := p2p.Message{
 		Ctx: context.Background(),
@@ -284,6 +316,258 @@ func TestProcessingBatchedBlocks_OK(t *testing.T) {
 	}
 }
 
+func TestProcessingBatchedBlocks_FromSeveralPeers(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	setUpGenesisStateAndBlock(db, t)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	statuses := p2p.NewPeerStatuses()
+	statuses.Set("a", &pb.Handshake{HeadSlot: genesisSlot + 30})
+	statuses.Set("b", &pb.Handshake{HeadSlot: genesisSlot + 30})
+	rp := &recordingP2P{sent: make(chan proto.Message, 10)}
+	cfg := &Config{
+		P2P:          rp,
+		SyncService:  &mockSyncService{},
+		ChainService: &mockChainService{},
+		BeaconDB:     db,
+		PeerStatuses: statuses,
+		RangeSize:    10,
+		RangeTimeout: time.Minute,
+	}
+	ss := NewInitialSyncService(context.Background(), cfg)
+	ss.highestObservedSlot = genesisSlot + 30
+	ss.requestBlockRanges()
+	if len(rp.sent) != 2 {
+		t.Fatalf("Expected a request to each peer, sent %d", len(rp.sent))
+	}
+
+	blocks := chainBlocks(t, db, genesisSlot+1, genesisSlot+20)
+	batch := func(pid peer.ID, blocks []*pb.BeaconBlock) p2p.Message {
+		return p2p.Message{
+			Ctx:  context.Background(),
+			Peer: pid,
+			Data: &pb.BatchedBeaconBlockResponse{BatchedBlocks: blocks},
+		}
+	}
+
+	// The second range arrives first and waits for the first one.
+	ss.processBatchedBlocks(batch("b", blocks[10:]))
+	if ss.currentSlot != genesisSlot {
+		t.Fatalf("Expected no block to be saved before the first range, current slot %d", ss.currentSlot-genesisSlot)
+	}
+	ss.processBatchedBlocks(batch("a", blocks[:10]))
+	if ss.currentSlot != genesisSlot+20 {
+		t.Errorf("Expected current slot 20, received %d", ss.currentSlot-genesisSlot)
+	}
+	if rp.reports["a"] != p2p.ScoreValidMessage || rp.reports["b"] != p2p.ScoreValidMessage {
+		t.Errorf("Expected both peers to be rewarded, received %v", rp.reports)
+	}
+
+	// The last range is requested from the peer which answered first, and it
+	// sends a block outside of the range.
+	var last *pb.BatchedBeaconBlockRequest
+	for len(rp.sent) > 0 {
+		last = (<-rp.sent).(*pb.BatchedBeaconBlockRequest)
+	}
+	if last.StartSlot != genesisSlot+21 || last.EndSlot != genesisSlot+30 {
+		t.Fatalf("Expected the last range to be requested, received %v", last)
+	}
+	ss.processBatchedBlocks(batch("b", blocks[:1]))
+	if rp.reports["b"] != p2p.ScoreValidMessage+p2p.ScoreInvalidBlock {
+		t.Errorf("Expected peer b to be penalized, received %v", rp.reports)
+	}
+	if len(rp.sent) != 1 {
+		t.Fatalf("Expected the range to be requested again, sent %d", len(rp.sent))
+	}
+}
+
+func TestProcessingBatchedBlocks_BlamesPeerWhichLeftOutBlocks(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	setUpGenesisStateAndBlock(db, t)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	statuses := p2p.NewPeerStatuses()
+	statuses.Set("a", &pb.Handshake{HeadSlot: genesisSlot + 20})
+	statuses.Set("b", &pb.Handshake{HeadSlot: genesisSlot + 20})
+	rp := &recordingP2P{sent: make(chan proto.Message, 10)}
+	cfg := &Config{
+		P2P:          rp,
+		SyncService:  &mockSyncService{},
+		ChainService: &mockChainService{},
+		BeaconDB:     db,
+		PeerStatuses: statuses,
+		RangeSize:    10,
+		RangeTimeout: time.Minute,
+	}
+	ss := NewInitialSyncService(context.Background(), cfg)
+	ss.highestObservedSlot = genesisSlot + 30
+	ss.requestBlockRanges()
+	for len(rp.sent) > 0 {
+		<-rp.sent
+	}
+
+	blocks := chainBlocks(t, db, genesisSlot+1, genesisSlot+20)
+	batch := func(pid peer.ID, blocks []*pb.BeaconBlock) p2p.Message {
+		return p2p.Message{
+			Ctx:  context.Background(),
+			Peer: pid,
+			Data: &pb.BatchedBeaconBlockResponse{BatchedBlocks: blocks},
+		}
+	}
+
+	// Peer a leaves out the last blocks of its range, so the parent of the first
+	// block of peer b is unknown.
+	ss.processBatchedBlocks(batch("a", blocks[:8]))
+	ss.processBatchedBlocks(batch("b", blocks[10:]))
+	if ss.currentSlot != genesisSlot+8 {
+		t.Fatalf("Expected current slot 8, received %d", ss.currentSlot-genesisSlot)
+	}
+	if rp.reports["a"] != p2p.ScoreValidMessage+p2p.ScoreInvalidBlock {
+		t.Errorf("Expected peer a to be penalized, received %v", rp.reports)
+	}
+	if rp.reports["b"] != 0 {
+		t.Errorf("Expected peer b not to be penalized, received %v", rp.reports)
+	}
+	var retried *pb.BatchedBeaconBlockRequest
+	for len(rp.sent) > 0 {
+		req := (<-rp.sent).(*pb.BatchedBeaconBlockRequest)
+		if req.StartSlot == genesisSlot+9 {
+			retried = req
+		}
+	}
+	if retried == nil || retried.EndSlot != genesisSlot+20 {
+		t.Fatalf("Expected the slots after the last saved block to be requested again, received %v", retried)
+	}
+}
+
+func TestProcessingBatchedBlocks_ReplacesBadBlock(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	setUpGenesisStateAndBlock(db, t)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	statuses := p2p.NewPeerStatuses()
+	statuses.Set("a", &pb.Handshake{HeadSlot: genesisSlot + 10})
+	statuses.Set("b", &pb.Handshake{HeadSlot: genesisSlot + 10})
+	blocks := chainBlocks(t, db, genesisSlot+1, genesisSlot+10)
+	badBlock := proto.Clone(blocks[4]).(*pb.BeaconBlock)
+	badBlock.RandaoReveal = []byte{'b'}
+	badRoot, err := hashutil.HashBeaconBlock(badBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rp := &recordingP2P{sent: make(chan proto.Message, 10), recipients: make(chan peer.ID, 10)}
+	cfg := &Config{
+		P2P:          rp,
+		SyncService:  &mockSyncService{},
+		ChainService: &mockChainService{badBlocks: map[[32]byte]bool{badRoot: true}},
+		BeaconDB:     db,
+		PeerStatuses: statuses,
+		RangeSize:    10,
+		RangeTimeout: time.Minute,
+	}
+	ss := NewInitialSyncService(context.Background(), cfg)
+	ss.highestObservedSlot = genesisSlot + 30
+	ss.requestBlockRanges()
+	for len(rp.sent) > 0 {
+		<-rp.sent
+		<-rp.recipients
+	}
+
+	batch := func(pid peer.ID, blocks []*pb.BeaconBlock) p2p.Message {
+		return p2p.Message{
+			Ctx:  context.Background(),
+			Peer: pid,
+			Data: &pb.BatchedBeaconBlockResponse{BatchedBlocks: blocks},
+		}
+	}
+
+	// Peer a sends a bad block at slot 5, which is requested again from peer b.
+	badBatch := append(append(append([]*pb.BeaconBlock{}, blocks[:4]...), badBlock), blocks[5:]...)
+	ss.processBatchedBlocks(batch("a", badBatch))
+	if ss.currentSlot != genesisSlot+4 {
+		t.Fatalf("Expected current slot 4, received %d", ss.currentSlot-genesisSlot)
+	}
+	if db.HasBlock(badRoot) {
+		t.Error("Expected the bad block to be deleted from the db")
+	}
+	if rp.reports["a"] != p2p.ScoreInvalidBlock {
+		t.Errorf("Expected peer a to be penalized, received %v", rp.reports)
+	}
+	if len(rp.sent) != 1 {
+		t.Fatalf("Expected the slots from the bad block to be requested again, received %d requests", len(rp.sent))
+	}
+	req := (<-rp.sent).(*pb.BatchedBeaconBlockRequest)
+	if pid := <-rp.recipients; pid != "b" || req.StartSlot != genesisSlot+5 || req.EndSlot != genesisSlot+10 {
+		t.Fatalf("Expected slots 5 to 10 to be requested from peer b, received %v from %s", req, pid)
+	}
+
+	ss.processBatchedBlocks(batch("b", blocks[4:]))
+	if ss.currentSlot != genesisSlot+10 {
+		t.Fatalf("Expected current slot 10, received %d", ss.currentSlot-genesisSlot)
+	}
+	if rp.reports["b"] != p2p.ScoreValidMessage {
+		t.Errorf("Expected peer b not to be penalized, received %v", rp.reports)
+	}
+}
+
+func TestProcessingBatchedBlocks_EmptyRangeIsNotPenalized(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	setUpGenesisStateAndBlock(db, t)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	rp := &recordingP2P{sent: make(chan proto.Message, 10)}
+	cfg := &Config{
+		P2P:          rp,
+		SyncService:  &mockSyncService{},
+		ChainService: &mockChainService{},
+		BeaconDB:     db,
+		RangeSize:    10,
+		RangeTimeout: time.Minute,
+	}
+	ss := NewInitialSyncService(context.Background(), cfg)
+	ss.InitializeBestPeer("a")
+	ss.highestObservedSlot = genesisSlot + 10
+	ss.requestBlockRanges()
+	ss.processBatchedBlocks(p2p.Message{
+		Ctx:  context.Background(),
+		Peer: "a",
+		Data: &pb.BatchedBeaconBlockResponse{},
+	})
+	if rp.reports["a"] != 0 {
+		t.Errorf("Expected a peer sending only skipped slots not to be penalized, received %v", rp.reports)
+	}
+}
+
+// chainBlocks returns a block at each slot of the range, each one the child of
+// the previous one, starting from the chain head.
+func chainBlocks(t *testing.T, beaconDB *db.BeaconDB, startSlot uint64, endSlot uint64) []*pb.BeaconBlock {
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parentRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var blocks []*pb.BeaconBlock
+	for slot := startSlot; slot <= endSlot; slot++ {
+		block := &pb.BeaconBlock{
+			Slot:             slot,
+			ParentRootHash32: append([]byte{}, parentRoot[:]...),
+		}
+		parentRoot, err = hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
 func TestProcessingBlocks_SkippedSlots(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -363,6 +647,121 @@ func TestProcessingBlocks_SkippedSlots(t *testing.T) {
 	}
 }
 
+func TestStart_FirstRequest(t *testing.T) {
+	tests := []struct {
+		fromCheckpoint bool
+		want           proto.Message
+	}{
+		{
+			fromCheckpoint: false,
+			want:           &pb.BeaconStateHeaderRequest{FinalizedStateRootHash32S: make([]byte, 32)},
+		},
+		{
+			fromCheckpoint: true,
+			want: &pb.BatchedBeaconBlockRequest{
+				StartSlot: params.BeaconConfig().GenesisSlot + 1,
+				EndSlot:   params.BeaconConfig().GenesisSlot + 10,
+			},
+		},
+	}
+	for _, tt := range tests {
+		db := internal.SetupDB(t)
+		setUpGenesisStateAndBlock(db, t)
+
+		p2p := &recordingP2P{sent: make(chan proto.Message, 1)}
+		cfg := &Config{
+			P2P:            p2p,
+			SyncService:    &mockSyncService{},
+			ChainService:   &mockChainService{},
+			BeaconDB:       db,
+			PowChain:       &mockPowchain{},
+			FromCheckpoint: tt.fromCheckpoint,
+			RangeSize:      64,
+			RangeTimeout:   time.Minute,
+		}
+		ss := NewInitialSyncService(context.Background(), cfg)
+		ss.InitializeObservedSlot(params.BeaconConfig().GenesisSlot + 10)
+		ss.Start()
+		if msg := <-p2p.sent; !proto.Equal(msg, tt.want) {
+			t.Errorf("Expected first request %v, received %v", tt.want, msg)
+		}
+		if err := ss.Stop(); err != nil {
+			t.Fatal(err)
+		}
+		internal.TeardownDB(t, db)
+	}
+}
+
+func TestStart_ResumesFromProgress(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	setUpGenesisStateAndBlock(beaconDB, t)
+	ctx := context.Background()
+	genesisSlot := params.BeaconConfig().GenesisSlot
+
+	genesis, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveSyncProgress(ctx, &db.SyncProgress{
+		ProcessedSlot: genesisSlot,
+		ProcessedRoot: genesisRoot,
+		TargetSlot:    genesisSlot + 10,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// Blocks saved before the restart but never applied to the chain.
+	blocks := chainBlocks(t, beaconDB, genesisSlot+1, genesisSlot+5)
+	for _, block := range blocks {
+		if err := beaconDB.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rp := &recordingP2P{sent: make(chan proto.Message, 1)}
+	cfg := &Config{
+		P2P:          rp,
+		SyncService:  &mockSyncService{},
+		ChainService: &mockChainService{},
+		BeaconDB:     beaconDB,
+		PowChain:     &mockPowchain{},
+		RangeSize:    64,
+		RangeTimeout: time.Minute,
+	}
+	ss := NewInitialSyncService(context.Background(), cfg)
+	ss.InitializeObservedSlot(genesisSlot + 10)
+	ss.Start()
+	want := &pb.BatchedBeaconBlockRequest{
+		StartSlot: genesisSlot + 6,
+		EndSlot:   genesisSlot + 10,
+	}
+	if msg := <-rp.sent; !proto.Equal(msg, want) {
+		t.Errorf("Expected first request %v, received %v", want, msg)
+	}
+	if err := ss.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head.Slot != genesisSlot+5 {
+		t.Errorf("Expected the stored blocks to be replayed up to slot 5, head at slot %d", head.Slot-genesisSlot)
+	}
+	progress, err := beaconDB.SyncProgress(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress.ProcessedSlot != genesisSlot+5 {
+		t.Errorf("Expected progress at slot 5, received slot %d", progress.ProcessedSlot-genesisSlot)
+	}
+}
+
 func TestSafelyHandleMessage(t *testing.T) {
 	hook := logTest.NewGlobal()
 
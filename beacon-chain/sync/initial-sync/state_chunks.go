@@ -0,0 +1,305 @@
+package initialsync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	statetransfer "github.com/prysmaticlabs/prysm/beacon-chain/sync/state-transfer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// maxChunksInFlight is the number of state chunks requested from a peer at once.
+const maxChunksInFlight = 4
+
+// chunkRequest is a chunk of the finalized state requested from a single peer.
+type chunkRequest struct {
+	rng         statetransfer.Range
+	peer        peer.ID
+	deadline    time.Time
+	failedPeers map[peer.ID]bool
+}
+
+// chunkScheduler spreads the requests for the chunks of a state across peers. A
+// chunk which times out or fails verification is requested again from another
+// peer. The scheduler is not safe for concurrent use.
+type chunkScheduler struct {
+	timeout  time.Duration
+	queue    []*chunkRequest
+	inFlight map[statetransfer.Range]*chunkRequest
+	perPeer  map[peer.ID]int
+}
+
+func newChunkScheduler(ranges []statetransfer.Range, timeout time.Duration) *chunkScheduler {
+	queue := make([]*chunkRequest, len(ranges))
+	for i, r := range ranges {
+		queue[i] = &chunkRequest{rng: r, failedPeers: make(map[peer.ID]bool)}
+	}
+	return &chunkScheduler{
+		timeout:  timeout,
+		queue:    queue,
+		inFlight: make(map[statetransfer.Range]*chunkRequest),
+		perPeer:  make(map[peer.ID]int),
+	}
+}
+
+// assign gives queued chunks to the peers with fewer than maxChunksInFlight
+// requests, and returns the requests to send. A chunk is not given again to a
+// peer which failed to serve it, unless every peer did.
+func (cs *chunkScheduler) assign(peers []peer.ID, now time.Time) []*chunkRequest {
+	pids := append([]peer.ID{}, peers...)
+	sort.Slice(pids, func(i, j int) bool {
+		return pids[i] < pids[j]
+	})
+	var requests []*chunkRequest
+	for assigned := true; assigned; {
+		assigned = false
+		for _, pid := range pids {
+			if cs.perPeer[pid] >= maxChunksInFlight {
+				continue
+			}
+			for i, req := range cs.queue {
+				if req.failedPeers[pid] && len(req.failedPeers) < len(pids) {
+					continue
+				}
+				cs.queue = append(cs.queue[:i], cs.queue[i+1:]...)
+				req.peer = pid
+				req.deadline = now.Add(cs.timeout)
+				cs.inFlight[req.rng] = req
+				cs.perPeer[pid]++
+				requests = append(requests, req)
+				assigned = true
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// receive returns the request of a chunk sent by a peer, if it was requested
+// from that peer.
+func (cs *chunkScheduler) receive(r statetransfer.Range, pid peer.ID) (*chunkRequest, bool) {
+	req, ok := cs.inFlight[r]
+	if !ok || req.peer != pid {
+		return nil, false
+	}
+	cs.done(req)
+	return req, true
+}
+
+// retry queues a chunk again, to be requested from another peer.
+func (cs *chunkScheduler) retry(req *chunkRequest) {
+	req.failedPeers[req.peer] = true
+	cs.queue = append(cs.queue, req)
+}
+
+// cancel queues a chunk which could not be requested from its peer.
+func (cs *chunkScheduler) cancel(req *chunkRequest) {
+	cs.done(req)
+	cs.retry(req)
+}
+
+// expire queues again the chunks whose request timed out, and returns them.
+func (cs *chunkScheduler) expire(now time.Time) []*chunkRequest {
+	var expired []*chunkRequest
+	for _, req := range cs.inFlight {
+		if now.After(req.deadline) {
+			expired = append(expired, req)
+		}
+	}
+	for _, req := range expired {
+		cs.cancel(req)
+	}
+	return expired
+}
+
+// exhausted determines whether every one of the peers failed to serve some chunk,
+// in which case the chunks are not served for the requested tree root.
+func (cs *chunkScheduler) exhausted(peers []peer.ID) bool {
+	if len(peers) == 0 {
+		return false
+	}
+	for _, req := range cs.queue {
+		failedAll := true
+		for _, pid := range peers {
+			if !req.failedPeers[pid] {
+				failedAll = false
+				break
+			}
+		}
+		if failedAll {
+			return true
+		}
+	}
+	return false
+}
+
+func (cs *chunkScheduler) done(req *chunkRequest) {
+	delete(cs.inFlight, req.rng)
+	cs.perPeer[req.peer]--
+	if cs.perPeer[req.peer] <= 0 {
+		delete(cs.perPeer, req.peer)
+	}
+}
+
+// processStateHeader verifies the header of the finalized state, and requests
+// the chunks of the state from the peers.
+func (s *InitialSync) processStateHeader(msg p2p.Message) {
+	_, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.initial-sync.processStateHeader")
+	defer span.End()
+	if s.stateReceived || s.stateAssembler != nil {
+		return
+	}
+	if msg.Peer != s.stateHeaderPeer {
+		log.WithField("peerID", msg.Peer.Pretty()).Debug("Received unrequested state header")
+		return
+	}
+	header := msg.Data.(*pb.BeaconStateHeaderResponse)
+	assembler, err := statetransfer.NewAssembler(s.finalizedStateRoot, header)
+	if err != nil {
+		log.WithField("peerID", msg.Peer.Pretty()).Errorf("Received invalid state header: %v", err)
+		s.p2p.ReportPeer(msg.Peer, p2p.ScoreMalformedMessage)
+		return
+	}
+	s.stateAssembler = assembler
+	s.stateChunks = newChunkScheduler(assembler.Missing(), s.rangeTimeout)
+	log.WithField("chunks", len(assembler.Missing())).Info("Requesting finalized state in chunks")
+	if assembler.Complete() {
+		s.finishStateTransfer()
+		return
+	}
+	s.dispatchStateChunks()
+}
+
+// processStateChunk verifies a chunk of the finalized state against the tree
+// root announced in its header.
+func (s *InitialSync) processStateChunk(msg p2p.Message) {
+	_, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.initial-sync.processStateChunk")
+	defer span.End()
+	if s.stateAssembler == nil {
+		return
+	}
+	chunk := msg.Data.(*pb.BeaconStateChunkResponse)
+	r := statetransfer.Range{Field: chunk.Field, Start: chunk.Start, Size: chunk.RangeSize}
+	req, ok := s.stateChunks.receive(r, msg.Peer)
+	if !ok {
+		log.WithField("peerID", msg.Peer.Pretty()).Debug("Received unrequested state chunk")
+		return
+	}
+	if err := s.stateAssembler.AddChunk(chunk); err != nil {
+		failedStateChunkReq.Inc()
+		log.WithField("peerID", msg.Peer.Pretty()).Errorf("Received invalid state chunk: %v", err)
+		s.p2p.ReportPeer(msg.Peer, p2p.ScoreMalformedMessage)
+		s.stateChunks.retry(req)
+		s.dispatchStateChunks()
+		return
+	}
+	recStateChunk.Inc()
+	if s.stateAssembler.Complete() {
+		s.finishStateTransfer()
+		return
+	}
+	s.dispatchStateChunks()
+}
+
+// checkStateChunks requests the chunks whose request timed out from other peers.
+func (s *InitialSync) checkStateChunks() {
+	if s.stateAssembler == nil {
+		return
+	}
+	for _, req := range s.stateChunks.expire(time.Now()) {
+		failedStateChunkReq.Inc()
+		log.WithField("peerID", req.peer.Pretty()).Debug("State chunk request timed out")
+	}
+	s.dispatchStateChunks()
+}
+
+// dispatchStateChunks sends the chunk requests assigned to the peers.
+func (s *InitialSync) dispatchStateChunks() {
+	ctx, span := trace.StartSpan(context.Background(), "beacon-chain.sync.initial-sync.dispatchStateChunks")
+	defer span.End()
+	treeRoot := s.stateAssembler.TreeRoot()
+	peers := make([]peer.ID, 0)
+	for pid := range s.syncPeers() {
+		peers = append(peers, pid)
+	}
+	if s.stateChunks.exhausted(peers) {
+		// No peer serves some chunk for the tree root of the header, so the
+		// header is abandoned rather than waiting for the chunk forever.
+		s.abandonStateHeader(ctx, "no peer serves the state chunks of the header")
+		return
+	}
+	for _, req := range s.stateChunks.assign(peers, time.Now()) {
+		sentStateChunkReq.Inc()
+		log.WithFields(logrus.Fields{
+			"peerID": req.peer.Pretty(),
+			"field":  req.rng.Field,
+			"start":  req.rng.Start,
+		}).Debug("Requesting state chunk")
+		if err := s.p2p.Send(ctx, &pb.BeaconStateChunkRequest{
+			StateTreeRootHash32: treeRoot[:],
+			Field:               req.rng.Field,
+			Start:               req.rng.Start,
+			RangeSize:           req.rng.Size,
+		}, req.peer); err != nil {
+			log.Errorf("Could not send state chunk request to peer %s: %v", req.peer.Pretty(), err)
+			s.stateChunks.cancel(req)
+		}
+	}
+}
+
+// finishStateTransfer saves the assembled finalized state. If it is not the
+// requested state, the peer which sent the header lied about its content, and
+// the header is requested from another peer.
+func (s *InitialSync) finishStateTransfer() {
+	ctx, span := trace.StartSpan(context.Background(), "beacon-chain.sync.initial-sync.finishStateTransfer")
+	defer span.End()
+	state, err := s.stateAssembler.State()
+	if err != nil {
+		s.p2p.ReportPeer(s.stateHeaderPeer, p2p.ScoreMalformedMessage)
+		s.abandonStateHeader(ctx, fmt.Sprintf("could not assemble finalized state: %v", err))
+		return
+	}
+	s.stateAssembler = nil
+	s.stateChunks = nil
+	recState.Inc()
+	if err := s.saveFinalizedState(ctx, state); err != nil {
+		log.Error(err)
+	}
+}
+
+// abandonStateHeader drops the chunks received for the current header, and
+// requests the header of the finalized state from a peer which did not send a
+// header yet, or from any peer once each of them did.
+func (s *InitialSync) abandonStateHeader(ctx context.Context, reason string) {
+	log.WithField("peerID", s.stateHeaderPeer.Pretty()).Errorf("Abandoning state header: %s", reason)
+	s.failedHeaderPeers[s.stateHeaderPeer] = true
+	s.stateAssembler = nil
+	s.stateChunks = nil
+
+	peers := make([]peer.ID, 0)
+	for pid := range s.syncPeers() {
+		peers = append(peers, pid)
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i] < peers[j]
+	})
+	pid := s.bestPeer
+	for _, candidate := range peers {
+		if !s.failedHeaderPeers[candidate] {
+			pid = candidate
+			break
+		}
+	}
+	if s.failedHeaderPeers[pid] {
+		s.failedHeaderPeers = make(map[peer.ID]bool)
+	}
+	if err := s.requestStateFromPeer(ctx, s.finalizedStateRoot, pid); err != nil {
+		log.Errorf("Could not request state header from peer %s: %v", pid.Pretty(), err)
+	}
+}
@@ -0,0 +1,223 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	statetransfer "github.com/prysmaticlabs/prysm/beacon-chain/sync/state-transfer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestChunkScheduler_SpreadsChunksAcrossPeers(t *testing.T) {
+	var ranges []statetransfer.Range
+	for i := uint64(0); i < 10; i++ {
+		ranges = append(ranges, statetransfer.Range{Field: "ValidatorRegistry", Start: i * 1024, Size: 1024})
+	}
+	cs := newChunkScheduler(ranges, time.Second)
+	now := time.Now()
+
+	reqs := cs.assign([]peer.ID{"b", "a"}, now)
+	if len(reqs) != 2*maxChunksInFlight {
+		t.Fatalf("Expected %d requests, received %d", 2*maxChunksInFlight, len(reqs))
+	}
+	if reqs[0].peer != "a" || reqs[1].peer != "b" {
+		t.Errorf("Expected chunks to alternate between peers, received %s and %s", reqs[0].peer, reqs[1].peer)
+	}
+	if _, ok := cs.receive(reqs[0].rng, "b"); ok {
+		t.Error("Expected a chunk from another peer to be ignored")
+	}
+	if _, ok := cs.receive(reqs[0].rng, "a"); !ok {
+		t.Fatal("Expected the chunk to be received")
+	}
+	if reqs := cs.assign([]peer.ID{"a", "b"}, now); len(reqs) != 1 || reqs[0].peer != "a" {
+		t.Errorf("Expected one chunk for the idle peer, received %d", len(reqs))
+	}
+
+	expired := cs.expire(now.Add(2 * time.Second))
+	if len(expired) != 2*maxChunksInFlight {
+		t.Fatalf("Expected %d expired chunks, received %d", 2*maxChunksInFlight, len(expired))
+	}
+	reqs = cs.assign([]peer.ID{"a", "b", "c"}, now)
+	for _, req := range reqs {
+		if req.failedPeers[req.peer] {
+			t.Errorf("Expected chunk %+v not to be requested again from %s", req.rng, req.peer)
+		}
+	}
+}
+
+// testFinalizedSnapshot returns a finalized state large enough to be sent in
+// several chunks, and its snapshot.
+func testFinalizedSnapshot(t *testing.T) (*pb.BeaconState, *statetransfer.Snapshot) {
+	fState := &pb.BeaconState{
+		Slot:           params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch,
+		FinalizedEpoch: params.BeaconConfig().GenesisEpoch + 1,
+		LatestBlock: &pb.BeaconBlock{
+			Slot: params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch,
+		},
+		LatestEth1Data: &pb.Eth1Data{
+			BlockHash32: []byte{},
+		},
+	}
+	for i := 0; i < 2500; i++ {
+		fState.ValidatorRegistry = append(fState.ValidatorRegistry, &pb.Validator{
+			Pubkey:    []byte{byte(i), byte(i >> 8)},
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		})
+		fState.ValidatorBalances = append(fState.ValidatorBalances, params.BeaconConfig().MaxDepositAmount)
+	}
+	snapshot, err := statetransfer.NewSnapshot(fState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fState, snapshot
+}
+
+// setUpStateTransfer returns an initial sync service which requested the header
+// of the finalized state from peer "a", out of peers "a" and "b".
+func setUpStateTransfer(t *testing.T, beaconDB *db.BeaconDB, snapshot *statetransfer.Snapshot) (*InitialSync, *recordingP2P) {
+	statuses := p2p.NewPeerStatuses()
+	statuses.Set("a", &pb.Handshake{HeadSlot: params.BeaconConfig().GenesisSlot + 100})
+	statuses.Set("b", &pb.Handshake{HeadSlot: params.BeaconConfig().GenesisSlot + 100})
+	rp := &recordingP2P{sent: make(chan proto.Message, 100), recipients: make(chan peer.ID, 100)}
+	cfg := &Config{
+		P2P:          rp,
+		SyncService:  &mockSyncService{},
+		ChainService: &mockChainService{},
+		BeaconDB:     beaconDB,
+		PowChain:     &mockPowchain{},
+		PeerStatuses: statuses,
+		RangeSize:    64,
+		RangeTimeout: time.Minute,
+	}
+	ss := NewInitialSyncService(context.Background(), cfg)
+	ss.InitializeFinalizedStateRoot(snapshot.ProtoRoot)
+	ss.InitializeBestPeer("a")
+	ss.InitializeObservedSlot(params.BeaconConfig().GenesisSlot + 100)
+	if err := ss.requestStateFromPeer(context.Background(), snapshot.ProtoRoot, "a"); err != nil {
+		t.Fatal(err)
+	}
+	<-rp.sent
+	<-rp.recipients
+	return ss, rp
+}
+
+func TestProcessStateHeader_IgnoresUnrequestedHeader(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	_, snapshot := testFinalizedSnapshot(t)
+	ss, _ := setUpStateTransfer(t, db, snapshot)
+
+	ss.processStateHeader(p2p.Message{Ctx: context.Background(), Peer: "b", Data: snapshot.Header()})
+	if ss.stateAssembler != nil {
+		t.Error("Expected the header of a peer it was not requested from to be ignored")
+	}
+	ss.processStateHeader(p2p.Message{Ctx: context.Background(), Peer: "a", Data: snapshot.Header()})
+	if ss.stateAssembler == nil {
+		t.Error("Expected the requested header to be accepted")
+	}
+}
+
+func TestProcessStateChunks_AssemblesFinalizedState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	setUpGenesisStateAndBlock(db, t)
+	fState, snapshot := testFinalizedSnapshot(t)
+	ss, rp := setUpStateTransfer(t, db, snapshot)
+
+	ss.processStateHeader(p2p.Message{Ctx: context.Background(), Peer: "a", Data: snapshot.Header()})
+	var tamperedBy peer.ID
+	for !ss.stateReceived {
+		var req *pb.BeaconStateChunkRequest
+		select {
+		case msg := <-rp.sent:
+			req = msg.(*pb.BeaconStateChunkRequest)
+		default:
+			t.Fatal("Expected a state chunk request")
+		}
+		pid := <-rp.recipients
+		chunk, err := snapshot.Chunk(statetransfer.Range{Field: req.Field, Start: req.Start, Size: req.RangeSize})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tamperedBy == "" && req.Field == "ValidatorBalances" {
+			// The invalid chunk is rejected and requested again from the other peer.
+			tamperedBy = pid
+			chunk = proto.Clone(chunk).(*pb.BeaconStateChunkResponse)
+			chunk.PartialState.ValidatorBalances[0]++
+		}
+		ss.processStateChunk(p2p.Message{Ctx: context.Background(), Peer: pid, Data: chunk})
+	}
+	if rp.reports[tamperedBy] != p2p.ScoreMalformedMessage {
+		t.Errorf("Expected the peer to be reported once for the invalid chunk, received score %d", rp.reports[tamperedBy])
+	}
+
+	saved, err := db.FinalizedState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(saved, fState) {
+		t.Error("Expected the assembled state to be saved as the finalized state")
+	}
+	if ss.currentSlot != fState.Slot {
+		t.Errorf("Expected current slot %d, received %d", fState.Slot, ss.currentSlot)
+	}
+}
+
+func TestProcessStateChunks_AbandonsHeaderWhenEveryPeerFails(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	_, snapshot := testFinalizedSnapshot(t)
+	ss, rp := setUpStateTransfer(t, db, snapshot)
+
+	ss.processStateHeader(p2p.Message{Ctx: context.Background(), Peer: "a", Data: snapshot.Header()})
+	failed := make(map[peer.ID]bool)
+	for ss.stateAssembler != nil {
+		var req *pb.BeaconStateChunkRequest
+		select {
+		case msg := <-rp.sent:
+			req = msg.(*pb.BeaconStateChunkRequest)
+		default:
+			t.Fatal("Expected a state chunk request")
+		}
+		pid := <-rp.recipients
+		chunk, err := snapshot.Chunk(statetransfer.Range{Field: req.Field, Start: req.Start, Size: req.RangeSize})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if req.Field == "ValidatorBalances" && req.Start == 0 {
+			// Neither peer serves the first chunk of the balances.
+			failed[pid] = true
+			chunk = proto.Clone(chunk).(*pb.BeaconStateChunkResponse)
+			chunk.PartialState.ValidatorBalances[0]++
+		}
+		ss.processStateChunk(p2p.Message{Ctx: context.Background(), Peer: pid, Data: chunk})
+	}
+	if !failed["a"] || !failed["b"] {
+		t.Fatalf("Expected both peers to fail the chunk, failed %v", failed)
+	}
+	if ss.stateReceived {
+		t.Fatal("Expected the state not to be received")
+	}
+	var headerReq *pb.BeaconStateHeaderRequest
+	var pid peer.ID
+	for len(rp.sent) > 0 {
+		msg := <-rp.sent
+		pid = <-rp.recipients
+		if req, ok := msg.(*pb.BeaconStateHeaderRequest); ok {
+			headerReq = req
+		}
+	}
+	if headerReq == nil {
+		t.Fatal("Expected the state header to be requested again")
+	}
+	if pid != "b" {
+		t.Errorf("Expected the state header to be requested from another peer, requested from %s", pid)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	peer "github.com/libp2p/go-libp2p-peer"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -15,6 +17,12 @@ import (
 	"go.opencensus.io/trace"
 )
 
+// rangeCheckInterval is how often the batched block requests are checked for
+// timeouts and idle peers are given new ranges to request.
+const rangeCheckInterval = time.Second
+
+var errParentUnknown = errors.New("parent block is unknown")
+
 // processBlock is the main method that validates each block which is received
 // for initial sync. It checks if the blocks are valid and then will continue to
 // process and save it into the db.
@@ -69,64 +77,158 @@ func (s *InitialSync) processBlock(ctx context.Context, block *pb.BeaconBlock) {
 	}
 }
 
-// processBatchedBlocks processes all the received blocks from
-// the p2p message.
+// processBatchedBlocks records the blocks received from a peer for the range
+// requested from it, and processes every range which is next in slot order.
 func (s *InitialSync) processBatchedBlocks(msg p2p.Message) {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.initial-sync.processBatchedBlocks")
 	defer span.End()
 	batchedBlockReq.Inc()
 
-	response := msg.Data.(*pb.BatchedBeaconBlockResponse)
-	batchedBlocks := response.BatchedBlocks
-	if len(batchedBlocks) == 0 {
-		// Do not process empty responses.
+	if s.ranges == nil {
+		log.WithField("peerID", msg.Peer.Pretty()).Debug("Received batch blocks before requesting any")
 		return
 	}
-	if msg.Peer != s.bestPeer {
-		// Only process batch block responses that come from the best peer
-		// we originally synced with.
-		log.WithField("peerID", msg.Peer.Pretty()).Debug("Received batch blocks from a different peer")
+	response := msg.Data.(*pb.BatchedBeaconBlockResponse)
+	if _, err := s.ranges.receive(msg.Peer, response.BatchedBlocks); err != nil {
+		if err == errUnrequestedRange {
+			// The range may have timed out and been requested from another peer.
+			log.WithField("peerID", msg.Peer.Pretty()).Debug("Received batch blocks which were not requested")
+			return
+		}
+		failedBatchedBlockReq.Inc()
+		log.WithError(err).WithField("peerID", msg.Peer.Pretty()).Debug("Requesting blocks from another peer")
+		// An empty range may be made only of skipped slots, so only an invalid
+		// batch is penalized.
+		if err == errInvalidRange {
+			s.p2p.ReportPeer(msg.Peer, p2p.ScoreInvalidBlock)
+		}
+		s.dispatchBlockRanges()
 		return
 	}
 
 	log.Debug("Processing batched block response")
-	for _, block := range batchedBlocks {
-		s.processBlock(ctx, block)
-	}
+	s.processBlockRanges(ctx)
 	log.Debug("Finished processing batched blocks")
+	s.dispatchBlockRanges()
+}
+
+// processBlockRanges saves the blocks of the received ranges in slot order. If a
+// block fails processing, the peer which sent it is penalized and the rest of its
+// range is requested from another peer. A block whose parent is unknown means a
+// peer left out blocks, so the slots after the last saved block are requested
+// again, and the peer which served them is penalized.
+func (s *InitialSync) processBlockRanges(ctx context.Context) {
+	for !s.nodeIsSynced {
+		req, blocks, ok := s.ranges.next()
+		if !ok {
+			return
+		}
+		for _, block := range blocks {
+			err := s.saveRangeBlock(ctx, block)
+			if err == nil {
+				s.ranges.settle(s.currentSlot + 1)
+				continue
+			}
+			failedBatchedBlockReq.Inc()
+			slot, offender := block.Slot, req
+			if err == errParentUnknown {
+				slot = s.currentSlot + 1
+				if gap := s.ranges.servedBy(slot); gap != nil {
+					offender = gap
+				}
+			}
+			log.WithError(err).WithFields(logrus.Fields{
+				"peerID": offender.peer.Pretty(),
+				"slot":   slot - params.BeaconConfig().GenesisSlot,
+			}).Error("Could not save batched block, requesting blocks from another peer")
+			s.p2p.ReportPeer(offender.peer, p2p.ScoreInvalidBlock)
+			s.ranges.retryFrom(req, slot, offender.peer)
+			return
+		}
+		s.p2p.ReportPeer(req.peer, p2p.ScoreValidMessage)
+	}
+}
+
+func (s *InitialSync) saveRangeBlock(ctx context.Context, block *pb.BeaconBlock) error {
+	recBlock.Inc()
+	if block.Slot <= s.currentSlot {
+		// The block was already received on its own.
+		return nil
+	}
+	if !s.doesParentExist(block) {
+		return errParentUnknown
+	}
+	if block.Slot == s.highestObservedSlot {
+		s.currentSlot = s.highestObservedSlot
+		return s.exitInitialSync(s.ctx, block)
+	}
+	if err := s.validateAndSaveNextBlock(ctx, block); err != nil && !strings.HasPrefix(err.Error(), debugError) {
+		return err
+	}
+	return nil
+}
+
+// requestBlockRanges requests the blocks from the slot after the current one up
+// to the highest observed slot, in ranges spread across the peers.
+func (s *InitialSync) requestBlockRanges() {
+	s.ranges = newRangeScheduler(s.currentSlot+1, s.highestObservedSlot, s.rangeSize, s.rangeTimeout)
+	s.dispatchBlockRanges()
+}
+
+// checkBlockRanges penalizes the peers which did not answer a request in time,
+// and requests their ranges from other peers.
+func (s *InitialSync) checkBlockRanges() {
+	if s.ranges == nil {
+		return
+	}
+	for _, req := range s.ranges.expire(time.Now()) {
+		failedBatchedBlockReq.Inc()
+		log.WithField("peerID", req.peer.Pretty()).Debug("Batched block request timed out")
+		s.p2p.ReportPeer(req.peer, p2p.ScoreUnfulfilledRequest)
+	}
+	s.dispatchBlockRanges()
 }
 
-// requestBatchedBlocks sends out a request for multiple blocks till a
-// specified bound slot number.
-func (s *InitialSync) requestBatchedBlocks(startSlot uint64, endSlot uint64) {
-	ctx, span := trace.StartSpan(context.Background(), "beacon-chain.sync.initial-sync.requestBatchedBlocks")
+// dispatchBlockRanges sends a batched block request to each idle peer.
+func (s *InitialSync) dispatchBlockRanges() {
+	ctx, span := trace.StartSpan(context.Background(), "beacon-chain.sync.initial-sync.dispatchBlockRanges")
 	defer span.End()
-	sentBatchedBlockReq.Inc()
-	if startSlot > endSlot {
+	for _, req := range s.ranges.assign(s.syncPeers(), time.Now()) {
+		sentBatchedBlockReq.Inc()
 		log.WithFields(logrus.Fields{
-			"slotSlot": startSlot - params.BeaconConfig().GenesisSlot,
-			"endSlot":  endSlot - params.BeaconConfig().GenesisSlot},
-		).Debug("Invalid batched block request")
-		return
+			"peerID":    req.peer.Pretty(),
+			"startSlot": req.start - params.BeaconConfig().GenesisSlot,
+			"endSlot":   req.end - params.BeaconConfig().GenesisSlot,
+		}).Debug("Requesting batched blocks")
+		if err := s.p2p.Send(ctx, &pb.BatchedBeaconBlockRequest{
+			StartSlot: req.start,
+			EndSlot:   req.end,
+		}, req.peer); err != nil {
+			log.Errorf("Could not send batch block request to peer %s: %v", req.peer.Pretty(), err)
+			s.ranges.cancel(req)
+		}
 	}
-	blockLimit := params.BeaconConfig().BatchBlockLimit
-	if startSlot+blockLimit < endSlot {
-		endSlot = startSlot + blockLimit
+}
+
+// syncPeers returns the head slot of each peer blocks can be requested from,
+// falling back to the best peer if no peer status is known.
+func (s *InitialSync) syncPeers() map[peer.ID]uint64 {
+	peers := make(map[peer.ID]uint64)
+	if s.peerStatuses != nil {
+		for pid, status := range s.peerStatuses.All() {
+			peers[pid] = status.HeadSlot
+		}
 	}
-	log.WithFields(logrus.Fields{
-		"slotSlot": startSlot - params.BeaconConfig().GenesisSlot,
-		"endSlot":  endSlot - params.BeaconConfig().GenesisSlot},
-	).Debug("Requesting batched blocks")
-	if err := s.p2p.Send(ctx, &pb.BatchedBeaconBlockRequest{
-		StartSlot: startSlot,
-		EndSlot:   endSlot,
-	}, s.bestPeer); err != nil {
-		log.Errorf("Could not send batch block request to peer %s: %v", s.bestPeer.Pretty(), err)
+	if len(peers) == 0 {
+		peers[s.bestPeer] = s.highestObservedSlot
 	}
+	return peers
 }
 
 // validateAndSaveNextBlock will validate whether blocks received from the blockfetcher
-// routine can be added to the chain.
+// routine can be added to the chain. The current slot only moves past the block once
+// its state transition succeeded, and a block which fails it is deleted from the db,
+// so the block can be requested again and replaced by a valid one.
 func (s *InitialSync) validateAndSaveNextBlock(ctx context.Context, block *pb.BeaconBlock) error {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.initial-sync.validateAndSaveNextBlock")
 	defer span.End()
@@ -144,7 +246,6 @@ func (s *InitialSync) validateAndSaveNextBlock(ctx context.Context, block *pb.Be
 		"root": fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
 		"slot": block.Slot - params.BeaconConfig().GenesisSlot,
 	}).Info("Saving block")
-	s.currentSlot = block.Slot
 
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -171,10 +272,18 @@ func (s *InitialSync) validateAndSaveNextBlock(ctx context.Context, block *pb.Be
 	}
 	state, err = s.chainService.ApplyBlockStateTransition(ctx, block, state)
 	if err != nil {
+		if err := s.db.DeleteBlock(block); err != nil {
+			return fmt.Errorf("could not delete bad block from db: %v", err)
+		}
 		return fmt.Errorf("could not apply block state transition: %v", err)
 	}
 	if err := s.chainService.CleanupBlockOperations(ctx, block); err != nil {
 		return err
 	}
-	return s.db.UpdateChainHead(ctx, block, state)
+	if err := s.db.UpdateChainHead(ctx, block, state); err != nil {
+		return err
+	}
+	s.currentSlot = block.Slot
+	s.saveProgress(ctx, block.Slot, root)
+	return nil
 }
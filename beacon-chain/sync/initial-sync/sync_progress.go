@@ -0,0 +1,107 @@
+package initialsync
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// saveProgress persists the last block processed by initial sync along with the
+// head of the peer synced with, so a restarted node resumes from there.
+func (s *InitialSync) saveProgress(ctx context.Context, slot uint64, root [32]byte) {
+	if err := s.db.SaveSyncProgress(ctx, &db.SyncProgress{
+		ProcessedSlot: slot,
+		ProcessedRoot: root,
+		TargetSlot:    s.HighestObservedSlot(),
+	}); err != nil {
+		log.Errorf("Could not save sync progress: %v", err)
+	}
+}
+
+// resumeSync continues an initial sync interrupted by a restart from the chain
+// head, after replaying the blocks stored past it. It returns false if there is
+// no sync to resume, in which case the sync starts from the finalized state.
+func (s *InitialSync) resumeSync(ctx context.Context) bool {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.initial-sync.resumeSync")
+	defer span.End()
+
+	progress, err := s.db.SyncProgress(ctx)
+	if err != nil {
+		log.Errorf("Could not retrieve sync progress: %v", err)
+		return false
+	}
+	if progress == nil {
+		return false
+	}
+	head, err := s.db.ChainHead()
+	if err != nil {
+		log.Errorf("Could not retrieve chain head: %v", err)
+		return false
+	}
+	// The chain head is past the saved progress if the node stopped between
+	// processing a block and saving the progress.
+	if head.Slot < progress.ProcessedSlot || !s.db.HasBlock(progress.ProcessedRoot) {
+		log.Warn("Sync progress does not match the stored chain, syncing from the finalized state")
+		return false
+	}
+
+	s.stateReceived = true
+	s.currentSlot = head.Slot
+	log.WithFields(logrus.Fields{
+		"processedSlot": progress.ProcessedSlot - params.BeaconConfig().GenesisSlot,
+		"headSlot":      head.Slot - params.BeaconConfig().GenesisSlot,
+		"targetSlot":    progress.TargetSlot - params.BeaconConfig().GenesisSlot,
+	}).Info("Resuming initial sync")
+
+	targetSlot := progress.TargetSlot
+	if s.highestObservedSlot > targetSlot {
+		targetSlot = s.highestObservedSlot
+	}
+	if replayed := s.replayStoredBlocks(ctx, head, targetSlot); replayed > 0 {
+		log.WithField("blocks", replayed).Info("Replayed blocks stored before the restart")
+	}
+	return true
+}
+
+// replayStoredBlocks processes the blocks which were saved to the db but not
+// applied to the chain before the restart, up to the target slot. It stops at the
+// first block which does not extend the chain, and returns the number of blocks
+// replayed.
+func (s *InitialSync) replayStoredBlocks(ctx context.Context, head *pb.BeaconBlock, targetSlot uint64) int {
+	parentRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		log.Errorf("Could not hash chain head: %v", err)
+		return 0
+	}
+	replayed := 0
+	for slot := head.Slot + 1; slot <= targetSlot && !s.nodeIsSynced; slot++ {
+		block, err := s.db.BlockBySlot(ctx, slot)
+		if err != nil {
+			log.Errorf("Could not retrieve stored block: %v", err)
+			return replayed
+		}
+		if block == nil {
+			continue
+		}
+		if bytesutil.ToBytes32(block.ParentRootHash32) != parentRoot {
+			return replayed
+		}
+		if err := s.saveRangeBlock(ctx, block); err != nil {
+			log.Errorf("Could not replay stored block: %v", err)
+			return replayed
+		}
+		parentRoot, err = hashutil.HashBeaconBlock(block)
+		if err != nil {
+			log.Errorf("Could not hash block: %v", err)
+			return replayed
+		}
+		replayed++
+	}
+	return replayed
+}
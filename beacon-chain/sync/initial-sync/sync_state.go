@@ -2,12 +2,14 @@ package initialsync
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
-	"github.com/prysmaticlabs/prysm/shared/hashutil"
-
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
@@ -17,69 +19,70 @@ func (s *InitialSync) processState(msg p2p.Message) {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.initial-sync.processState")
 	defer span.End()
 	data := msg.Data.(*pb.BeaconStateResponse)
-	finalizedState := data.FinalizedState
 	recState.Inc()
 
+	if err := s.saveFinalizedState(ctx, data.FinalizedState); err != nil {
+		log.Error(err)
+	}
+}
+
+// saveFinalizedState saves a finalized state received from a peer as the chain
+// head, and requests the blocks after it.
+func (s *InitialSync) saveFinalizedState(ctx context.Context, finalizedState *pb.BeaconState) error {
 	if err := s.db.SaveFinalizedState(finalizedState); err != nil {
-		log.Errorf("Unable to set received last finalized state in db: %v", err)
-		return
+		return fmt.Errorf("unable to set received last finalized state in db: %v", err)
 	}
 
 	if err := s.db.SaveHistoricalState(ctx, finalizedState); err != nil {
-		log.Errorf("Could not save new historical state: %v", err)
-		return
+		return fmt.Errorf("could not save new historical state: %v", err)
 	}
 
 	if err := s.db.SaveFinalizedBlock(finalizedState.LatestBlock); err != nil {
-		log.Errorf("Could not save finalized block %v", err)
-		return
+		return fmt.Errorf("could not save finalized block: %v", err)
 	}
 
 	if err := s.db.SaveBlock(finalizedState.LatestBlock); err != nil {
-		log.Errorf("Could not save block %v", err)
-		return
+		return fmt.Errorf("could not save block: %v", err)
 	}
 
 	root, err := hashutil.HashBeaconBlock(finalizedState.LatestBlock)
 	if err != nil {
-		log.Errorf("Could not hash finalized block %v", err)
-		return
+		return fmt.Errorf("could not hash finalized block: %v", err)
 	}
 	if err := s.db.SaveAttestationTarget(ctx, &pb.AttestationTarget{
 		Slot:       finalizedState.LatestBlock.Slot,
 		BlockRoot:  root[:],
 		ParentRoot: finalizedState.LatestBlock.ParentRootHash32,
 	}); err != nil {
-		log.Errorf("Could not to save attestation target: %v", err)
-		return
+		return fmt.Errorf("could not save attestation target: %v", err)
 	}
 
 	if err := s.db.SaveJustifiedState(finalizedState); err != nil {
-		log.Errorf("Could not set beacon state for initial sync %v", err)
-		return
+		return fmt.Errorf("could not set beacon state for initial sync: %v", err)
 	}
 
 	if err := s.db.SaveJustifiedBlock(finalizedState.LatestBlock); err != nil {
-		log.Errorf("Could not save finalized block %v", err)
-		return
+		return fmt.Errorf("could not save finalized block: %v", err)
 	}
 
-	exists, _, err := s.powchain.BlockExists(ctx, bytesutil.ToBytes32(finalizedState.LatestEth1Data.BlockHash32))
-	if err != nil {
-		log.Errorf("Unable to get powchain block %v", err)
-	}
+	// Nodes running without a POW chain cannot check the ETH1 block of the state.
+	if s.powchain != nil {
+		exists, _, err := s.powchain.BlockExists(ctx, bytesutil.ToBytes32(finalizedState.LatestEth1Data.BlockHash32))
+		if err != nil {
+			log.Errorf("Unable to get powchain block %v", err)
+		}
 
-	if !exists {
-		log.Error("Latest ETH1 block doesn't exist in the pow chain")
-		return
+		if !exists {
+			return errors.New("latest ETH1 block doesn't exist in the pow chain")
+		}
 	}
 
 	s.db.PrunePendingDeposits(ctx, finalizedState.DepositIndex)
 
 	if err := s.db.UpdateChainHead(ctx, finalizedState.LatestBlock, finalizedState); err != nil {
-		log.Errorf("Could not update chain head: %v", err)
-		return
+		return fmt.Errorf("could not update chain head: %v", err)
 	}
+	s.saveProgress(ctx, finalizedState.LatestBlock.Slot, root)
 
 	validators.InitializeValidatorStore(finalizedState)
 
@@ -91,16 +94,19 @@ func (s *InitialSync) processState(msg p2p.Message) {
 		"Successfully saved beacon state with the last finalized slot: %d",
 		finalizedState.Slot-params.BeaconConfig().GenesisSlot,
 	)
-	s.requestBatchedBlocks(s.currentSlot+1, s.highestObservedSlot)
-	s.lastRequestedSlot = s.highestObservedSlot
+	s.requestBlockRanges()
+	return nil
 }
 
-// requestStateFromPeer requests for the canonical state, finalized state, and justified state from a peer.
-func (s *InitialSync) requestStateFromPeer(ctx context.Context, lastFinalizedRoot [32]byte) error {
+// requestStateFromPeer requests the header of the finalized state from a peer.
+// The state itself is then requested in chunks proven against the tree root
+// announced in the header. Only the header of that peer is accepted.
+func (s *InitialSync) requestStateFromPeer(ctx context.Context, lastFinalizedRoot [32]byte, pid peer.ID) error {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.initial-sync.requestStateFromPeer")
 	defer span.End()
 	stateReq.Inc()
-	return s.p2p.Send(ctx, &pb.BeaconStateRequest{
+	s.stateHeaderPeer = pid
+	return s.p2p.Send(ctx, &pb.BeaconStateHeaderRequest{
 		FinalizedStateRootHash32S: lastFinalizedRoot[:],
-	}, s.bestPeer)
+	}, pid)
 }
@@ -56,6 +56,18 @@ var (
 		Name: "regsync_sent_state",
 		Help: "The number of sent state",
 	})
+	stateHeaderReq = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_state_header_req",
+		Help: "The number of state header requests",
+	})
+	stateChunkReq = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_state_chunk_req",
+		Help: "The number of state chunk requests",
+	})
+	sentStateChunk = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_sent_state_chunk",
+		Help: "The number of sent state chunks",
+	})
 	attestationReq = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "regsync_attestation_req",
 		Help: "The number of received attestation requests",
@@ -84,4 +96,48 @@ var (
 		Name: "regsync_chain_head_sent",
 		Help: "The number of sent chain head responses",
 	})
+	backfilledBlocks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_blocks",
+		Help: "The number of blocks saved below the checkpoint the node was started from",
+	})
+	backfillLowestSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "backfill_lowest_slot",
+		Help: "The slot of the lowest block backfilled below the checkpoint the node was started from",
+	})
+	backfillSentBatchedBlockReq = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_sent_batched_block_req",
+		Help: "The number of batched block requests sent to backfill blocks",
+	})
+	syncingGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "sync_syncing",
+		Help: "Whether the node is syncing with the network, 1 if it is and 0 otherwise",
+	})
+	headSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "sync_head_slot",
+		Help: "The slot of the chain head",
+	})
+	highestObservedSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "sync_highest_observed_slot",
+		Help: "The highest head slot observed from peers",
+	})
+	blocksPerSecondGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "sync_blocks_per_second",
+		Help: "The rate at which the chain head advanced over the last minute, in slots per second",
+	})
+	secondsRemainingGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "sync_seconds_remaining",
+		Help: "The estimated time until the node is synced, in seconds",
+	})
+	rejectedGossip = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "regsync_rejected_gossip",
+		Help: "The number of gossip messages dropped for failing validation",
+	}, []string{"topic"})
+	evictedOrphans = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "regsync_evicted_orphan_blocks",
+		Help: "The number of blocks missing a parent dropped from the orphan pool",
+	}, []string{"reason"})
+	resolvedOrphans = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_resolved_orphan_blocks",
+		Help: "The number of blocks missing a parent processed once their parent was received",
+	})
 )
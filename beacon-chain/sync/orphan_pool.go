@@ -0,0 +1,164 @@
+package sync
+
+import (
+	"sync"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+)
+
+// parentRequestInterval is how long to wait for the parent of an orphan block
+// before requesting it again.
+const parentRequestInterval = 10 * time.Second
+
+// orphanPruneInterval is how often the orphans passed by the finalized slot are
+// expired, so they do not linger while no new orphan arrives.
+const orphanPruneInterval = 30 * time.Second
+
+// orphanBlock is a block whose parent is not yet known, along with the message
+// it was received in. The peer is the one the block was received from, which
+// for gossip is the peer which forwarded it rather than the announced sender.
+type orphanBlock struct {
+	msg    p2p.Message
+	peer   peer.ID
+	root   [32]byte
+	parent [32]byte
+	slot   uint64
+	seq    uint64
+}
+
+// orphanPool holds blocks whose parent is unknown until the parent is processed.
+// The pool is bounded both in total and per peer, so peers sending unrelated
+// blocks cannot grow it without limit, and orphans expire once the finalized
+// slot passes them as they can no longer extend the chain.
+type orphanPool struct {
+	lock       sync.Mutex
+	maxSize    int
+	maxPerPeer int
+	blocks     map[[32]byte]*orphanBlock
+	children   map[[32]byte][][32]byte
+	peerCounts map[peer.ID]int
+	requested  map[[32]byte]time.Time
+	nextSeq    uint64
+}
+
+func newOrphanPool(maxSize int, maxPerPeer int) *orphanPool {
+	return &orphanPool{
+		maxSize:    maxSize,
+		maxPerPeer: maxPerPeer,
+		blocks:     make(map[[32]byte]*orphanBlock),
+		children:   make(map[[32]byte][][32]byte),
+		peerCounts: make(map[peer.ID]int),
+		requested:  make(map[[32]byte]time.Time),
+	}
+}
+
+// add inserts an orphan into the pool. It returns whether the orphan was added,
+// and whether its parent should be requested from the network, which is not the
+// case if the parent is itself an orphan or was requested recently.
+func (p *orphanPool) add(o *orphanBlock, now time.Time) (added bool, requestParent bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.blocks[o.root]; ok {
+		return false, false
+	}
+	if p.peerCounts[o.peer] >= p.maxPerPeer {
+		evictedOrphans.WithLabelValues("peer_quota").Inc()
+		return false, false
+	}
+	if len(p.blocks) >= p.maxSize {
+		// The orphans waiting the longest for their parent are the least likely
+		// to be resolved, so the oldest one is evicted to make room.
+		p.remove(p.oldest())
+		evictedOrphans.WithLabelValues("pool_full").Inc()
+	}
+
+	o.seq = p.nextSeq
+	p.nextSeq++
+	p.blocks[o.root] = o
+	p.children[o.parent] = append(p.children[o.parent], o.root)
+	p.peerCounts[o.peer]++
+	blocksAwaitingProcessingGauge.Set(float64(len(p.blocks)))
+
+	if _, ok := p.blocks[o.parent]; ok {
+		return true, false
+	}
+	if last, ok := p.requested[o.parent]; ok && now.Sub(last) < parentRequestInterval {
+		return true, false
+	}
+	p.requested[o.parent] = now
+	return true, true
+}
+
+// takeChildren removes the orphans whose parent is the given block from the
+// pool and returns them.
+func (p *orphanPool) takeChildren(root [32]byte) []p2p.Message {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	roots := append([][32]byte{}, p.children[root]...)
+	msgs := make([]p2p.Message, 0, len(roots))
+	for _, r := range roots {
+		if o, ok := p.blocks[r]; ok {
+			msgs = append(msgs, o.msg)
+			p.remove(o)
+		}
+	}
+	resolvedOrphans.Add(float64(len(msgs)))
+	return msgs
+}
+
+// prune expires the orphans at or below the finalized slot.
+func (p *orphanPool) prune(finalizedSlot uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, o := range p.blocks {
+		if o.slot <= finalizedSlot {
+			p.remove(o)
+			evictedOrphans.WithLabelValues("expired").Inc()
+		}
+	}
+}
+
+// size returns the number of orphans in the pool.
+func (p *orphanPool) size() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return len(p.blocks)
+}
+
+func (p *orphanPool) oldest() *orphanBlock {
+	var oldest *orphanBlock
+	for _, o := range p.blocks {
+		if oldest == nil || o.seq < oldest.seq {
+			oldest = o
+		}
+	}
+	return oldest
+}
+
+func (p *orphanPool) remove(o *orphanBlock) {
+	delete(p.blocks, o.root)
+	p.peerCounts[o.peer]--
+	if p.peerCounts[o.peer] <= 0 {
+		delete(p.peerCounts, o.peer)
+	}
+
+	siblings := p.children[o.parent]
+	for i, r := range siblings {
+		if r == o.root {
+			siblings = append(siblings[:i], siblings[i+1:]...)
+			break
+		}
+	}
+	if len(siblings) > 0 {
+		p.children[o.parent] = siblings
+	} else {
+		delete(p.children, o.parent)
+		delete(p.requested, o.parent)
+	}
+	blocksAwaitingProcessingGauge.Set(float64(len(p.blocks)))
+}
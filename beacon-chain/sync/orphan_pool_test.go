@@ -0,0 +1,71 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+)
+
+func orphan(root byte, parent byte, slot uint64, pid peer.ID) *orphanBlock {
+	return &orphanBlock{
+		msg:    p2p.Message{Peer: pid},
+		peer:   pid,
+		root:   [32]byte{root},
+		parent: [32]byte{parent},
+		slot:   slot,
+	}
+}
+
+func TestOrphanPool_DeduplicatesParentRequests(t *testing.T) {
+	pool := newOrphanPool(10, 10)
+	now := time.Now()
+
+	if added, request := pool.add(orphan(2, 1, 2, "a"), now); !added || !request {
+		t.Fatalf("Expected the orphan to be added and its parent requested, received %v %v", added, request)
+	}
+	if added, request := pool.add(orphan(3, 1, 3, "b"), now); !added || request {
+		t.Errorf("Expected the parent not to be requested twice, received %v %v", added, request)
+	}
+	if added, request := pool.add(orphan(4, 2, 4, "b"), now); !added || request {
+		t.Errorf("Expected the orphan parent not to be requested, received %v %v", added, request)
+	}
+	if _, request := pool.add(orphan(5, 1, 5, "b"), now.Add(parentRequestInterval)); !request {
+		t.Error("Expected the parent to be requested again after the interval")
+	}
+
+	children := pool.takeChildren([32]byte{1})
+	if len(children) != 3 {
+		t.Fatalf("Expected 3 children, received %d", len(children))
+	}
+	if pool.size() != 1 {
+		t.Errorf("Expected 1 orphan left, received %d", pool.size())
+	}
+}
+
+func TestOrphanPool_EnforcesLimits(t *testing.T) {
+	pool := newOrphanPool(3, 2)
+	now := time.Now()
+
+	pool.add(orphan(1, 0, 10, "a"), now)
+	pool.add(orphan(2, 0, 20, "a"), now)
+	if added, _ := pool.add(orphan(3, 0, 5, "a"), now); added {
+		t.Error("Expected the orphan over the peer quota to be dropped")
+	}
+	pool.add(orphan(4, 0, 30, "b"), now)
+	if added, _ := pool.add(orphan(5, 0, 15, "c"), now); !added {
+		t.Error("Expected the orphan to be added to the full pool")
+	}
+	if _, ok := pool.blocks[[32]byte{1}]; ok {
+		t.Error("Expected the oldest orphan to be evicted")
+	}
+	if added, _ := pool.add(orphan(3, 0, 5, "a"), now); !added {
+		t.Error("Expected the quota of the peer to be freed by the eviction")
+	}
+
+	pool.prune(15)
+	if pool.size() != 1 {
+		t.Errorf("Expected orphans at or below the finalized slot to expire, %d left", pool.size())
+	}
+}
@@ -0,0 +1,41 @@
+package sync
+
+import (
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// peerHeadsInterval is how often peers are asked for their chain head, to keep
+// the heads in the peer status table current after the handshake.
+const peerHeadsInterval = 30 * time.Second
+
+// refreshPeerHeads regularly requests the chain head of the peers, and records
+// the heads they respond with in the peer status table.
+func (ss *Service) refreshPeerHeads() {
+	responseBuf := make(chan p2p.Message, params.BeaconConfig().DefaultBufferSize)
+	sub := ss.p2p.Subscribe(&pb.ChainHeadResponse{}, responseBuf)
+	defer sub.Unsubscribe()
+	ticker := time.NewTicker(peerHeadsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ss.ctx.Done():
+			return
+		case <-ticker.C:
+			ss.p2p.Broadcast(ss.ctx, &pb.ChainHeadRequest{})
+		case msg := <-responseBuf:
+			ss.updatePeerHead(msg)
+		}
+	}
+}
+
+func (ss *Service) updatePeerHead(msg p2p.Message) {
+	response, ok := msg.Data.(*pb.ChainHeadResponse)
+	if !ok {
+		return
+	}
+	ss.peerStatuses.UpdateHead(msg.Peer, response.CanonicalSlot, response.CanonicalStateRootHash32)
+}
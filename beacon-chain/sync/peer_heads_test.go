@@ -0,0 +1,23 @@
+package sync
+
+import (
+	"testing"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+)
+
+func TestUpdatePeerHead_RefreshesPeerStatus(t *testing.T) {
+	ss := &Service{peerStatuses: p2p.NewPeerStatuses()}
+	ss.peerStatuses.Set(peer.ID("a"), &pb.Handshake{HeadSlot: 10})
+
+	ss.updatePeerHead(p2p.Message{
+		Peer: peer.ID("a"),
+		Data: &pb.ChainHeadResponse{CanonicalSlot: 20, CanonicalStateRootHash32: []byte{'a'}},
+	})
+	status, _ := ss.peerStatuses.Get(peer.ID("a"))
+	if status.HeadSlot != 20 {
+		t.Errorf("Expected the head of the peer to be refreshed, received slot %d", status.HeadSlot)
+	}
+}
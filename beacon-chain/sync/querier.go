@@ -3,12 +3,12 @@ package sync
 import (
 	"context"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
-	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
@@ -25,21 +25,20 @@ type powChainService interface {
 }
 
 // QuerierConfig defines the configurable properties of SyncQuerier.
+// PeerStatuses is the table of the chain status peers sent in their handshake,
+// from which the querier picks the peer to sync from.
 type QuerierConfig struct {
-	ResponseBufferSize int
-	P2P                p2pAPI
-	BeaconDB           *db.BeaconDB
-	PowChain           powChainService
-	CurrentHeadSlot    uint64
-	ChainService       chainService
+	P2P             p2pAPI
+	BeaconDB        *db.BeaconDB
+	PowChain        powChainService
+	CurrentHeadSlot uint64
+	ChainService    chainService
+	PeerStatuses    *p2p.PeerStatuses
 }
 
 // DefaultQuerierConfig provides the default configuration for a sync service.
-// ResponseBufferSize determines that buffer size of the `responseBuf` channel.
 func DefaultQuerierConfig() *QuerierConfig {
-	return &QuerierConfig{
-		ResponseBufferSize: params.BeaconConfig().DefaultBufferSize,
-	}
+	return &QuerierConfig{}
 }
 
 // Querier defines the main class in this package.
@@ -53,13 +52,16 @@ type Querier struct {
 	currentHeadSlot           uint64
 	currentStateRoot          []byte
 	currentFinalizedStateRoot [32]byte
-	responseBuf               chan p2p.Message
+	peerStatuses              *p2p.PeerStatuses
 	chainStartBuf             chan time.Time
 	powchain                  powChainService
 	chainStarted              bool
 	atGenesis                 bool
+	bestPeerLock              sync.RWMutex
 	bestPeer                  peer.ID
-	peerMap                   map[peer.ID]uint64
+	// statusWait is how long the querier waits for more peers to handshake
+	// after the first one, to pick the best head among them.
+	statusWait time.Duration
 }
 
 // NewQuerierService constructs a new Sync Querier Service.
@@ -69,7 +71,10 @@ func NewQuerierService(ctx context.Context,
 ) *Querier {
 	ctx, cancel := context.WithCancel(ctx)
 
-	responseBuf := make(chan p2p.Message, cfg.ResponseBufferSize)
+	peerStatuses := cfg.PeerStatuses
+	if peerStatuses == nil {
+		peerStatuses = p2p.NewPeerStatuses()
+	}
 
 	return &Querier{
 		ctx:             ctx,
@@ -77,22 +82,27 @@ func NewQuerierService(ctx context.Context,
 		p2p:             cfg.P2P,
 		db:              cfg.BeaconDB,
 		chainService:    cfg.ChainService,
-		responseBuf:     responseBuf,
+		peerStatuses:    peerStatuses,
 		currentHeadSlot: cfg.CurrentHeadSlot,
 		chainStarted:    false,
 		atGenesis:       true,
 		powchain:        cfg.PowChain,
 		chainStartBuf:   make(chan time.Time, 1),
-		peerMap:         make(map[peer.ID]uint64),
+		statusWait:      10 * time.Second,
 	}
 }
 
 // Start begins the goroutine.
 func (q *Querier) Start() {
-	hasChainStarted, _, err := q.powchain.HasChainStartLogOccurred()
-	if err != nil {
-		queryLog.Errorf("Unable to get current state of the deposit contract %v", err)
-		return
+	// Without a POW chain, the chain starts from the genesis state given to the node.
+	hasChainStarted := q.powchain == nil
+	if q.powchain != nil {
+		var err error
+		hasChainStarted, _, err = q.powchain.HasChainStartLogOccurred()
+		if err != nil {
+			queryLog.Errorf("Unable to get current state of the deposit contract %v", err)
+			return
+		}
 	}
 
 	q.chainStarted = hasChainStarted
@@ -145,19 +155,12 @@ func (q *Querier) listenForStateInitialization() {
 }
 
 func (q *Querier) run() {
-	responseSub := q.p2p.Subscribe(&pb.ChainHeadResponse{}, q.responseBuf)
-	// Ticker so that service will keep on requesting for chain head
-	// until they get a response.
+	// Ticker so that service will keep on checking for peer statuses
+	// until a peer completes its handshake.
 	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
 
-	defer func() {
-		responseSub.Unsubscribe()
-		close(q.responseBuf)
-		ticker.Stop()
-	}()
-
-	log.Info("Polling peers for latest chain head...")
-	hasReceivedResponse := false
+	log.Info("Waiting for the chain status of peers...")
 	var timeout <-chan time.Time
 	for {
 		select {
@@ -165,47 +168,51 @@ func (q *Querier) run() {
 			queryLog.Info("Finished querying state of the network, importing blocks...")
 			return
 		case <-ticker.C:
-			q.RequestLatestHead()
+			// Once the first peer has sent its status, we start a timeout
+			// to let more peers complete their handshake to ensure we get
+			// the best head from our peers.
+			if timeout == nil && q.peerStatuses.Len() > 0 {
+				timeout = time.After(q.statusWait)
+			}
 		case <-timeout:
-			queryLog.WithField("peerID", q.bestPeer.Pretty()).Info("Peer with highest canonical head")
+			q.selectBestPeer()
+			queryLog.WithField("peerID", q.BestPeer().Pretty()).Info("Peer with highest canonical head")
 			queryLog.Infof(
 				"Latest chain head is at slot: %d and state root: %#x",
 				q.currentHeadSlot-params.BeaconConfig().GenesisSlot, q.currentStateRoot,
 			)
 			ticker.Stop()
-			responseSub.Unsubscribe()
 			q.cancel()
-		case msg := <-q.responseBuf:
-			// If this is the first response a node receives, we start
-			// a timeout that will keep listening for more responses over a
-			// certain time interval to ensure we get the best head from our peers.
-			if !hasReceivedResponse {
-				timeout = time.After(10 * time.Second)
-				hasReceivedResponse = true
-			}
-			response := msg.Data.(*pb.ChainHeadResponse)
-			if _, ok := q.peerMap[msg.Peer]; !ok {
-				queryLog.WithFields(logrus.Fields{
-					"peerID":      msg.Peer.Pretty(),
-					"highestSlot": response.CanonicalSlot - params.BeaconConfig().GenesisSlot,
-				}).Info("Received chain head from peer")
-				q.peerMap[msg.Peer] = response.CanonicalSlot
-			}
-			if response.CanonicalSlot > q.currentHeadSlot {
-				q.bestPeer = msg.Peer
-				q.currentHeadSlot = response.CanonicalSlot
-				q.currentStateRoot = response.CanonicalStateRootHash32
-				q.currentFinalizedStateRoot = bytesutil.ToBytes32(response.FinalizedStateRootHash32S)
-			}
 		}
 	}
 }
 
-// RequestLatestHead broadcasts a request for
-// the latest chain head slot and state root to a peer.
-func (q *Querier) RequestLatestHead() {
-	request := &pb.ChainHeadRequest{}
-	q.p2p.Broadcast(context.Background(), request)
+// selectBestPeer picks the peer with the highest head in the peer status table
+// as the peer to sync from, if its head is ahead of the current head.
+func (q *Querier) selectBestPeer() {
+	for pid, status := range q.peerStatuses.All() {
+		queryLog.WithFields(logrus.Fields{
+			"peerID":      pid.Pretty(),
+			"highestSlot": status.HeadSlot - params.BeaconConfig().GenesisSlot,
+		}).Info("Received chain head from peer")
+	}
+	pid, status := q.peerStatuses.BestPeer()
+	if status == nil || status.HeadSlot <= q.currentHeadSlot {
+		return
+	}
+	q.bestPeerLock.Lock()
+	q.bestPeer = pid
+	q.bestPeerLock.Unlock()
+	q.currentHeadSlot = status.HeadSlot
+	q.currentStateRoot = status.HeadStateRootHash32
+	q.currentFinalizedStateRoot = bytesutil.ToBytes32(status.FinalizedStateRootHash32)
+}
+
+// BestPeer returns the peer with the highest head, which the node syncs from.
+func (q *Querier) BestPeer() peer.ID {
+	q.bestPeerLock.RLock()
+	defer q.bestPeerLock.RUnlock()
+	return q.bestPeer
 }
 
 // IsSynced checks if the node is currently synced with the
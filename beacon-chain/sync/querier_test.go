@@ -54,11 +54,10 @@ func TestQuerier_StartStop(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		PowChain:           &afterGenesisPowChain{},
-		BeaconDB:           db,
-		ChainService:       &mockChainService{},
+		P2P:          &mockP2P{},
+		PowChain:     &afterGenesisPowChain{},
+		BeaconDB:     db,
+		ChainService: &mockChainService{},
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 
@@ -85,10 +84,9 @@ func TestListenForStateInitialization_ContextCancelled(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		ChainService:       &mockChainService{},
-		BeaconDB:           db,
+		P2P:          &mockP2P{},
+		ChainService: &mockChainService{},
+		BeaconDB:     db,
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 	exitRoutine := make(chan bool)
@@ -114,10 +112,9 @@ func TestListenForStateInitialization(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		ChainService:       &mockChainService{},
-		BeaconDB:           db,
+		P2P:          &mockP2P{},
+		ChainService: &mockChainService{},
+		BeaconDB:     db,
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 
@@ -132,12 +129,20 @@ func TestListenForStateInitialization(t *testing.T) {
 
 func TestQuerier_ChainReqResponse(t *testing.T) {
 	hook := logTest.NewGlobal()
+	statuses := p2p.NewPeerStatuses()
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		PowChain:           &afterGenesisPowChain{},
+		P2P:          &mockP2P{},
+		PowChain:     &afterGenesisPowChain{},
+		PeerStatuses: statuses,
 	}
 	sq := NewQuerierService(context.Background(), cfg)
+	sq.statusWait = 0
+
+	status := &pb.Handshake{
+		HeadSlot:            1,
+		HeadStateRootHash32: []byte{'a', 'b'},
+	}
+	statuses.Set("peer", status)
 
 	exitRoutine := make(chan bool)
 	go func() {
@@ -145,20 +150,9 @@ func TestQuerier_ChainReqResponse(t *testing.T) {
 		exitRoutine <- true
 	}()
 
-	response := &pb.ChainHeadResponse{
-		CanonicalSlot:            1,
-		CanonicalStateRootHash32: []byte{'a', 'b'},
-	}
-
-	msg := p2p.Message{
-		Data: response,
-	}
-
-	sq.responseBuf <- msg
-
 	expMsg := fmt.Sprintf(
 		"Latest chain head is at slot: %d and state root: %#x",
-		response.CanonicalSlot-params.BeaconConfig().GenesisSlot, response.CanonicalStateRootHash32,
+		status.HeadSlot-params.BeaconConfig().GenesisSlot, status.HeadStateRootHash32,
 	)
 
 	<-exitRoutine
@@ -171,11 +165,10 @@ func TestSyncedInGenesis(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		ChainService:       &mockChainService{},
-		BeaconDB:           db,
-		PowChain:           &genesisPowChain{},
+		P2P:          &mockP2P{},
+		ChainService: &mockChainService{},
+		BeaconDB:     db,
+		PowChain:     &genesisPowChain{},
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 
@@ -195,14 +188,16 @@ func TestSyncedInGenesis(t *testing.T) {
 func TestSyncedInRestarts(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
+	statuses := p2p.NewPeerStatuses()
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		ChainService:       &mockChainService{},
-		BeaconDB:           db,
-		PowChain:           &afterGenesisPowChain{},
+		P2P:          &mockP2P{},
+		ChainService: &mockChainService{},
+		BeaconDB:     db,
+		PowChain:     &afterGenesisPowChain{},
+		PeerStatuses: statuses,
 	}
 	sq := NewQuerierService(context.Background(), cfg)
+	sq.statusWait = 0
 
 	bState := &pb.BeaconState{Slot: 0}
 	blk := &pb.BeaconBlock{Slot: 0}
@@ -216,23 +211,17 @@ func TestSyncedInRestarts(t *testing.T) {
 		t.Fatalf("Could not update chainhead: %v", err)
 	}
 
+	statuses.Set("peer", &pb.Handshake{
+		HeadSlot:            10,
+		HeadStateRootHash32: []byte{'a', 'b'},
+	})
+
 	exitRoutine := make(chan bool)
 	go func() {
 		sq.Start()
 		exitRoutine <- true
 	}()
 
-	response := &pb.ChainHeadResponse{
-		CanonicalSlot:            10,
-		CanonicalStateRootHash32: []byte{'a', 'b'},
-	}
-
-	msg := p2p.Message{
-		Data: response,
-	}
-
-	sq.responseBuf <- msg
-
 	<-exitRoutine
 
 	synced, err := sq.IsSynced()
@@ -244,3 +233,41 @@ func TestSyncedInRestarts(t *testing.T) {
 	}
 	sq.cancel()
 }
+
+func TestQuerier_SelectsBestPeer(t *testing.T) {
+	statuses := p2p.NewPeerStatuses()
+	cfg := &QuerierConfig{
+		P2P:             &mockP2P{},
+		CurrentHeadSlot: 5,
+		PeerStatuses:    statuses,
+	}
+	sq := NewQuerierService(context.Background(), cfg)
+
+	sq.selectBestPeer()
+	if sq.bestPeer != "" || sq.currentHeadSlot != 5 {
+		t.Errorf("Selected peer %q at slot %d without any peer status", sq.bestPeer, sq.currentHeadSlot)
+	}
+
+	statuses.Set("behind", &pb.Handshake{HeadSlot: 4})
+	sq.selectBestPeer()
+	if sq.bestPeer != "" {
+		t.Errorf("Selected peer %q which is behind the current head", sq.bestPeer)
+	}
+
+	finalizedRoot := [32]byte{'c'}
+	statuses.Set("ahead", &pb.Handshake{
+		HeadSlot:                 8,
+		HeadStateRootHash32:      []byte{'a'},
+		FinalizedStateRootHash32: finalizedRoot[:],
+	})
+	sq.selectBestPeer()
+	if sq.bestPeer != "ahead" {
+		t.Errorf("Expected peer ahead, selected %q", sq.bestPeer)
+	}
+	if sq.currentHeadSlot != 8 {
+		t.Errorf("Expected head slot 8, got %d", sq.currentHeadSlot)
+	}
+	if sq.currentFinalizedStateRoot != finalizedRoot {
+		t.Errorf("Expected finalized state root %#x, got %#x", finalizedRoot, sq.currentFinalizedStateRoot)
+	}
+}
@@ -2,8 +2,11 @@ package sync
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -71,9 +74,9 @@ func (rs *RegularSync) receiveBlock(msg p2p.Message) error {
 	return rs.processBlockAndFetchAncestors(ctx, msg)
 }
 
-// processBlockAndFetchAncestors verifies if a block has a child in the pending blocks map - if so, then
+// processBlockAndFetchAncestors verifies if a block has children in the orphan pool - if so, then
 // we recursively call processBlock which applies block state transitions and updates the chain service.
-// At the end of the recursive call, we'll have a block which has no children in the map, and at that point
+// At the end of the recursive call, we'll have a block which has no children in the pool, and at that point
 // we can apply the fork choice rule for ETH 2.0.
 func (rs *RegularSync) processBlockAndFetchAncestors(ctx context.Context, msg p2p.Message) error {
 	block, _, isValid, err := rs.validateAndProcessBlock(ctx, msg)
@@ -84,6 +87,7 @@ func (rs *RegularSync) processBlockAndFetchAncestors(ctx context.Context, msg p2
 	if !isValid {
 		return nil
 	}
+	rs.blockFeed.Send(block)
 
 	blockRoot, err := hashutil.HashBeaconBlock(block)
 	if err != nil {
@@ -95,15 +99,17 @@ func (rs *RegularSync) processBlockAndFetchAncestors(ctx context.Context, msg p2
 		return nil
 	}
 
-	// If the block has a child, we then clear it from the blocks pending processing
-	// and call receiveBlock recursively. The recursive function call will stop once
-	// the block we process no longer has children.
-	if child, ok := rs.hasChild(blockRoot); ok {
-		// We clear the block root from the pending processing map.
-		rs.clearPendingBlock(blockRoot)
-		return rs.processBlockAndFetchAncestors(ctx, child)
+	// If the block has children, we then take them from the orphan pool and
+	// process them recursively. The recursive function call will stop once the
+	// block we process no longer has children. As the pool is bounded, so is the
+	// recursion.
+	var childErr error
+	for _, child := range rs.orphans.takeChildren(blockRoot) {
+		if err := rs.processBlockAndFetchAncestors(ctx, child); err != nil && childErr == nil {
+			childErr = err
+		}
 	}
-	return nil
+	return childErr
 }
 
 func (rs *RegularSync) validateAndProcessBlock(
@@ -114,6 +120,10 @@ func (rs *RegularSync) validateAndProcessBlock(
 
 	response := blockMsg.Data.(*pb.BeaconBlockResponse)
 	block := response.Block
+	if block == nil {
+		rs.p2p.ReportPeer(blockMsg.Peer, p2p.ScoreMalformedMessage)
+		return nil, nil, false, errors.New("received block response without block")
+	}
 	blockRoot, err := hashutil.HashBeaconBlock(block)
 	if err != nil {
 		log.Errorf("Could not hash received block: %v", err)
@@ -154,8 +164,10 @@ func (rs *RegularSync) validateAndProcessBlock(
 	span.AddAttributes(trace.BoolAttribute("hasParent", hasParent))
 
 	if !hasParent {
-		// If we do not have the parent, we insert it into a pending block's map.
-		rs.insertPendingBlock(ctx, parentRoot, blockMsg)
+		// If we do not have the parent, we insert the block into the orphan pool,
+		// after expiring the orphans which can no longer extend the chain.
+		rs.orphans.prune(beaconState.FinalizedEpoch * params.BeaconConfig().SlotsPerEpoch)
+		rs.insertPendingBlock(ctx, block, blockRoot, blockMsg)
 		// We update the last observed slot to the received canonical block's slot.
 		if block.Slot > rs.highestObservedSlot {
 			rs.highestObservedSlot = block.Slot
@@ -171,8 +183,14 @@ func (rs *RegularSync) validateAndProcessBlock(
 	if err != nil {
 		log.Errorf("Could not process beacon block: %v", err)
 		span.AddAttributes(trace.BoolAttribute("invalidBlock", true))
+		// Only a block failing the state transition is known to be invalid,
+		// other errors may be local failures.
+		if _, ok := err.(*blockchain.BlockFailedProcessingErr); ok {
+			rs.p2p.ReportPeer(blockMsg.Peer, p2p.ScoreInvalidBlock)
+		}
 		return nil, nil, false, err
 	}
+	rs.p2p.ReportPeer(blockMsg.Peer, p2p.ScoreValidMessage)
 
 	head, err := rs.db.ChainHead()
 	if err != nil {
@@ -212,28 +230,40 @@ func (rs *RegularSync) validateAndProcessBlock(
 	return block, beaconState, true, nil
 }
 
-func (rs *RegularSync) insertPendingBlock(ctx context.Context, blockRoot [32]byte, blockMsg p2p.Message) {
-	rs.blocksAwaitingProcessingLock.Lock()
-	defer rs.blocksAwaitingProcessingLock.Unlock()
-	// Do not reinsert into the map if block root was previously added.
-	if _, ok := rs.blocksAwaitingProcessing[blockRoot]; ok {
+// pruneOrphans expires the orphans at or below the finalized slot of the head
+// state, as they can no longer extend the chain.
+func (rs *RegularSync) pruneOrphans(ctx context.Context) {
+	if rs.orphans.size() == 0 {
 		return
 	}
-	rs.blocksAwaitingProcessing[blockRoot] = blockMsg
-	blocksAwaitingProcessingGauge.Inc()
-	rs.p2p.Broadcast(ctx, &pb.BeaconBlockRequest{Hash: blockRoot[:]})
-}
-
-func (rs *RegularSync) clearPendingBlock(blockRoot [32]byte) {
-	rs.blocksAwaitingProcessingLock.Lock()
-	defer rs.blocksAwaitingProcessingLock.Unlock()
-	delete(rs.blocksAwaitingProcessing, blockRoot)
-	blocksAwaitingProcessingGauge.Dec()
+	beaconState, err := rs.db.HeadState(ctx)
+	if err != nil {
+		log.Errorf("Could not retrieve head state to prune orphan blocks: %v", err)
+		return
+	}
+	if beaconState == nil {
+		return
+	}
+	rs.orphans.prune(beaconState.FinalizedEpoch * params.BeaconConfig().SlotsPerEpoch)
 }
 
-func (rs *RegularSync) hasChild(blockRoot [32]byte) (p2p.Message, bool) {
-	rs.blocksAwaitingProcessingLock.Lock()
-	defer rs.blocksAwaitingProcessingLock.Unlock()
-	child, ok := rs.blocksAwaitingProcessing[blockRoot]
-	return child, ok
+// insertPendingBlock adds a block whose parent is unknown to the orphan pool, and
+// requests the parent from the network unless it is already awaited.
+func (rs *RegularSync) insertPendingBlock(ctx context.Context, block *pb.BeaconBlock, blockRoot [32]byte, blockMsg p2p.Message) {
+	parentRoot := bytesutil.ToBytes32(block.ParentRootHash32)
+	added, requestParent := rs.orphans.add(&orphanBlock{
+		msg:    blockMsg,
+		peer:   blockMsg.Peer,
+		root:   blockRoot,
+		parent: parentRoot,
+		slot:   block.Slot,
+	}, time.Now())
+	if !added {
+		log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(blockRoot[:]))).
+			Debug("Dropping block missing a parent")
+		return
+	}
+	if requestParent {
+		rs.p2p.Broadcast(ctx, &pb.BeaconBlockRequest{Hash: parentRoot[:]})
+	}
 }
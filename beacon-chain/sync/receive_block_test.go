@@ -3,12 +3,14 @@ package sync
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -136,11 +138,11 @@ func TestReceiveBlock_RecursivelyProcessesChildren(t *testing.T) {
 			t.Fatalf("Could not receive block: %v", err)
 		}
 	}
-	if len(rs.blocksAwaitingProcessing) != len(blocksMissingParent) {
+	if rs.orphans.size() != len(blocksMissingParent) {
 		t.Errorf(
-			"Expected blocks awaiting processing map len = %d, received len = %d",
+			"Expected orphan pool len = %d, received len = %d",
 			len(blocksMissingParent),
-			len(rs.blocksAwaitingProcessing),
+			rs.orphans.size(),
 		)
 	}
 	for _, block := range parents {
@@ -154,7 +156,41 @@ func TestReceiveBlock_RecursivelyProcessesChildren(t *testing.T) {
 			t.Fatalf("Could not receive block: %v", err)
 		}
 	}
-	if len(rs.blocksAwaitingProcessing) > 0 {
-		t.Errorf("Expected blocks awaiting processing map to be empty, received len = %d", len(rs.blocksAwaitingProcessing))
+	if rs.orphans.size() > 0 {
+		t.Errorf("Expected orphan pool to be empty, received len = %d", rs.orphans.size())
+	}
+}
+
+func TestPruneOrphans_ExpiresFinalizedOrphans(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	rsCfg := DefaultRegularSyncConfig()
+	rsCfg.ChainService = &mockChainService{
+		db: db,
+	}
+	rsCfg.BeaconDB = db
+	rsCfg.P2P = &mockP2P{}
+	rs := NewRegularSyncService(context.Background(), rsCfg)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	rs.orphans.add(orphan(1, 0, slotsPerEpoch, "a"), time.Now())
+	rs.orphans.add(orphan(2, 1, slotsPerEpoch+1, "a"), time.Now())
+	rs.pruneOrphans(ctx)
+	if rs.orphans.size() != 2 {
+		t.Fatalf("Expected orphans not to be pruned before chain start, received len = %d", rs.orphans.size())
+	}
+
+	head := &pb.BeaconBlock{Slot: 2 * slotsPerEpoch}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, head, &pb.BeaconState{Slot: head.Slot, FinalizedEpoch: 1}); err != nil {
+		t.Fatal(err)
+	}
+	rs.pruneOrphans(ctx)
+	if rs.orphans.size() != 1 {
+		t.Errorf("Expected the orphan at the finalized slot to be pruned, received len = %d", rs.orphans.size())
 	}
 }
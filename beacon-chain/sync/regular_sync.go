@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"runtime/debug"
 	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
@@ -15,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	statetransfer "github.com/prysmaticlabs/prysm/beacon-chain/sync/state-transfer"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -48,6 +50,7 @@ type p2pAPI interface {
 	p2p.Broadcaster
 	p2p.Sender
 	p2p.Subscriber
+	p2p.PeerReporter
 }
 
 // RegularSync is the gateway and the bridge between the p2p network and the local beacon chain.
@@ -63,32 +66,37 @@ type p2pAPI interface {
 //     *  Drop peers that send invalid data
 //     *  Throttle incoming requests
 type RegularSync struct {
-	ctx                          context.Context
-	cancel                       context.CancelFunc
-	p2p                          p2pAPI
-	chainService                 chainService
-	attsService                  attsService
-	operationsService            operations.OperationFeeds
-	db                           *db.BeaconDB
-	blockAnnouncementFeed        *event.Feed
-	announceBlockBuf             chan p2p.Message
-	blockBuf                     chan p2p.Message
-	blockRequestBySlot           chan p2p.Message
-	blockRequestByHash           chan p2p.Message
-	batchedRequestBuf            chan p2p.Message
-	stateRequestBuf              chan p2p.Message
-	chainHeadReqBuf              chan p2p.Message
-	attestationBuf               chan p2p.Message
-	attestationReqByHashBuf      chan p2p.Message
-	announceAttestationBuf       chan p2p.Message
-	exitBuf                      chan p2p.Message
-	canonicalBuf                 chan *pb.BeaconBlockAnnounce
-	highestObservedSlot          uint64
-	blocksAwaitingProcessing     map[[32]byte]p2p.Message
-	blocksAwaitingProcessingLock sync.RWMutex
-	blockProcessingLock          sync.RWMutex
-	blockAnnouncements           map[uint64][]byte
-	blockAnnouncementsLock       sync.RWMutex
+	ctx                     context.Context
+	cancel                  context.CancelFunc
+	p2p                     p2pAPI
+	chainService            chainService
+	attsService             attsService
+	operationsService       operations.OperationFeeds
+	db                      *db.BeaconDB
+	blockAnnouncementFeed   *event.Feed
+	blockFeed               *event.Feed
+	announceBlockBuf        chan p2p.Message
+	blockBuf                chan p2p.Message
+	blockRequestBySlot      chan p2p.Message
+	blockRequestByHash      chan p2p.Message
+	batchedRequestBuf       chan p2p.Message
+	stateRequestBuf         chan p2p.Message
+	stateHeaderRequestBuf   chan p2p.Message
+	stateChunkRequestBuf    chan p2p.Message
+	chainHeadReqBuf         chan p2p.Message
+	attestationBuf          chan p2p.Message
+	attestationReqByHashBuf chan p2p.Message
+	announceAttestationBuf  chan p2p.Message
+	exitBuf                 chan p2p.Message
+	canonicalBuf            chan *pb.BeaconBlockAnnounce
+	highestObservedSlot     uint64
+	orphans                 *orphanPool
+	blockProcessingLock     sync.RWMutex
+	blockAnnouncements      map[uint64][]byte
+	blockAnnouncementsLock  sync.RWMutex
+	stateSnapshot           *statetransfer.Snapshot
+	stateSnapshotBlockRoot  [32]byte
+	stateSnapshotLock       sync.Mutex
 }
 
 // RegularSyncConfig allows the channel's buffer sizes to be changed.
@@ -99,6 +107,8 @@ type RegularSyncConfig struct {
 	BlockReqHashBufferSize      int
 	BatchedBufferSize           int
 	StateReqBufferSize          int
+	StateHeaderReqBufferSize    int
+	StateChunkReqBufferSize     int
 	AttestationBufferSize       int
 	AttestationReqHashBufSize   int
 	AttestationsAnnounceBufSize int
@@ -110,6 +120,8 @@ type RegularSyncConfig struct {
 	AttsService                 attsService
 	BeaconDB                    *db.BeaconDB
 	P2P                         p2pAPI
+	OrphanPoolSize              int
+	OrphanPeerQuota             int
 }
 
 // DefaultRegularSyncConfig provides the default configuration for a sync service.
@@ -121,12 +133,16 @@ func DefaultRegularSyncConfig() *RegularSyncConfig {
 		BlockReqHashBufferSize:      params.BeaconConfig().DefaultBufferSize,
 		BatchedBufferSize:           params.BeaconConfig().DefaultBufferSize,
 		StateReqBufferSize:          params.BeaconConfig().DefaultBufferSize,
+		StateHeaderReqBufferSize:    params.BeaconConfig().DefaultBufferSize,
+		StateChunkReqBufferSize:     params.BeaconConfig().DefaultBufferSize,
 		ChainHeadReqBufferSize:      params.BeaconConfig().DefaultBufferSize,
 		AttestationBufferSize:       params.BeaconConfig().DefaultBufferSize,
 		AttestationReqHashBufSize:   params.BeaconConfig().DefaultBufferSize,
 		AttestationsAnnounceBufSize: params.BeaconConfig().DefaultBufferSize,
 		ExitBufferSize:              params.BeaconConfig().DefaultBufferSize,
 		CanonicalBufferSize:         params.BeaconConfig().DefaultBufferSize,
+		OrphanPoolSize:              int(4 * params.BeaconConfig().SlotsPerEpoch),
+		OrphanPeerQuota:             int(params.BeaconConfig().SlotsPerEpoch),
 	}
 }
 
@@ -134,28 +150,31 @@ func DefaultRegularSyncConfig() *RegularSyncConfig {
 func NewRegularSyncService(ctx context.Context, cfg *RegularSyncConfig) *RegularSync {
 	ctx, cancel := context.WithCancel(ctx)
 	return &RegularSync{
-		ctx:                      ctx,
-		cancel:                   cancel,
-		p2p:                      cfg.P2P,
-		chainService:             cfg.ChainService,
-		db:                       cfg.BeaconDB,
-		operationsService:        cfg.OperationService,
-		attsService:              cfg.AttsService,
-		blockAnnouncementFeed:    new(event.Feed),
-		announceBlockBuf:         make(chan p2p.Message, cfg.BlockAnnounceBufferSize),
-		blockBuf:                 make(chan p2p.Message, cfg.BlockBufferSize),
-		blockRequestBySlot:       make(chan p2p.Message, cfg.BlockReqSlotBufferSize),
-		blockRequestByHash:       make(chan p2p.Message, cfg.BlockReqHashBufferSize),
-		batchedRequestBuf:        make(chan p2p.Message, cfg.BatchedBufferSize),
-		stateRequestBuf:          make(chan p2p.Message, cfg.StateReqBufferSize),
-		attestationBuf:           make(chan p2p.Message, cfg.AttestationBufferSize),
-		attestationReqByHashBuf:  make(chan p2p.Message, cfg.AttestationReqHashBufSize),
-		announceAttestationBuf:   make(chan p2p.Message, cfg.AttestationsAnnounceBufSize),
-		exitBuf:                  make(chan p2p.Message, cfg.ExitBufferSize),
-		chainHeadReqBuf:          make(chan p2p.Message, cfg.ChainHeadReqBufferSize),
-		canonicalBuf:             make(chan *pb.BeaconBlockAnnounce, cfg.CanonicalBufferSize),
-		blocksAwaitingProcessing: make(map[[32]byte]p2p.Message),
-		blockAnnouncements:       make(map[uint64][]byte),
+		ctx:                     ctx,
+		cancel:                  cancel,
+		p2p:                     cfg.P2P,
+		chainService:            cfg.ChainService,
+		db:                      cfg.BeaconDB,
+		operationsService:       cfg.OperationService,
+		attsService:             cfg.AttsService,
+		blockAnnouncementFeed:   new(event.Feed),
+		blockFeed:               new(event.Feed),
+		announceBlockBuf:        make(chan p2p.Message, cfg.BlockAnnounceBufferSize),
+		blockBuf:                make(chan p2p.Message, cfg.BlockBufferSize),
+		blockRequestBySlot:      make(chan p2p.Message, cfg.BlockReqSlotBufferSize),
+		blockRequestByHash:      make(chan p2p.Message, cfg.BlockReqHashBufferSize),
+		batchedRequestBuf:       make(chan p2p.Message, cfg.BatchedBufferSize),
+		stateRequestBuf:         make(chan p2p.Message, cfg.StateReqBufferSize),
+		stateHeaderRequestBuf:   make(chan p2p.Message, cfg.StateHeaderReqBufferSize),
+		stateChunkRequestBuf:    make(chan p2p.Message, cfg.StateChunkReqBufferSize),
+		attestationBuf:          make(chan p2p.Message, cfg.AttestationBufferSize),
+		attestationReqByHashBuf: make(chan p2p.Message, cfg.AttestationReqHashBufSize),
+		announceAttestationBuf:  make(chan p2p.Message, cfg.AttestationsAnnounceBufSize),
+		exitBuf:                 make(chan p2p.Message, cfg.ExitBufferSize),
+		chainHeadReqBuf:         make(chan p2p.Message, cfg.ChainHeadReqBufferSize),
+		canonicalBuf:            make(chan *pb.BeaconBlockAnnounce, cfg.CanonicalBufferSize),
+		orphans:                 newOrphanPool(cfg.OrphanPoolSize, cfg.OrphanPeerQuota),
+		blockAnnouncements:      make(map[uint64][]byte),
 	}
 }
 
@@ -182,6 +201,12 @@ func (rs *RegularSync) BlockAnnouncementFeed() *event.Feed {
 	return rs.blockAnnouncementFeed
 }
 
+// BlockFeed returns an event feed processes can subscribe to for
+// incoming p2p blocks which have been successfully processed by the chain service.
+func (rs *RegularSync) BlockFeed() *event.Feed {
+	return rs.blockFeed
+}
+
 // run handles incoming block sync.
 func (rs *RegularSync) run() {
 	announceBlockSub := rs.p2p.Subscribe(&pb.BeaconBlockAnnounce{}, rs.announceBlockBuf)
@@ -190,6 +215,8 @@ func (rs *RegularSync) run() {
 	blockRequestHashSub := rs.p2p.Subscribe(&pb.BeaconBlockRequest{}, rs.blockRequestByHash)
 	batchedBlockRequestSub := rs.p2p.Subscribe(&pb.BatchedBeaconBlockRequest{}, rs.batchedRequestBuf)
 	stateRequestSub := rs.p2p.Subscribe(&pb.BeaconStateRequest{}, rs.stateRequestBuf)
+	stateHeaderRequestSub := rs.p2p.Subscribe(&pb.BeaconStateHeaderRequest{}, rs.stateHeaderRequestBuf)
+	stateChunkRequestSub := rs.p2p.Subscribe(&pb.BeaconStateChunkRequest{}, rs.stateChunkRequestBuf)
 	attestationSub := rs.p2p.Subscribe(&pb.AttestationResponse{}, rs.attestationBuf)
 	attestationReqSub := rs.p2p.Subscribe(&pb.AttestationRequest{}, rs.attestationReqByHashBuf)
 	announceAttestationSub := rs.p2p.Subscribe(&pb.AttestationAnnounce{}, rs.announceAttestationBuf)
@@ -203,6 +230,8 @@ func (rs *RegularSync) run() {
 	defer blockRequestHashSub.Unsubscribe()
 	defer batchedBlockRequestSub.Unsubscribe()
 	defer stateRequestSub.Unsubscribe()
+	defer stateHeaderRequestSub.Unsubscribe()
+	defer stateChunkRequestSub.Unsubscribe()
 	defer chainHeadReqSub.Unsubscribe()
 	defer attestationSub.Unsubscribe()
 	defer attestationReqSub.Unsubscribe()
@@ -210,6 +239,9 @@ func (rs *RegularSync) run() {
 	defer exitSub.Unsubscribe()
 	defer canonicalBlockSub.Unsubscribe()
 
+	pruneTicker := time.NewTicker(orphanPruneInterval)
+	defer pruneTicker.Stop()
+
 	for {
 		select {
 		case <-rs.ctx.Done():
@@ -235,10 +267,16 @@ func (rs *RegularSync) run() {
 			go safelyHandleMessage(rs.handleBatchedBlockRequest, msg)
 		case msg := <-rs.stateRequestBuf:
 			go safelyHandleMessage(rs.handleStateRequest, msg)
+		case msg := <-rs.stateHeaderRequestBuf:
+			go safelyHandleMessage(rs.handleStateHeaderRequest, msg)
+		case msg := <-rs.stateChunkRequestBuf:
+			go safelyHandleMessage(rs.handleStateChunkRequest, msg)
 		case msg := <-rs.chainHeadReqBuf:
 			go safelyHandleMessage(rs.handleChainHeadRequest, msg)
 		case blockAnnounce := <-rs.canonicalBuf:
 			go rs.broadcastCanonicalBlock(rs.ctx, blockAnnounce)
+		case <-pruneTicker.C:
+			go rs.pruneOrphans(rs.ctx)
 		}
 	}
 }
@@ -413,6 +451,10 @@ func (rs *RegularSync) receiveAttestation(msg p2p.Message) error {
 
 	resp := msg.Data.(*pb.AttestationResponse)
 	attestation := resp.Attestation
+	if attestation == nil || attestation.Data == nil {
+		rs.p2p.ReportPeer(msg.Peer, p2p.ScoreInvalidAttestation)
+		return errors.New("received attestation without data")
+	}
 	attestationRoot, err := hashutil.HashProto(attestation)
 	if err != nil {
 		log.Errorf("Could not hash received attestation: %v", err)
@@ -534,13 +576,12 @@ func (rs *RegularSync) handleBatchedBlockRequest(msg p2p.Message) error {
 	finalizedSlot := helpers.StartSlot(bState.FinalizedEpoch)
 
 	currentSlot := block.Slot
-	if currentSlot < startSlot || finalizedSlot > endSlot {
+	if currentSlot < startSlot {
 		log.WithFields(logrus.Fields{
-			"currentSlot":   currentSlot,
-			"startSlot":     startSlot,
-			"endSlot":       endSlot,
-			"finalizedSlot": finalizedSlot},
-		).Debug("invalid batch request: current slot < start slot || finalized slot > end slot")
+			"currentSlot": currentSlot,
+			"startSlot":   startSlot,
+			"endSlot":     endSlot},
+		).Debug("invalid batch request: current slot < start slot")
 		return err
 	}
 
@@ -562,6 +603,15 @@ func (rs *RegularSync) handleBatchedBlockRequest(msg p2p.Message) error {
 			log.Errorf("Unable to retrieve canonical block %v", err)
 			continue
 		}
+		// Blocks up to the finalized slot, including backfilled ones, may only be
+		// stored in the db.
+		if retBlock == nil && i <= finalizedSlot {
+			retBlock, err = rs.db.BlockBySlot(ctx, i)
+			if err != nil {
+				log.Errorf("Unable to retrieve block by slot %v", err)
+				continue
+			}
+		}
 		if retBlock == nil {
 			log.WithField("slot", i).
 				Debug("Canonical block does not exist")
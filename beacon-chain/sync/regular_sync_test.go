@@ -34,6 +34,7 @@ func init() {
 
 type mockP2P struct {
 	sentMsg proto.Message
+	reports []int
 }
 
 func (mp *mockP2P) Subscribe(msg proto.Message, channel chan p2p.Message) event.Subscription {
@@ -48,6 +49,10 @@ func (mp *mockP2P) Send(ctx context.Context, msg proto.Message, peerID peer.ID)
 	return nil
 }
 
+func (mp *mockP2P) ReportPeer(pid peer.ID, delta int) {
+	mp.reports = append(mp.reports, delta)
+}
+
 type mockChainService struct {
 	sFeed *event.Feed
 	cFeed *event.Feed
@@ -117,6 +122,14 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return new(event.Feed)
 }
 
+func (ms *mockOperationService) IncomingProposerSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
+func (ms *mockOperationService) IncomingAttesterSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
 type mockAttestationService struct{}
 
 func (ma *mockAttestationService) IncomingAttestationFeed() *event.Feed {
@@ -236,10 +249,22 @@ func TestProcessBlock_OK(t *testing.T) {
 		Data: responseBlock,
 	}
 
+	blockChan := make(chan *pb.BeaconBlock, 1)
+	sub := ss.BlockFeed().Subscribe(blockChan)
+	defer sub.Unsubscribe()
+
 	if err := ss.receiveBlock(msg); err != nil {
 		t.Error(err)
 	}
 	testutil.AssertLogsContain(t, hook, "Sending newly received block to chain service")
+	select {
+	case block := <-blockChan:
+		if block != data {
+			t.Errorf("Expected processed block %v on block feed, received %v", data, block)
+		}
+	default:
+		t.Error("Expected processed block to be sent on block feed")
+	}
 	hook.Reset()
 }
 
@@ -447,6 +472,31 @@ func TestReceiveAttestation_OK(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Sending newly received attestation to subscribers")
 }
 
+func TestReceiveAttestation_ReportsPeerWithoutData(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	mp := &mockP2P{}
+	cfg := &RegularSyncConfig{
+		ChainService:     &mockChainService{},
+		OperationService: &mockOperationService{},
+		P2P:              mp,
+		BeaconDB:         db,
+	}
+	ss := NewRegularSyncService(context.Background(), cfg)
+
+	msg := p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.AttestationResponse{Attestation: &pb.Attestation{}},
+		Peer: "peer",
+	}
+	if err := ss.receiveAttestation(msg); err == nil {
+		t.Error("Expected an attestation without data to be rejected")
+	}
+	if len(mp.reports) != 1 || mp.reports[0] != p2p.ScoreInvalidAttestation {
+		t.Errorf("Expected the peer to be reported for an invalid attestation, got %v", mp.reports)
+	}
+}
+
 func TestReceiveAttestation_OlderThanPrevEpoch(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ms := &mockChainService{}
@@ -742,3 +792,43 @@ func TestHandleStateReq_OK(t *testing.T) {
 	}
 	testutil.AssertLogsContain(t, hook, "Sending finalized, justified, and canonical states to peer")
 }
+
+func TestHandleBatchedBlockRequest_ServesFinalizedBlocksFromDB(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	if err := db.InitializeState(context.Background(), uint64(time.Now().Unix()), []*pb.Deposit{}, &pb.Eth1Data{}); err != nil {
+		t.Fatalf("could not initialize beacon state to disk: %v", err)
+	}
+	beaconState, err := db.HeadState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState.FinalizedEpoch = params.BeaconConfig().GenesisEpoch + 1
+	finalizedBlock := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 1}
+	if err := db.SaveBlock(finalizedBlock); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(context.Background(), finalizedBlock, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	ss := setupService(db)
+	msg := p2p.Message{
+		Ctx: context.Background(),
+		Data: &pb.BatchedBeaconBlockRequest{
+			StartSlot: params.BeaconConfig().GenesisSlot + 1,
+			EndSlot:   params.BeaconConfig().GenesisSlot + 1,
+		},
+		Peer: "",
+	}
+	if err := ss.handleBatchedBlockRequest(msg); err != nil {
+		t.Fatal(err)
+	}
+	response, ok := ss.p2p.(*mockP2P).sentMsg.(*pb.BatchedBeaconBlockResponse)
+	if !ok {
+		t.Fatal("Expected batched block response to be sent")
+	}
+	if len(response.BatchedBlocks) != 1 || !proto.Equal(response.BatchedBlocks[0], finalizedBlock) {
+		t.Errorf("Expected finalized block in response, received %v", response.BatchedBlocks)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
@@ -20,7 +21,13 @@ type Service struct {
 	RegularSync     *RegularSync
 	InitialSync     *initialsync.InitialSync
 	Querier         *Querier
+	Backfill        *Backfill
+	p2p             p2pAPI
+	peerStatuses    *p2p.PeerStatuses
 	querierFinished bool
+	ctx             context.Context
+	cancel          context.CancelFunc
+	rate            *syncRate
 }
 
 // Config defines the configured services required for sync to work.
@@ -31,6 +38,8 @@ type Config struct {
 	AttsService      attsService
 	OperationService operations.OperationFeeds
 	PowChainService  powChainService
+	FromCheckpoint   bool
+	PeerStatuses     *p2p.PeerStatuses
 }
 
 // NewSyncService creates a new instance of SyncService using the config
@@ -41,12 +50,15 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 	sqCfg.P2P = cfg.P2P
 	sqCfg.PowChain = cfg.PowChainService
 	sqCfg.ChainService = cfg.ChainService
+	sqCfg.PeerStatuses = cfg.PeerStatuses
 
 	isCfg := initialsync.DefaultConfig()
 	isCfg.BeaconDB = cfg.BeaconDB
 	isCfg.P2P = cfg.P2P
 	isCfg.PowChain = cfg.PowChainService
 	isCfg.ChainService = cfg.ChainService
+	isCfg.FromCheckpoint = cfg.FromCheckpoint
+	isCfg.PeerStatuses = cfg.PeerStatuses
 
 	rsCfg := DefaultRegularSyncConfig()
 	rsCfg.ChainService = cfg.ChainService
@@ -55,17 +67,31 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 	rsCfg.AttsService = cfg.AttsService
 	rsCfg.OperationService = cfg.OperationService
 
+	bfCfg := DefaultBackfillConfig()
+	bfCfg.BeaconDB = cfg.BeaconDB
+	bfCfg.P2P = cfg.P2P
+	bfCfg.PeerStatuses = cfg.PeerStatuses
+
+	ctx, cancel := context.WithCancel(ctx)
 	sq := NewQuerierService(ctx, sqCfg)
 	rs := NewRegularSyncService(ctx, rsCfg)
 
-	isCfg.SyncService = rs
+	bf := NewBackfillService(ctx, bfCfg)
+
+	isCfg.SyncService = &resumer{regularSync: rs, backfill: bf}
 	is := initialsync.NewInitialSyncService(ctx, isCfg)
 
 	return &Service{
 		RegularSync:     rs,
 		InitialSync:     is,
 		Querier:         sq,
+		Backfill:        bf,
+		p2p:             cfg.P2P,
+		peerStatuses:    cfg.PeerStatuses,
 		querierFinished: false,
+		ctx:             ctx,
+		cancel:          cancel,
+		rate:            &syncRate{},
 	}
 
 }
@@ -74,11 +100,16 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 func (ss *Service) Start() {
 	slog.Info("Starting service")
 	go ss.run()
+	go ss.updateSyncStatusMetrics()
+	if ss.peerStatuses != nil {
+		go ss.refreshPeerHeads()
+	}
 }
 
 // Stop ends all the currently running routines
 // which are part of the sync service.
 func (ss *Service) Stop() error {
+	defer ss.cancel()
 	err := ss.Querier.Stop()
 	if err != nil {
 		return err
@@ -88,6 +119,11 @@ func (ss *Service) Stop() error {
 	if err != nil {
 		return err
 	}
+
+	err = ss.Backfill.Stop()
+	if err != nil {
+		return err
+	}
 	return ss.RegularSync.Stop()
 }
 
@@ -129,15 +165,36 @@ func (ss *Service) run() {
 
 	// Sets the highest observed slot from querier.
 	ss.InitialSync.InitializeObservedSlot(ss.Querier.currentHeadSlot)
-	ss.InitialSync.InitializeBestPeer(ss.Querier.bestPeer)
+	ss.InitialSync.InitializeBestPeer(ss.Querier.BestPeer())
 	ss.InitialSync.InitializeObservedStateRoot(bytesutil.ToBytes32(ss.Querier.currentStateRoot))
 	// Sets the state root of the highest observed slot.
 	ss.InitialSync.InitializeFinalizedStateRoot(ss.Querier.currentFinalizedStateRoot)
 
+	// Backfills the blocks below the checkpoint the node was started from, if any,
+	// once the node is synced, as backfill and initial sync both request batches
+	// of blocks.
+	ss.Backfill.InitializePeer(ss.Querier.BestPeer())
+
 	if synced {
+		ss.Backfill.Start()
 		ss.RegularSync.Start()
 		return
 	}
 
 	ss.InitialSync.Start()
 }
+
+// resumer starts regular sync and backfill once initial sync completes.
+type resumer struct {
+	regularSync *RegularSync
+	backfill    *Backfill
+}
+
+func (r *resumer) Start() {
+	r.regularSync.Start()
+}
+
+func (r *resumer) ResumeSync() {
+	r.regularSync.ResumeSync()
+	r.backfill.Start()
+}
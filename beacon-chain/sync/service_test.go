@@ -17,8 +17,7 @@ import (
 
 func NotSyncQuerierConfig() *QuerierConfig {
 	return &QuerierConfig{
-		ResponseBufferSize: 100,
-		CurrentHeadSlot:    10,
+		CurrentHeadSlot: 10,
 	}
 }
 
@@ -0,0 +1,319 @@
+// Package statetransfer splits a beacon state into a header and chunks of its
+// lists, such as ranges of the validator registry or balances. Each chunk comes
+// with a merkle branch against the tree-hash of the state announced in the
+// header, so the chunks of a state can be fetched from several peers and each of
+// them verified as it arrives.
+package statetransfer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+const (
+	// RangeElements is the minimum number of list elements requested in a chunk.
+	RangeElements = 1024
+	// maxRangeElements is the maximum number of list elements served in a chunk.
+	maxRangeElements = 4 * RangeElements
+	// maxListLength bounds the length of the lists announced in a state header,
+	// as they are allocated before any chunk is verified.
+	maxListLength = 1 << 22
+)
+
+// ChunkedFields are the lists of the beacon state which are transferred in
+// chunks rather than in the state header.
+var ChunkedFields = []string{
+	"ValidatorRegistry",
+	"ValidatorBalances",
+	"LatestRandaoMixes",
+	"LatestCrosslinks",
+	"LatestBlockRootHash32S",
+	"BatchedBlockRootHash32S",
+	"LatestSlashedBalances",
+	"LatestAttestations",
+	"LatestIndexRootHash32S",
+	"Eth1DataVotes",
+}
+
+var (
+	// ErrUnrequestedChunk is returned for a chunk which is not part of the
+	// state being assembled, or was already received.
+	ErrUnrequestedChunk = errors.New("chunk was not requested")
+	errInvalidHeader    = errors.New("state header does not match its tree root")
+)
+
+// Range identifies a chunk of one of the lists of a state.
+type Range struct {
+	Field string
+	Start uint64
+	Size  uint64
+}
+
+// Snapshot is a state split into a header and the merkle trees over its lists,
+// from which chunks of the state are served.
+type Snapshot struct {
+	ProtoRoot [32]byte
+	TreeRoot  [32]byte
+	state     *pb.BeaconState
+	header    *pb.BeaconStateHeaderResponse
+	trees     map[string]*ssz.ListTree
+}
+
+// NewSnapshot splits a state into its header and the merkle trees over its lists.
+func NewSnapshot(state *pb.BeaconState) (*Snapshot, error) {
+	protoRoot, err := hashutil.HashProto(state)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash state: %v", err)
+	}
+	headerState := *state
+	trees := make(map[string]*ssz.ListTree)
+	lists := make([]*pb.StateList, 0, len(ChunkedFields))
+	for _, name := range ChunkedFields {
+		list := reflect.ValueOf(state).Elem().FieldByName(name)
+		reflect.ValueOf(&headerState).Elem().FieldByName(name).Set(reflect.Zero(list.Type()))
+		if list.Len() == 0 {
+			lists = append(lists, &pb.StateList{Field: name})
+			continue
+		}
+		tree, err := ssz.NewListTree(list.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("could not build merkle tree of %s: %v", name, err)
+		}
+		trees[name] = tree
+		lists = append(lists, &pb.StateList{
+			Field:          name,
+			Length:         uint64(list.Len()),
+			TreeRootHash32: tree.TreeRoot(),
+		})
+	}
+	treeRoot, err := headerRoot(&headerState, lists)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{
+		ProtoRoot: protoRoot,
+		TreeRoot:  treeRoot,
+		state:     state,
+		header: &pb.BeaconStateHeaderResponse{
+			FinalizedStateRootHash32S: protoRoot[:],
+			StateTreeRootHash32:       treeRoot[:],
+			State:                     &headerState,
+			Lists:                     lists,
+		},
+		trees: trees,
+	}, nil
+}
+
+// Header returns the state header announcing the tree root of the state.
+func (s *Snapshot) Header() *pb.BeaconStateHeaderResponse {
+	return s.header
+}
+
+// Chunk returns a range of a list of the state along with its merkle branch.
+func (s *Snapshot) Chunk(r Range) (*pb.BeaconStateChunkResponse, error) {
+	tree, ok := s.trees[r.Field]
+	if !ok {
+		return nil, fmt.Errorf("no chunked list %q in state", r.Field)
+	}
+	if r.Size > maxRangeElements {
+		return nil, fmt.Errorf("range size %d is larger than the maximum %d", r.Size, maxRangeElements)
+	}
+	branch, err := tree.Branch(r.Start, r.Size)
+	if err != nil {
+		return nil, fmt.Errorf("could not build branch: %v", err)
+	}
+	list := reflect.ValueOf(s.state).Elem().FieldByName(r.Field)
+	end := r.Start + r.Size
+	if end > uint64(list.Len()) {
+		end = uint64(list.Len())
+	}
+	partial := &pb.BeaconState{}
+	reflect.ValueOf(partial).Elem().FieldByName(r.Field).Set(list.Slice(int(r.Start), int(end)))
+	return &pb.BeaconStateChunkResponse{
+		StateTreeRootHash32: s.TreeRoot[:],
+		Field:               r.Field,
+		Start:               r.Start,
+		RangeSize:           r.Size,
+		PartialState:        partial,
+		Branch:              branch,
+	}, nil
+}
+
+// Assembler rebuilds a state from its header and chunks, verifying each chunk
+// against the tree root announced in the header. It is not safe for concurrent
+// use.
+type Assembler struct {
+	protoRoot [32]byte
+	treeRoot  [32]byte
+	state     *pb.BeaconState
+	lists     map[string]*pb.StateList
+	missing   map[Range]bool
+}
+
+// NewAssembler verifies a state header against its tree root, and prepares the
+// state it describes to be filled in by chunks. The header must be for the state
+// with the given proto root.
+func NewAssembler(protoRoot [32]byte, header *pb.BeaconStateHeaderResponse) (*Assembler, error) {
+	if bytesutil.ToBytes32(header.FinalizedStateRootHash32S) != protoRoot {
+		return nil, fmt.Errorf("state header is for state %#x, wanted %#x", header.FinalizedStateRootHash32S, protoRoot)
+	}
+	if header.State == nil {
+		return nil, errors.New("state header has no state")
+	}
+	lists := make(map[string]*pb.StateList)
+	for _, list := range header.Lists {
+		lists[list.Field] = list
+	}
+	if len(lists) != len(ChunkedFields) || len(header.Lists) != len(ChunkedFields) {
+		return nil, fmt.Errorf("state header has %d lists, wanted %d", len(header.Lists), len(ChunkedFields))
+	}
+
+	state := proto.Clone(header.State).(*pb.BeaconState)
+	missing := make(map[Range]bool)
+	for _, name := range ChunkedFields {
+		list, ok := lists[name]
+		if !ok {
+			return nil, fmt.Errorf("state header has no list %s", name)
+		}
+		field := reflect.ValueOf(state).Elem().FieldByName(name)
+		if field.Len() != 0 {
+			return nil, fmt.Errorf("state header holds list %s", name)
+		}
+		if list.Length == 0 {
+			continue
+		}
+		if list.Length > maxListLength {
+			return nil, fmt.Errorf("state header list %s has length %d, more than the maximum %d", name, list.Length, maxListLength)
+		}
+		size, err := rangeSize(field.Interface())
+		if err != nil {
+			return nil, err
+		}
+		field.Set(reflect.MakeSlice(field.Type(), int(list.Length), int(list.Length)))
+		for start := uint64(0); start < list.Length; start += size {
+			missing[Range{Field: name, Start: start, Size: size}] = true
+		}
+	}
+
+	treeRoot, err := headerRoot(header.State, header.Lists)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(treeRoot[:], header.StateTreeRootHash32) {
+		return nil, errInvalidHeader
+	}
+	return &Assembler{
+		protoRoot: protoRoot,
+		treeRoot:  treeRoot,
+		state:     state,
+		lists:     lists,
+		missing:   missing,
+	}, nil
+}
+
+// TreeRoot returns the tree root of the state being assembled.
+func (a *Assembler) TreeRoot() [32]byte {
+	return a.treeRoot
+}
+
+// Missing returns the ranges of the state not received yet, in a stable order.
+func (a *Assembler) Missing() []Range {
+	ranges := make([]Range, 0, len(a.missing))
+	for r := range a.missing {
+		ranges = append(ranges, r)
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].Field != ranges[j].Field {
+			return ranges[i].Field < ranges[j].Field
+		}
+		return ranges[i].Start < ranges[j].Start
+	})
+	return ranges
+}
+
+// Complete returns whether every chunk of the state was received.
+func (a *Assembler) Complete() bool {
+	return len(a.missing) == 0
+}
+
+// AddChunk verifies a chunk against the tree root of the state, and copies its
+// elements into the state.
+func (a *Assembler) AddChunk(chunk *pb.BeaconStateChunkResponse) error {
+	if bytesutil.ToBytes32(chunk.StateTreeRootHash32) != a.treeRoot {
+		return ErrUnrequestedChunk
+	}
+	r := Range{Field: chunk.Field, Start: chunk.Start, Size: chunk.RangeSize}
+	if !a.missing[r] {
+		return ErrUnrequestedChunk
+	}
+	if chunk.PartialState == nil {
+		return errors.New("chunk has no partial state")
+	}
+	list := a.lists[r.Field]
+	elems := reflect.ValueOf(chunk.PartialState).Elem().FieldByName(r.Field)
+	if err := ssz.VerifyListRange(elems.Interface(), r.Start, r.Size, list.Length, list.TreeRootHash32, chunk.Branch); err != nil {
+		return fmt.Errorf("could not verify chunk of %s at %d: %v", r.Field, r.Start, err)
+	}
+	dst := reflect.ValueOf(a.state).Elem().FieldByName(r.Field)
+	reflect.Copy(dst.Slice(int(r.Start), int(r.Start)+elems.Len()), elems)
+	delete(a.missing, r)
+	return nil
+}
+
+// State returns the assembled state once every chunk was received, after checking
+// it is the state which was requested.
+func (a *Assembler) State() (*pb.BeaconState, error) {
+	if !a.Complete() {
+		return nil, fmt.Errorf("%d chunks of the state are missing", len(a.missing))
+	}
+	root, err := hashutil.HashProto(a.state)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash state: %v", err)
+	}
+	if root != a.protoRoot {
+		return nil, fmt.Errorf("assembled state has root %#x, wanted %#x", root, a.protoRoot)
+	}
+	return a.state, nil
+}
+
+// headerRoot computes the tree-hash of a state from its header, in which the
+// chunked lists are empty, and the merkle tree roots of the lists.
+func headerRoot(header *pb.BeaconState, lists []*pb.StateList) ([32]byte, error) {
+	fields, err := ssz.FieldHashes(header)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not hash state fields: %v", err)
+	}
+	byName := make(map[string]*pb.StateList)
+	for _, list := range lists {
+		byName[list.Field] = list
+	}
+	for i, f := range fields {
+		// The hash of an empty list in the header is already that of the list.
+		if list, ok := byName[f.Name]; ok && list.Length > 0 {
+			fields[i].Hash = ssz.MixInLength(list.TreeRootHash32, list.Length)
+		}
+	}
+	return ssz.HashFields(fields), nil
+}
+
+// rangeSize returns the number of elements of a list requested in each chunk,
+// which must be a power of two number of leaves of the merkle tree over the list.
+func rangeSize(list interface{}) (uint64, error) {
+	size, err := ssz.ElementsPerLeaf(list)
+	if err != nil {
+		return 0, err
+	}
+	for size < RangeElements {
+		size <<= 1
+	}
+	return size, nil
+}
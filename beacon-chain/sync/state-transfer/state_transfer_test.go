@@ -0,0 +1,145 @@
+package statetransfer
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+func testState(numValidators int) *pb.BeaconState {
+	state := &pb.BeaconState{
+		Slot:              10,
+		FinalizedRoot:     []byte{'f'},
+		LatestRandaoMixes: make([][]byte, 100),
+		LatestCrosslinks:  []*pb.Crosslink{{Epoch: 1}, {Epoch: 2}},
+		Fork:              &pb.Fork{CurrentVersion: 1},
+	}
+	for i := 0; i < numValidators; i++ {
+		state.ValidatorRegistry = append(state.ValidatorRegistry, &pb.Validator{
+			Pubkey:    []byte{byte(i), byte(i >> 8)},
+			ExitEpoch: uint64(i),
+		})
+		state.ValidatorBalances = append(state.ValidatorBalances, uint64(i))
+	}
+	for i := range state.LatestRandaoMixes {
+		state.LatestRandaoMixes[i] = []byte{byte(i)}
+	}
+	return state
+}
+
+func TestSnapshot_TreeRootMatchesTreeHash(t *testing.T) {
+	state := testState(3000)
+	snapshot, err := NewSnapshot(state)
+	if err != nil {
+		t.Fatalf("Could not create snapshot: %v", err)
+	}
+	want, err := ssz.TreeHash(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.TreeRoot != want {
+		t.Errorf("Expected tree root %#x, received %#x", want, snapshot.TreeRoot)
+	}
+	if len(state.ValidatorRegistry) != 3000 {
+		t.Error("Expected the snapshot not to modify the state")
+	}
+}
+
+func TestAssembler_RebuildsStateFromChunks(t *testing.T) {
+	state := testState(3000)
+	snapshot, err := NewSnapshot(state)
+	if err != nil {
+		t.Fatalf("Could not create snapshot: %v", err)
+	}
+	// The header and chunks go through the wire encoding, as between peers.
+	header := &pb.BeaconStateHeaderResponse{}
+	if err := roundTrip(snapshot.Header(), header); err != nil {
+		t.Fatal(err)
+	}
+	assembler, err := NewAssembler(snapshot.ProtoRoot, header)
+	if err != nil {
+		t.Fatalf("Could not verify header: %v", err)
+	}
+	ranges := assembler.Missing()
+	// 3 validator ranges, 3 balance ranges, and one range for each other list.
+	if len(ranges) != 8 {
+		t.Fatalf("Expected 8 ranges, received %d", len(ranges))
+	}
+
+	for _, r := range ranges {
+		chunk, err := snapshot.Chunk(r)
+		if err != nil {
+			t.Fatalf("Could not create chunk %+v: %v", r, err)
+		}
+		received := &pb.BeaconStateChunkResponse{}
+		if err := roundTrip(chunk, received); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := assembler.State(); err == nil {
+			t.Fatal("Expected an incomplete state to be rejected")
+		}
+		if err := assembler.AddChunk(received); err != nil {
+			t.Fatalf("Could not add chunk %+v: %v", r, err)
+		}
+		if err := assembler.AddChunk(received); err != ErrUnrequestedChunk {
+			t.Errorf("Expected %v for a duplicate chunk, received %v", ErrUnrequestedChunk, err)
+		}
+	}
+
+	assembled, err := assembler.State()
+	if err != nil {
+		t.Fatalf("Could not assemble state: %v", err)
+	}
+	if !proto.Equal(assembled, state) {
+		t.Error("Expected the assembled state to equal the original state")
+	}
+}
+
+func TestAssembler_RejectsInvalidChunk(t *testing.T) {
+	snapshot, err := NewSnapshot(testState(100))
+	if err != nil {
+		t.Fatalf("Could not create snapshot: %v", err)
+	}
+	assembler, err := NewAssembler(snapshot.ProtoRoot, snapshot.Header())
+	if err != nil {
+		t.Fatalf("Could not verify header: %v", err)
+	}
+	r := Range{Field: "ValidatorRegistry", Start: 0, Size: RangeElements}
+	chunk, err := snapshot.Chunk(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := proto.Clone(chunk).(*pb.BeaconStateChunkResponse)
+	tampered.PartialState.ValidatorRegistry[5].ExitEpoch = 1000
+	if err := assembler.AddChunk(tampered); err == nil {
+		t.Error("Expected a tampered chunk to be rejected")
+	}
+	if err := assembler.AddChunk(chunk); err != nil {
+		t.Errorf("Could not add chunk: %v", err)
+	}
+}
+
+func TestNewAssembler_RejectsInvalidHeader(t *testing.T) {
+	snapshot, err := NewSnapshot(testState(100))
+	if err != nil {
+		t.Fatalf("Could not create snapshot: %v", err)
+	}
+	header := proto.Clone(snapshot.Header()).(*pb.BeaconStateHeaderResponse)
+	header.State.Slot++
+	if _, err := NewAssembler(snapshot.ProtoRoot, header); err != errInvalidHeader {
+		t.Errorf("Expected %v, received %v", errInvalidHeader, err)
+	}
+	if _, err := NewAssembler([32]byte{'a'}, snapshot.Header()); err == nil {
+		t.Error("Expected a header for another state to be rejected")
+	}
+}
+
+func roundTrip(msg proto.Message, into proto.Message) error {
+	enc, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(enc, into)
+}
@@ -0,0 +1,114 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+
+	statetransfer "github.com/prysmaticlabs/prysm/beacon-chain/sync/state-transfer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"go.opencensus.io/trace"
+)
+
+// handleStateHeaderRequest sends the header of the finalized state, which
+// announces the tree root its chunks are proven against.
+func (rs *RegularSync) handleStateHeaderRequest(msg p2p.Message) error {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.handleStateHeaderRequest")
+	defer span.End()
+	stateHeaderReq.Inc()
+	req, ok := msg.Data.(*pb.BeaconStateHeaderRequest)
+	if !ok {
+		log.Error("Message is of the incorrect type")
+		return errors.New("incoming message is not *pb.BeaconStateHeaderRequest")
+	}
+	snapshot, err := rs.finalizedSnapshot()
+	if err != nil {
+		log.Errorf("Could not split finalized state into chunks: %v", err)
+		return err
+	}
+	if snapshot.ProtoRoot != bytesutil.ToBytes32(req.FinalizedStateRootHash32S) {
+		log.WithField("requested", fmt.Sprintf("%#x", req.FinalizedStateRootHash32S)).
+			Debug("Requested state root is diff than local state root")
+		return nil
+	}
+	log.WithField("stateTreeRoot", fmt.Sprintf("%#x", snapshot.TreeRoot)).Debug("Sending finalized state header to peer")
+	if err := rs.p2p.Send(ctx, snapshot.Header(), msg.Peer); err != nil {
+		log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// handleStateChunkRequest sends a chunk of the finalized state with its merkle
+// branch.
+func (rs *RegularSync) handleStateChunkRequest(msg p2p.Message) error {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.handleStateChunkRequest")
+	defer span.End()
+	stateChunkReq.Inc()
+	req, ok := msg.Data.(*pb.BeaconStateChunkRequest)
+	if !ok {
+		log.Error("Message is of the incorrect type")
+		return errors.New("incoming message is not *pb.BeaconStateChunkRequest")
+	}
+	treeRoot := bytesutil.ToBytes32(req.StateTreeRootHash32)
+	snapshot, err := rs.finalizedSnapshot()
+	if err != nil {
+		log.Errorf("Could not split finalized state into chunks: %v", err)
+		return err
+	}
+	if snapshot.TreeRoot != treeRoot {
+		log.WithField("requested", fmt.Sprintf("%#x", treeRoot)).Debug("Requested state is not the local finalized state")
+		return nil
+	}
+	chunk, err := snapshot.Chunk(statetransfer.Range{
+		Field: req.Field,
+		Start: req.Start,
+		Size:  req.RangeSize,
+	})
+	if err != nil {
+		rs.p2p.ReportPeer(msg.Peer, p2p.ScoreMalformedMessage)
+		return fmt.Errorf("could not create state chunk: %v", err)
+	}
+	defer sentStateChunk.Inc()
+	if err := rs.p2p.Send(ctx, chunk, msg.Peer); err != nil {
+		log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// finalizedSnapshot returns the finalized state split into chunks. The snapshot
+// is cached until the finalized block changes, so serving the finalized state
+// does not reload and hash it for every request.
+func (rs *RegularSync) finalizedSnapshot() (*statetransfer.Snapshot, error) {
+	rs.stateSnapshotLock.Lock()
+	defer rs.stateSnapshotLock.Unlock()
+	fBlock, err := rs.db.FinalizedBlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve finalized block: %v", err)
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(fBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash finalized block: %v", err)
+	}
+	if rs.stateSnapshot != nil && rs.stateSnapshotBlockRoot == blockRoot {
+		return rs.stateSnapshot, nil
+	}
+
+	fState, err := rs.db.FinalizedState()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve finalized state: %v", err)
+	}
+	if fState == nil {
+		return nil, errors.New("no finalized state exists in db")
+	}
+	snapshot, err := statetransfer.NewSnapshot(fState)
+	if err != nil {
+		return nil, err
+	}
+	rs.stateSnapshot = snapshot
+	rs.stateSnapshotBlockRoot = blockRoot
+	return snapshot, nil
+}
@@ -0,0 +1,115 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	statetransfer "github.com/prysmaticlabs/prysm/beacon-chain/sync/state-transfer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+)
+
+func TestHandleStateChunkRequests_ServesFinalizedState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	deposits, _ := setupInitialDeposits(t)
+	if err := db.InitializeState(ctx, uint64(time.Now().Unix()), deposits, &pb.Eth1Data{}); err != nil {
+		t.Fatalf("Failed to initialize state: %v", err)
+	}
+	beaconState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genBlock, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveFinalizedBlock(genBlock); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveFinalizedState(beaconState); err != nil {
+		t.Fatal(err)
+	}
+	stateRoot, err := hashutil.HashProto(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ss := setupService(db)
+	mp := ss.p2p.(*mockP2P)
+	if err := ss.handleStateHeaderRequest(p2p.Message{
+		Ctx:  ctx,
+		Data: &pb.BeaconStateHeaderRequest{FinalizedStateRootHash32S: stateRoot[:]},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	header, ok := mp.sentMsg.(*pb.BeaconStateHeaderResponse)
+	if !ok {
+		t.Fatal("Expected state header to be sent")
+	}
+	assembler, err := statetransfer.NewAssembler(stateRoot, header)
+	if err != nil {
+		t.Fatalf("Could not verify state header: %v", err)
+	}
+	snapshot := ss.stateSnapshot
+	if err := ss.handleStateHeaderRequest(p2p.Message{
+		Ctx:  ctx,
+		Data: &pb.BeaconStateHeaderRequest{FinalizedStateRootHash32S: stateRoot[:]},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if ss.stateSnapshot != snapshot {
+		t.Error("Expected the snapshot to be reused while the finalized block is unchanged")
+	}
+
+	for _, r := range assembler.Missing() {
+		mp.sentMsg = nil
+		treeRoot := assembler.TreeRoot()
+		if err := ss.handleStateChunkRequest(p2p.Message{
+			Ctx: ctx,
+			Data: &pb.BeaconStateChunkRequest{
+				StateTreeRootHash32: treeRoot[:],
+				Field:               r.Field,
+				Start:               r.Start,
+				RangeSize:           r.Size,
+			},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		chunk, ok := mp.sentMsg.(*pb.BeaconStateChunkResponse)
+		if !ok {
+			t.Fatalf("Expected chunk %+v to be sent", r)
+		}
+		if err := assembler.AddChunk(chunk); err != nil {
+			t.Fatalf("Could not add chunk %+v: %v", r, err)
+		}
+	}
+	assembled, err := assembler.State()
+	if err != nil {
+		t.Fatalf("Could not assemble state: %v", err)
+	}
+	if !proto.Equal(assembled, beaconState) {
+		t.Error("Expected the assembled state to equal the finalized state")
+	}
+
+	mp.sentMsg = nil
+	if err := ss.handleStateChunkRequest(p2p.Message{
+		Ctx: ctx,
+		Data: &pb.BeaconStateChunkRequest{
+			StateTreeRootHash32: []byte{'a'},
+			Field:               "ValidatorRegistry",
+			RangeSize:           statetransfer.RangeElements,
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if mp.sentMsg != nil {
+		t.Error("Expected no chunk to be sent for another state")
+	}
+}
@@ -0,0 +1,200 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+var _ = p2p.StatusHandler(&ChainStatus{})
+
+// ChainStatus provides the chain status of the node for p2p handshakes and
+// rejects peers on a different chain or with a conflicting finalized checkpoint.
+// The status is cached, and only computed again once the head or the finalized
+// block changes.
+type ChainStatus struct {
+	db                 *db.BeaconDB
+	lock               sync.Mutex
+	status             *pb.Handshake
+	headStateRoot      [32]byte
+	finalizedBlockRoot [32]byte
+}
+
+// NewChainStatus creates the chain status handler of the beacon chain stored in
+// the given db.
+func NewChainStatus(beaconDB *db.BeaconDB) *ChainStatus {
+	return &ChainStatus{db: beaconDB}
+}
+
+// LocalStatus returns the fork version, genesis time, finalized checkpoint and
+// head of the chain. Before the chain has started, the status is empty.
+func (c *ChainStatus) LocalStatus(ctx context.Context) (*pb.Handshake, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.LocalStatus")
+	defer span.End()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// The head state root is read before the status is computed, so a head
+	// changing meanwhile makes the next call compute the status again.
+	headStateRoot := c.db.HeadStateRoot()
+	if c.status != nil && c.headStateRoot == headStateRoot {
+		finalizedRoot, err := c.finalizedRoot()
+		if err != nil {
+			return nil, err
+		}
+		if finalizedRoot == c.finalizedBlockRoot {
+			span.AddAttributes(trace.BoolAttribute("cached", true))
+			return proto.Clone(c.status).(*pb.Handshake), nil
+		}
+	}
+	status, err := c.computeStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Before the chain has started, there is no status to cache.
+	if status.GenesisTime != 0 {
+		c.status = status
+		c.headStateRoot = headStateRoot
+		c.finalizedBlockRoot = bytesutil.ToBytes32(status.FinalizedRootHash32)
+	}
+	return proto.Clone(status).(*pb.Handshake), nil
+}
+
+func (c *ChainStatus) finalizedRoot() ([32]byte, error) {
+	finalizedBlock, err := c.db.FinalizedBlock()
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not retrieve finalized block: %v", err)
+	}
+	finalizedRoot, err := hashutil.HashBeaconBlock(finalizedBlock)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not hash finalized block: %v", err)
+	}
+	return finalizedRoot, nil
+}
+
+func (c *ChainStatus) computeStatus(ctx context.Context) (*pb.Handshake, error) {
+	headState, err := c.db.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return &pb.Handshake{}, nil
+	}
+	headBlock, err := c.db.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(headBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash head block: %v", err)
+	}
+	headStateRoot := c.db.HeadStateRoot()
+	finalizedRoot, err := c.finalizedRoot()
+	if err != nil {
+		return nil, err
+	}
+	finalizedState, err := c.db.FinalizedState()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve finalized state: %v", err)
+	}
+	finalizedStateRoot, err := hashutil.HashProto(finalizedState)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash finalized state: %v", err)
+	}
+
+	return &pb.Handshake{
+		ForkVersion:              forkutil.ForkVersion(headState.Fork, helpers.CurrentEpoch(headState)),
+		GenesisTime:              headState.GenesisTime,
+		FinalizedRootHash32:      finalizedRoot[:],
+		FinalizedEpoch:           headState.FinalizedEpoch,
+		FinalizedStateRootHash32: finalizedStateRoot[:],
+		HeadRootHash32:           headRoot[:],
+		HeadSlot:                 headBlock.Slot,
+		HeadStateRootHash32:      headStateRoot[:],
+	}, nil
+}
+
+// ValidatePeerStatus returns an error if the peer is on a different chain, or if
+// it finalized a different block than the node at an epoch the node can check.
+// Nodes whose chain has not started yet only check the network of their peers.
+func (c *ChainStatus) ValidatePeerStatus(ctx context.Context, status *pb.Handshake) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.ValidatePeerStatus")
+	defer span.End()
+
+	local, err := c.LocalStatus(ctx)
+	if err != nil {
+		return err
+	}
+	if local.GenesisTime == 0 || status.GenesisTime == 0 {
+		return nil
+	}
+	if status.GenesisTime != local.GenesisTime {
+		return fmt.Errorf("peer is on a chain with genesis time %d, expected %d", status.GenesisTime, local.GenesisTime)
+	}
+	if status.ForkVersion != local.ForkVersion {
+		return fmt.Errorf("peer is on fork version %d, expected %d", status.ForkVersion, local.ForkVersion)
+	}
+
+	// A peer ahead of the node finalized blocks the node cannot check yet.
+	if status.FinalizedEpoch > local.FinalizedEpoch {
+		return nil
+	}
+	finalizedRoot := local.FinalizedRootHash32
+	if status.FinalizedEpoch < local.FinalizedEpoch {
+		finalizedRoot, err = c.canonicalRootAtEpoch(status.FinalizedEpoch)
+		if err != nil {
+			return err
+		}
+		if finalizedRoot == nil {
+			return nil
+		}
+	}
+	if !bytes.Equal(status.FinalizedRootHash32, finalizedRoot) {
+		return fmt.Errorf(
+			"peer finalized block %#x at epoch %d, conflicting with block %#x",
+			status.FinalizedRootHash32,
+			status.FinalizedEpoch-params.BeaconConfig().GenesisEpoch,
+			finalizedRoot,
+		)
+	}
+	return nil
+}
+
+// canonicalRootAtEpoch returns the root of the latest canonical block at or before
+// the start slot of the epoch, which is the block a node finalizes at the epoch. The
+// epoch is below the finalized epoch of the node, so the block is found by walking
+// the parent roots from the finalized block. It returns nil if the node does not
+// store the canonical blocks that far back.
+func (c *ChainStatus) canonicalRootAtEpoch(epoch uint64) ([]byte, error) {
+	block, err := c.db.FinalizedBlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve finalized block: %v", err)
+	}
+	startSlot := helpers.StartSlot(epoch)
+	for block.Slot > startSlot {
+		block, err = c.db.Block(bytesutil.ToBytes32(block.ParentRootHash32))
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve parent block: %v", err)
+		}
+		if block == nil {
+			return nil, nil
+		}
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash block: %v", err)
+	}
+	return root[:], nil
+}
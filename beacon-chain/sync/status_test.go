@@ -0,0 +1,237 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// setupStatusChain stores a chain with a block at genesis, a finalized block at
+// the start of the next epoch and a head block in the epoch after, and returns
+// the roots of the genesis and finalized blocks.
+// setupStatusChain saves a chain whose finalized block sits in the last slot of the
+// genesis epoch, as the first slot of the finalized epoch was skipped, and a fork
+// block at the genesis slot saved after the canonical genesis block.
+func setupStatusChain(t *testing.T, beaconDB *db.BeaconDB) ([32]byte, [32]byte, [32]byte) {
+	ctx := context.Background()
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	fork := &pb.Fork{
+		PreviousVersion: 1,
+		CurrentVersion:  2,
+		Epoch:           params.BeaconConfig().GenesisEpoch + 1,
+	}
+
+	saveBlock := func(block *pb.BeaconBlock, beaconState *pb.BeaconState) [32]byte {
+		if err := beaconDB.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
+		if err := beaconDB.UpdateChainHead(ctx, block, beaconState); err != nil {
+			t.Fatalf("Could not update chain head: %v", err)
+		}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return root
+	}
+	genesisRoot := saveBlock(
+		&pb.BeaconBlock{Slot: genesisSlot},
+		&pb.BeaconState{Slot: genesisSlot, GenesisTime: 100, Fork: fork},
+	)
+	finalizedBlock := &pb.BeaconBlock{Slot: genesisSlot + slotsPerEpoch - 1, ParentRootHash32: genesisRoot[:]}
+	finalizedState := &pb.BeaconState{Slot: finalizedBlock.Slot, GenesisTime: 100, Fork: fork}
+	finalizedRoot := saveBlock(finalizedBlock, finalizedState)
+	forkRoot := saveBlock(
+		&pb.BeaconBlock{Slot: genesisSlot, RandaoReveal: []byte{'f'}},
+		&pb.BeaconState{Slot: genesisSlot, GenesisTime: 100, Fork: fork},
+	)
+	saveBlock(
+		&pb.BeaconBlock{Slot: genesisSlot + 2*slotsPerEpoch + 1, ParentRootHash32: finalizedRoot[:]},
+		&pb.BeaconState{
+			Slot:           genesisSlot + 2*slotsPerEpoch + 1,
+			GenesisTime:    100,
+			Fork:           fork,
+			FinalizedEpoch: params.BeaconConfig().GenesisEpoch + 1,
+		},
+	)
+	if err := beaconDB.SaveFinalizedBlock(finalizedBlock); err != nil {
+		t.Fatalf("Could not save finalized block: %v", err)
+	}
+	if err := beaconDB.SaveFinalizedState(finalizedState); err != nil {
+		t.Fatalf("Could not save finalized state: %v", err)
+	}
+	return genesisRoot, forkRoot, finalizedRoot
+}
+
+func TestChainStatus_LocalStatus(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	cs := NewChainStatus(beaconDB)
+
+	status, err := cs.LocalStatus(context.Background())
+	if err != nil {
+		t.Fatalf("Could not get status before chain start: %v", err)
+	}
+	if status.GenesisTime != 0 || status.HeadRootHash32 != nil {
+		t.Errorf("Expected empty status before chain start, got %v", status)
+	}
+
+	_, _, finalizedRoot := setupStatusChain(t, beaconDB)
+	status, err = cs.LocalStatus(context.Background())
+	if err != nil {
+		t.Fatalf("Could not get status: %v", err)
+	}
+	if status.GenesisTime != 100 {
+		t.Errorf("Expected genesis time 100, got %d", status.GenesisTime)
+	}
+	if status.ForkVersion != 2 {
+		t.Errorf("Expected fork version 2, got %d", status.ForkVersion)
+	}
+	if status.FinalizedEpoch != params.BeaconConfig().GenesisEpoch+1 {
+		t.Errorf("Expected finalized epoch %d, got %d", params.BeaconConfig().GenesisEpoch+1, status.FinalizedEpoch)
+	}
+	if string(status.FinalizedRootHash32) != string(finalizedRoot[:]) {
+		t.Errorf("Expected finalized root %#x, got %#x", finalizedRoot, status.FinalizedRootHash32)
+	}
+	headSlot := params.BeaconConfig().GenesisSlot + 2*params.BeaconConfig().SlotsPerEpoch + 1
+	if status.HeadSlot != headSlot {
+		t.Errorf("Expected head slot %d, got %d", headSlot, status.HeadSlot)
+	}
+
+	cached := cs.status
+	if _, err := cs.LocalStatus(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if cs.status != cached {
+		t.Error("Expected the status to be cached while the head is unchanged")
+	}
+	head := &pb.BeaconBlock{Slot: headSlot + 1}
+	if err := beaconDB.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.UpdateChainHead(context.Background(), head, &pb.BeaconState{
+		Slot:           headSlot + 1,
+		GenesisTime:    100,
+		Fork:           &pb.Fork{},
+		FinalizedEpoch: params.BeaconConfig().GenesisEpoch + 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	status, err = cs.LocalStatus(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.HeadSlot != headSlot+1 {
+		t.Errorf("Expected the status to follow the new head, got head slot %d", status.HeadSlot)
+	}
+}
+
+func TestChainStatus_ValidatePeerStatus(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	cs := NewChainStatus(beaconDB)
+	genesisRoot, forkRoot, finalizedRoot := setupStatusChain(t, beaconDB)
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+
+	tests := []struct {
+		name   string
+		status *pb.Handshake
+		valid  bool
+	}{
+		{
+			name:   "peer before chain start",
+			status: &pb.Handshake{},
+			valid:  true,
+		},
+		{
+			name: "same finalized checkpoint",
+			status: &pb.Handshake{
+				GenesisTime:         100,
+				ForkVersion:         2,
+				FinalizedEpoch:      genesisEpoch + 1,
+				FinalizedRootHash32: finalizedRoot[:],
+			},
+			valid: true,
+		},
+		{
+			name: "different genesis time",
+			status: &pb.Handshake{
+				GenesisTime:         200,
+				ForkVersion:         2,
+				FinalizedEpoch:      genesisEpoch + 1,
+				FinalizedRootHash32: finalizedRoot[:],
+			},
+		},
+		{
+			name: "different fork version",
+			status: &pb.Handshake{
+				GenesisTime:         100,
+				ForkVersion:         1,
+				FinalizedEpoch:      genesisEpoch + 1,
+				FinalizedRootHash32: finalizedRoot[:],
+			},
+		},
+		{
+			name: "conflicting finalized root",
+			status: &pb.Handshake{
+				GenesisTime:         100,
+				ForkVersion:         2,
+				FinalizedEpoch:      genesisEpoch + 1,
+				FinalizedRootHash32: []byte{'a'},
+			},
+		},
+		{
+			name: "older canonical finalized root",
+			status: &pb.Handshake{
+				GenesisTime:         100,
+				ForkVersion:         2,
+				FinalizedEpoch:      genesisEpoch,
+				FinalizedRootHash32: genesisRoot[:],
+			},
+			valid: true,
+		},
+		{
+			name: "older non-canonical finalized root",
+			status: &pb.Handshake{
+				GenesisTime:         100,
+				ForkVersion:         2,
+				FinalizedEpoch:      genesisEpoch,
+				FinalizedRootHash32: finalizedRoot[:],
+			},
+		},
+		{
+			name: "older fork finalized root",
+			status: &pb.Handshake{
+				GenesisTime:         100,
+				ForkVersion:         2,
+				FinalizedEpoch:      genesisEpoch,
+				FinalizedRootHash32: forkRoot[:],
+			},
+		},
+		{
+			name: "peer finalized ahead",
+			status: &pb.Handshake{
+				GenesisTime:         100,
+				ForkVersion:         2,
+				FinalizedEpoch:      genesisEpoch + 2,
+				FinalizedRootHash32: []byte{'a'},
+			},
+			valid: true,
+		},
+	}
+	for _, tt := range tests {
+		err := cs.ValidatePeerStatus(context.Background(), tt.status)
+		if tt.valid && err != nil {
+			t.Errorf("%s: expected peer status to be accepted, got %v", tt.name, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("%s: expected peer status to be rejected", tt.name)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	// syncRateWindow is the period over which the rate of sync is measured.
+	syncRateWindow = time.Minute
+	// syncStatusInterval is how often the sync status metrics are updated.
+	syncStatusInterval = 5 * time.Second
+)
+
+// SyncStatus describes how far the node is from the head of the network.
+type SyncStatus struct {
+	Syncing             bool
+	HeadSlot            uint64
+	HighestObservedSlot uint64
+	BestPeer            peer.ID
+	// BlocksPerSecond is the rate at which the chain head advanced over the
+	// last minute, counting skipped slots.
+	BlocksPerSecond float64
+	// TimeRemaining is the estimated time until the node is synced, or zero if
+	// it is synced or not making progress.
+	TimeRemaining time.Duration
+}
+
+type slotSample struct {
+	time time.Time
+	slot uint64
+}
+
+// syncRate measures how fast the chain head advances. It is sampled by the
+// metrics ticker, and the last measured rate is read by everyone else.
+type syncRate struct {
+	lock    sync.Mutex
+	samples []slotSample
+	rate    float64
+}
+
+// record adds a sample of the head slot, and returns the rate at which the head
+// advanced over the samples in the window, in slots per second.
+func (r *syncRate) record(now time.Time, slot uint64) float64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.samples = append(r.samples, slotSample{time: now, slot: slot})
+	for len(r.samples) > 2 && now.Sub(r.samples[0].time) > syncRateWindow {
+		r.samples = r.samples[1:]
+	}
+	first, last := r.samples[0], r.samples[len(r.samples)-1]
+	elapsed := last.time.Sub(first.time).Seconds()
+	r.rate = 0
+	if elapsed > 0 && last.slot > first.slot {
+		r.rate = float64(last.slot-first.slot) / elapsed
+	}
+	return r.rate
+}
+
+// current returns the rate measured by the last sample.
+func (r *syncRate) current() float64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.rate
+}
+
+// SyncStatus returns how far the node is from the head of the network.
+func (ss *Service) SyncStatus() (*SyncStatus, error) {
+	head, err := ss.Querier.db.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	if head == nil {
+		return nil, errors.New("no chain head exists in db")
+	}
+	status := &SyncStatus{
+		Syncing:             ss.Status() != nil,
+		HeadSlot:            head.Slot,
+		HighestObservedSlot: ss.InitialSync.HighestObservedSlot(),
+		BestPeer:            ss.Querier.BestPeer(),
+		BlocksPerSecond:     ss.rate.current(),
+	}
+	if status.HighestObservedSlot < status.HeadSlot {
+		status.HighestObservedSlot = status.HeadSlot
+	}
+	if status.Syncing && status.BlocksPerSecond > 0 {
+		remaining := float64(status.HighestObservedSlot-status.HeadSlot) / status.BlocksPerSecond
+		status.TimeRemaining = time.Duration(remaining * float64(time.Second))
+	}
+	return status, nil
+}
+
+// updateSyncStatusMetrics regularly exports the sync status to prometheus.
+func (ss *Service) updateSyncStatusMetrics() {
+	ticker := time.NewTicker(syncStatusInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ss.ctx.Done():
+			return
+		case <-ticker.C:
+			head, err := ss.Querier.db.ChainHead()
+			if err != nil {
+				slog.Debugf("Could not retrieve chain head: %v", err)
+				continue
+			}
+			if head != nil {
+				ss.rate.record(time.Now(), head.Slot)
+			}
+			status, err := ss.SyncStatus()
+			if err != nil {
+				slog.Debugf("Could not retrieve sync status: %v", err)
+				continue
+			}
+			if status.Syncing {
+				syncingGauge.Set(1)
+			} else {
+				syncingGauge.Set(0)
+			}
+			headSlotGauge.Set(float64(status.HeadSlot - params.BeaconConfig().GenesisSlot))
+			highestObservedSlotGauge.Set(float64(status.HighestObservedSlot - params.BeaconConfig().GenesisSlot))
+			blocksPerSecondGauge.Set(status.BlocksPerSecond)
+			secondsRemainingGauge.Set(status.TimeRemaining.Seconds())
+		}
+	}
+}
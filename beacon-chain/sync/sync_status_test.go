@@ -0,0 +1,25 @@
+package sync
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSyncRate_MeasuresOverWindow(t *testing.T) {
+	r := &syncRate{}
+	start := time.Unix(1000, 0)
+
+	if rate := r.record(start, 100); rate != 0 {
+		t.Errorf("Expected no rate from a single sample, received %f", rate)
+	}
+	if rate := r.record(start.Add(10*time.Second), 150); rate != 5 {
+		t.Errorf("Expected 5 blocks per second, received %f", rate)
+	}
+	// The first sample falls out of the window.
+	if rate := r.record(start.Add(syncRateWindow+20*time.Second), 170); rate != 20.0/float64(syncRateWindow/time.Second+10) {
+		t.Errorf("Expected the rate since the second sample, received %f", rate)
+	}
+	if rate := r.record(start.Add(syncRateWindow+30*time.Second), 160); rate != 0 {
+		t.Errorf("Expected no rate when the head went back, received %f", rate)
+	}
+}
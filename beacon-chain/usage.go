@@ -49,6 +49,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RelayNode,
 			cmd.P2PPort,
 			cmd.DataDirFlag,
+			cmd.ChainConfigFileFlag,
 			cmd.VerbosityFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingEndpointFlag,
@@ -74,9 +75,19 @@ var appHelpFlagGroups = []flagGroup{
 			utils.NoCustomConfigFlag,
 			utils.DepositContractFlag,
 			utils.Web3ProviderFlag,
+			utils.Web3PollingIntervalFlag,
+			utils.SimulatedETH1Flag,
+			utils.SimulatedETH1BlockIntervalFlag,
+			utils.SimulatedETH1DepositsFlag,
+			utils.InteropGenesisStateFlag,
+			utils.InteropNumValidatorsFlag,
+			utils.InteropGenesisTimeFlag,
 			utils.RPCPort,
 			utils.CertFlag,
 			utils.KeyFlag,
+			utils.CheckpointStateFlag,
+			utils.CheckpointBlockFlag,
+			utils.CheckpointRPCProviderFlag,
 			utils.EnableDBCleanup,
 		},
 	},
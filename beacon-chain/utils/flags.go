@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"time"
+
 	"github.com/urfave/cli"
 )
 
@@ -13,15 +15,51 @@ var (
 	// HTTPWeb3ProviderFlag provides an HTTP access endpoint to an ETH 1.0 RPC.
 	HTTPWeb3ProviderFlag = cli.StringFlag{
 		Name:  "http-web3provider",
-		Usage: "A mainchain web3 provider string http endpoint",
+		Usage: "A mainchain web3 provider string http endpoint. Can be a comma separated list, in which case its endpoints accompany the web3provider endpoints of the same position.",
 		Value: "https://goerli.prylabs.net",
 	}
 	// Web3ProviderFlag defines a flag for a mainchain RPC endpoint.
 	Web3ProviderFlag = cli.StringFlag{
 		Name:  "web3provider",
-		Usage: "A mainchain web3 provider string endpoint. Can either be an IPC file string or a WebSocket endpoint. Cannot be an HTTP endpoint.",
+		Usage: "A mainchain web3 provider string endpoint. Can either be an IPC file string or a WebSocket endpoint. Cannot be an HTTP endpoint. Can be a comma separated list of endpoints in order of preference, to fail over to the next healthy endpoint.",
 		Value: "wss://goerli.prylabs.net/websocket",
 	}
+	// Web3PollingIntervalFlag defines a flag to follow the mainchain by polling its HTTP endpoints.
+	Web3PollingIntervalFlag = cli.DurationFlag{
+		Name:  "web3provider-polling-interval",
+		Usage: "Follow the mainchain by polling the http-web3provider endpoints at the given interval, such as 15s, instead of subscribing to the web3provider endpoints. For providers which only offer HTTP JSON-RPC.",
+	}
+	// SimulatedETH1Flag defines a flag to follow an in-process simulated mainchain.
+	SimulatedETH1Flag = cli.BoolFlag{
+		Name:  "simulated-eth1",
+		Usage: "Follow an in-process simulated mainchain with its own deposit contract instead of a web3 provider, for local testnets. The simulated mainchain does not persist, so the database is cleared at startup.",
+	}
+	// SimulatedETH1BlockIntervalFlag defines a flag for the block interval of the simulated mainchain.
+	SimulatedETH1BlockIntervalFlag = cli.DurationFlag{
+		Name:  "simulated-eth1-block-interval",
+		Usage: "Interval between the blocks of the simulated mainchain.",
+		Value: time.Second,
+	}
+	// SimulatedETH1DepositsFlag defines a flag for the number of deposits submitted to the simulated mainchain.
+	SimulatedETH1DepositsFlag = cli.Uint64Flag{
+		Name:  "simulated-eth1-deposits",
+		Usage: "Number of deposits submitted to the simulated mainchain at startup, for the interop validators with deterministic keys of index 0 up to this number.",
+	}
+	// InteropGenesisStateFlag defines a flag for the file of an interop genesis state.
+	InteropGenesisStateFlag = cli.StringFlag{
+		Name:  "interop-genesis-state",
+		Usage: "File of a genesis state of interop validators with deterministic keys, as written by the interop-genesis command. The beacon chain starts from it instead of the ChainStart log of the deposit contract. Protobuf encoded, or SSZ encoded if the file has the .ssz extension.",
+	}
+	// InteropNumValidatorsFlag defines a flag for the number of validators of a generated interop genesis state.
+	InteropNumValidatorsFlag = cli.Uint64Flag{
+		Name:  "interop-num-validators",
+		Usage: "Number of interop validators with deterministic keys of the genesis state the beacon chain starts from, instead of the ChainStart log of the deposit contract.",
+	}
+	// InteropGenesisTimeFlag defines a flag for the genesis time of a generated interop genesis state.
+	InteropGenesisTimeFlag = cli.Uint64Flag{
+		Name:  "interop-genesis-time",
+		Usage: "Unix genesis time of the interop genesis state, defaults to the current time.",
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = cli.StringFlag{
 		Name:  "deposit-contract",
@@ -43,6 +81,21 @@ var (
 		Name:  "tls-key",
 		Usage: "Key for secure gRPC. Pass this and the tls-cert flag in order to use gRPC securely.",
 	}
+	// CheckpointStateFlag defines a flag for the file of a trusted finalized state to start the node from.
+	CheckpointStateFlag = cli.StringFlag{
+		Name:  "checkpoint-state",
+		Usage: "Trusted finalized beacon state to sync from instead of chain start, protobuf encoded or SSZ encoded if the file has the .ssz extension. Requires the checkpoint-block flag.",
+	}
+	// CheckpointBlockFlag defines a flag for the file of the block of the trusted finalized state.
+	CheckpointBlockFlag = cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "Block of the trusted finalized beacon state given by the checkpoint-state flag, encoded the same way.",
+	}
+	// CheckpointRPCProviderFlag defines a flag for a trusted beacon node RPC endpoint to fetch the finalized state from.
+	CheckpointRPCProviderFlag = cli.StringFlag{
+		Name:  "checkpoint-rpc-provider",
+		Usage: "RPC endpoint of a trusted beacon node to fetch the finalized beacon state and block to sync from instead of chain start.",
+	}
 	// EnableDBCleanup tells the beacon node to automatically clean DB content such as block vote cache.
 	EnableDBCleanup = cli.BoolFlag{
 		Name:  "enable-db-cleanup",
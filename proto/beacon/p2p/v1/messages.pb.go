@@ -40,6 +40,10 @@ const (
 	Topic_ATTESTATION_ANNOUNCE                Topic = 12
 	Topic_ATTESTATION_REQUEST                 Topic = 13
 	Topic_ATTESTATION_RESPONSE                Topic = 14
+	Topic_BEACON_STATE_HEADER_REQUEST         Topic = 15
+	Topic_BEACON_STATE_HEADER_RESPONSE        Topic = 16
+	Topic_BEACON_STATE_CHUNK_REQUEST          Topic = 17
+	Topic_BEACON_STATE_CHUNK_RESPONSE         Topic = 18
 )
 
 var Topic_name = map[int32]string{
@@ -58,6 +62,10 @@ var Topic_name = map[int32]string{
 	12: "ATTESTATION_ANNOUNCE",
 	13: "ATTESTATION_REQUEST",
 	14: "ATTESTATION_RESPONSE",
+	15: "BEACON_STATE_HEADER_REQUEST",
+	16: "BEACON_STATE_HEADER_RESPONSE",
+	17: "BEACON_STATE_CHUNK_REQUEST",
+	18: "BEACON_STATE_CHUNK_RESPONSE",
 }
 
 var Topic_value = map[string]int32{
@@ -76,6 +84,10 @@ var Topic_value = map[string]int32{
 	"ATTESTATION_ANNOUNCE":                12,
 	"ATTESTATION_REQUEST":                 13,
 	"ATTESTATION_RESPONSE":                14,
+	"BEACON_STATE_HEADER_REQUEST":         15,
+	"BEACON_STATE_HEADER_RESPONSE":        16,
+	"BEACON_STATE_CHUNK_REQUEST":          17,
+	"BEACON_STATE_CHUNK_RESPONSE":         18,
 }
 
 func (x Topic) String() string {
@@ -1436,10 +1448,19 @@ func (m *ExitResponse) GetVoluntaryExit() *VoluntaryExit {
 }
 
 type Handshake struct {
-	DepositContractAddress string   `protobuf:"bytes,1,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	DepositContractAddress   string   `protobuf:"bytes,1,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	ConfigHash               []byte   `protobuf:"bytes,2,opt,name=config_hash,json=configHash,proto3" json:"config_hash,omitempty"`
+	ForkVersion              uint64   `protobuf:"varint,3,opt,name=fork_version,json=forkVersion,proto3" json:"fork_version,omitempty"`
+	GenesisTime              uint64   `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	FinalizedRootHash32      []byte   `protobuf:"bytes,5,opt,name=finalized_root_hash32,json=finalizedRootHash32,proto3" json:"finalized_root_hash32,omitempty"`
+	FinalizedEpoch           uint64   `protobuf:"varint,6,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	FinalizedStateRootHash32 []byte   `protobuf:"bytes,7,opt,name=finalized_state_root_hash32,json=finalizedStateRootHash32,proto3" json:"finalized_state_root_hash32,omitempty"`
+	HeadRootHash32           []byte   `protobuf:"bytes,8,opt,name=head_root_hash32,json=headRootHash32,proto3" json:"head_root_hash32,omitempty"`
+	HeadSlot                 uint64   `protobuf:"varint,9,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	HeadStateRootHash32      []byte   `protobuf:"bytes,10,opt,name=head_state_root_hash32,json=headStateRootHash32,proto3" json:"head_state_root_hash32,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *Handshake) Reset()         { *m = Handshake{} }
@@ -1482,6 +1503,412 @@ func (m *Handshake) GetDepositContractAddress() string {
 	return ""
 }
 
+func (m *Handshake) GetConfigHash() []byte {
+	if m != nil {
+		return m.ConfigHash
+	}
+	return nil
+}
+
+func (m *Handshake) GetForkVersion() uint64 {
+	if m != nil {
+		return m.ForkVersion
+	}
+	return 0
+}
+
+func (m *Handshake) GetGenesisTime() uint64 {
+	if m != nil {
+		return m.GenesisTime
+	}
+	return 0
+}
+
+func (m *Handshake) GetFinalizedRootHash32() []byte {
+	if m != nil {
+		return m.FinalizedRootHash32
+	}
+	return nil
+}
+
+func (m *Handshake) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *Handshake) GetFinalizedStateRootHash32() []byte {
+	if m != nil {
+		return m.FinalizedStateRootHash32
+	}
+	return nil
+}
+
+func (m *Handshake) GetHeadRootHash32() []byte {
+	if m != nil {
+		return m.HeadRootHash32
+	}
+	return nil
+}
+
+func (m *Handshake) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *Handshake) GetHeadStateRootHash32() []byte {
+	if m != nil {
+		return m.HeadStateRootHash32
+	}
+	return nil
+}
+
+type BeaconStateHeaderRequest struct {
+	FinalizedStateRootHash32S []byte   `protobuf:"bytes,1,opt,name=finalized_state_root_hash32s,json=finalizedStateRootHash32s,proto3" json:"finalized_state_root_hash32s,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *BeaconStateHeaderRequest) Reset()         { *m = BeaconStateHeaderRequest{} }
+func (m *BeaconStateHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateHeaderRequest) ProtoMessage()    {}
+func (*BeaconStateHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{28}
+}
+func (m *BeaconStateHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconStateHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconStateHeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconStateHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateHeaderRequest.Merge(m, src)
+}
+func (m *BeaconStateHeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconStateHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateHeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconStateHeaderRequest proto.InternalMessageInfo
+
+func (m *BeaconStateHeaderRequest) GetFinalizedStateRootHash32S() []byte {
+	if m != nil {
+		return m.FinalizedStateRootHash32S
+	}
+	return nil
+}
+
+// BeaconStateHeaderResponse announces the tree-hash of a finalized state, along
+// with the state without its lists, which are transferred in chunks.
+type BeaconStateHeaderResponse struct {
+	FinalizedStateRootHash32S []byte       `protobuf:"bytes,1,opt,name=finalized_state_root_hash32s,json=finalizedStateRootHash32s,proto3" json:"finalized_state_root_hash32s,omitempty"`
+	StateTreeRootHash32       []byte       `protobuf:"bytes,2,opt,name=state_tree_root_hash32,json=stateTreeRootHash32,proto3" json:"state_tree_root_hash32,omitempty"`
+	State                     *BeaconState `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Lists                     []*StateList `protobuf:"bytes,4,rep,name=lists,proto3" json:"lists,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}     `json:"-"`
+	XXX_unrecognized          []byte       `json:"-"`
+	XXX_sizecache             int32        `json:"-"`
+}
+
+func (m *BeaconStateHeaderResponse) Reset()         { *m = BeaconStateHeaderResponse{} }
+func (m *BeaconStateHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateHeaderResponse) ProtoMessage()    {}
+func (*BeaconStateHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{29}
+}
+func (m *BeaconStateHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconStateHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconStateHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconStateHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateHeaderResponse.Merge(m, src)
+}
+func (m *BeaconStateHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconStateHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconStateHeaderResponse proto.InternalMessageInfo
+
+func (m *BeaconStateHeaderResponse) GetFinalizedStateRootHash32S() []byte {
+	if m != nil {
+		return m.FinalizedStateRootHash32S
+	}
+	return nil
+}
+
+func (m *BeaconStateHeaderResponse) GetStateTreeRootHash32() []byte {
+	if m != nil {
+		return m.StateTreeRootHash32
+	}
+	return nil
+}
+
+func (m *BeaconStateHeaderResponse) GetState() *BeaconState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *BeaconStateHeaderResponse) GetLists() []*StateList {
+	if m != nil {
+		return m.Lists
+	}
+	return nil
+}
+
+type StateList struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Length               uint64   `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	TreeRootHash32       []byte   `protobuf:"bytes,3,opt,name=tree_root_hash32,json=treeRootHash32,proto3" json:"tree_root_hash32,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateList) Reset()         { *m = StateList{} }
+func (m *StateList) String() string { return proto.CompactTextString(m) }
+func (*StateList) ProtoMessage()    {}
+func (*StateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{30}
+}
+func (m *StateList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateList.Merge(m, src)
+}
+func (m *StateList) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateList proto.InternalMessageInfo
+
+func (m *StateList) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *StateList) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *StateList) GetTreeRootHash32() []byte {
+	if m != nil {
+		return m.TreeRootHash32
+	}
+	return nil
+}
+
+type BeaconStateChunkRequest struct {
+	StateTreeRootHash32  []byte   `protobuf:"bytes,1,opt,name=state_tree_root_hash32,json=stateTreeRootHash32,proto3" json:"state_tree_root_hash32,omitempty"`
+	Field                string   `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Start                uint64   `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	RangeSize            uint64   `protobuf:"varint,4,opt,name=range_size,json=rangeSize,proto3" json:"range_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeaconStateChunkRequest) Reset()         { *m = BeaconStateChunkRequest{} }
+func (m *BeaconStateChunkRequest) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateChunkRequest) ProtoMessage()    {}
+func (*BeaconStateChunkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{31}
+}
+func (m *BeaconStateChunkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconStateChunkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconStateChunkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconStateChunkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateChunkRequest.Merge(m, src)
+}
+func (m *BeaconStateChunkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconStateChunkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateChunkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconStateChunkRequest proto.InternalMessageInfo
+
+func (m *BeaconStateChunkRequest) GetStateTreeRootHash32() []byte {
+	if m != nil {
+		return m.StateTreeRootHash32
+	}
+	return nil
+}
+
+func (m *BeaconStateChunkRequest) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *BeaconStateChunkRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *BeaconStateChunkRequest) GetRangeSize() uint64 {
+	if m != nil {
+		return m.RangeSize
+	}
+	return 0
+}
+
+// BeaconStateChunkResponse holds a range of one list of a state, set in the
+// partial state, and the merkle branch proving it against the list tree root.
+type BeaconStateChunkResponse struct {
+	StateTreeRootHash32  []byte       `protobuf:"bytes,1,opt,name=state_tree_root_hash32,json=stateTreeRootHash32,proto3" json:"state_tree_root_hash32,omitempty"`
+	Field                string       `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Start                uint64       `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	RangeSize            uint64       `protobuf:"varint,4,opt,name=range_size,json=rangeSize,proto3" json:"range_size,omitempty"`
+	PartialState         *BeaconState `protobuf:"bytes,5,opt,name=partial_state,json=partialState,proto3" json:"partial_state,omitempty"`
+	Branch               [][]byte     `protobuf:"bytes,6,rep,name=branch,proto3" json:"branch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BeaconStateChunkResponse) Reset()         { *m = BeaconStateChunkResponse{} }
+func (m *BeaconStateChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateChunkResponse) ProtoMessage()    {}
+func (*BeaconStateChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{32}
+}
+func (m *BeaconStateChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconStateChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconStateChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconStateChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateChunkResponse.Merge(m, src)
+}
+func (m *BeaconStateChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconStateChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateChunkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconStateChunkResponse proto.InternalMessageInfo
+
+func (m *BeaconStateChunkResponse) GetStateTreeRootHash32() []byte {
+	if m != nil {
+		return m.StateTreeRootHash32
+	}
+	return nil
+}
+
+func (m *BeaconStateChunkResponse) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *BeaconStateChunkResponse) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *BeaconStateChunkResponse) GetRangeSize() uint64 {
+	if m != nil {
+		return m.RangeSize
+	}
+	return 0
+}
+
+func (m *BeaconStateChunkResponse) GetPartialState() *BeaconState {
+	if m != nil {
+		return m.PartialState
+	}
+	return nil
+}
+
+func (m *BeaconStateChunkResponse) GetBranch() [][]byte {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.beacon.p2p.v1.Topic", Topic_name, Topic_value)
 	proto.RegisterType((*Envelope)(nil), "ethereum.beacon.p2p.v1.Envelope")
@@ -1512,70 +1939,99 @@ func init() {
 	proto.RegisterType((*ExitRequest)(nil), "ethereum.beacon.p2p.v1.ExitRequest")
 	proto.RegisterType((*ExitResponse)(nil), "ethereum.beacon.p2p.v1.ExitResponse")
 	proto.RegisterType((*Handshake)(nil), "ethereum.beacon.p2p.v1.Handshake")
+	proto.RegisterType((*BeaconStateHeaderRequest)(nil), "ethereum.beacon.p2p.v1.BeaconStateHeaderRequest")
+	proto.RegisterType((*BeaconStateHeaderResponse)(nil), "ethereum.beacon.p2p.v1.BeaconStateHeaderResponse")
+	proto.RegisterType((*StateList)(nil), "ethereum.beacon.p2p.v1.StateList")
+	proto.RegisterType((*BeaconStateChunkRequest)(nil), "ethereum.beacon.p2p.v1.BeaconStateChunkRequest")
+	proto.RegisterType((*BeaconStateChunkResponse)(nil), "ethereum.beacon.p2p.v1.BeaconStateChunkResponse")
 }
 
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/messages.proto", fileDescriptor_a1d590cda035b632) }
 
 var fileDescriptor_a1d590cda035b632 = []byte{
-	// 921 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdf, 0x72, 0xdb, 0x44,
-	0x14, 0xc6, 0x51, 0x93, 0xd4, 0xc9, 0xb1, 0xe3, 0xba, 0x1b, 0x48, 0x94, 0xd0, 0x3a, 0x89, 0x4a,
-	0x86, 0xc0, 0x4c, 0x9d, 0x69, 0x7a, 0x43, 0x2f, 0x18, 0x46, 0x72, 0x34, 0xb8, 0x6d, 0x90, 0x8b,
-	0x6c, 0xc3, 0x70, 0xb5, 0xac, 0xa5, 0xa5, 0xf6, 0xd4, 0xd9, 0x15, 0xda, 0xb5, 0x27, 0xe1, 0x9e,
-	0x67, 0xe0, 0x09, 0x78, 0x09, 0x9e, 0x80, 0x4b, 0x1e, 0x81, 0xc9, 0x93, 0x30, 0x5a, 0xad, 0x1c,
-	0xf9, 0x4f, 0x94, 0x5c, 0x70, 0x17, 0x9d, 0xf3, 0x7d, 0xdf, 0x9e, 0xdf, 0xe6, 0xc8, 0x23, 0xb0,
-	0xa2, 0x98, 0x4b, 0x7e, 0xd2, 0xa7, 0x24, 0xe0, 0xec, 0x24, 0x3a, 0x8d, 0x4e, 0x26, 0x2f, 0x4e,
-	0x2e, 0xa8, 0x10, 0xe4, 0x3d, 0x15, 0x0d, 0xd5, 0x44, 0xdb, 0x54, 0x0e, 0x68, 0x4c, 0xc7, 0x17,
-	0x8d, 0x54, 0xd6, 0x88, 0x4e, 0xa3, 0xc6, 0xe4, 0xc5, 0xde, 0xfe, 0x32, 0xaf, 0xbc, 0x8a, 0x32,
-	0xa3, 0xf5, 0x2d, 0xac, 0xbb, 0x6c, 0x42, 0x47, 0x3c, 0xa2, 0xe8, 0x10, 0x2a, 0x22, 0x22, 0x0c,
-	0x07, 0x9c, 0x49, 0x7a, 0x29, 0x4d, 0xe3, 0xc0, 0x38, 0xae, 0xf8, 0xe5, 0xa4, 0xd6, 0x4c, 0x4b,
-	0xc8, 0x84, 0x52, 0x44, 0xae, 0x46, 0x9c, 0x84, 0xe6, 0x03, 0xd5, 0xcd, 0x1e, 0xad, 0x37, 0xb0,
-	0xe5, 0xa8, 0x53, 0x9c, 0x11, 0x0f, 0x3e, 0xd8, 0x8c, 0xf1, 0x31, 0x0b, 0x28, 0x42, 0xb0, 0x3a,
-	0x20, 0x62, 0xa0, 0xb3, 0xd4, 0xdf, 0x68, 0x1f, 0xca, 0x62, 0xc4, 0x25, 0x66, 0xe3, 0x8b, 0x3e,
-	0x8d, 0x55, 0xd0, 0xaa, 0x0f, 0x49, 0xc9, 0x53, 0x15, 0xeb, 0x18, 0x50, 0x2e, 0xcb, 0xa7, 0xbf,
-	0x8e, 0xa9, 0x90, 0xcb, 0xa2, 0x2c, 0x1b, 0xea, 0x8b, 0x4a, 0xe7, 0xaa, 0x33, 0xcd, 0x9a, 0x3f,
-	0xcc, 0x58, 0x38, 0xec, 0x0f, 0x63, 0x66, 0x72, 0x9f, 0x8a, 0x88, 0x33, 0x41, 0xd1, 0x2b, 0x58,
-	0xeb, 0x27, 0x05, 0x65, 0x29, 0x9f, 0x3e, 0x6b, 0x2c, 0xbf, 0xe2, 0x46, 0xde, 0x9b, 0x3a, 0x90,
-	0x0b, 0x65, 0x22, 0x25, 0x15, 0x92, 0xc8, 0x21, 0x67, 0x0a, 0xb0, 0x20, 0xc0, 0xbe, 0x91, 0xfa,
-	0x79, 0x9f, 0xd5, 0x83, 0x5d, 0x87, 0xc8, 0x60, 0x40, 0xc3, 0x25, 0xb7, 0xf1, 0x14, 0x40, 0x48,
-	0x12, 0x4b, 0x9c, 0xa0, 0x68, 0xac, 0x0d, 0x55, 0x49, 0xe0, 0xd1, 0x2e, 0xac, 0x53, 0x16, 0xa6,
-	0xcd, 0xf4, 0x82, 0x4b, 0x94, 0x85, 0x49, 0xcb, 0x1a, 0xc0, 0xde, 0xb2, 0x58, 0x8d, 0xfd, 0x06,
-	0xaa, 0xfd, 0xb4, 0x8b, 0x15, 0x8c, 0x30, 0x8d, 0x83, 0x95, 0xfb, 0xf2, 0x6f, 0x6a, 0xab, 0x7a,
-	0x12, 0x16, 0x82, 0x5a, 0x73, 0x40, 0x86, 0xac, 0x45, 0x49, 0xa8, 0xe7, 0xb6, 0xfe, 0x32, 0xe0,
-	0x71, 0xae, 0xa8, 0x4f, 0x3d, 0x82, 0x6a, 0x40, 0x18, 0x67, 0xc3, 0x80, 0x8c, 0xf2, 0x44, 0x9b,
-	0xd3, 0xaa, 0xa2, 0xfa, 0x1a, 0x3e, 0xcd, 0xc9, 0x24, 0x91, 0x14, 0xc7, 0x9c, 0x4b, 0x9c, 0xec,
-	0xc2, 0xcb, 0x53, 0xbd, 0x92, 0xe6, 0x8d, 0x27, 0x51, 0xf8, 0x9c, 0xcb, 0x96, 0xea, 0xa3, 0x6f,
-	0xe0, 0xc9, 0x2f, 0x43, 0x46, 0x46, 0xc3, 0xdf, 0x68, 0xb8, 0x68, 0x17, 0xe6, 0x8a, 0xf2, 0xef,
-	0x4e, 0x35, 0x73, 0x7e, 0x61, 0x3d, 0x87, 0x9d, 0x14, 0x57, 0x75, 0x92, 0x6a, 0xd1, 0xa2, 0x5b,
-	0xbd, 0x6c, 0x8f, 0xd3, 0x20, 0xfd, 0x9f, 0xbb, 0x6b, 0x0a, 0xe3, 0xae, 0x29, 0x82, 0x6c, 0x61,
-	0x75, 0xac, 0xbe, 0xc3, 0x73, 0x78, 0x34, 0x97, 0x7b, 0xbf, 0xd5, 0x4d, 0x53, 0xaa, 0xb3, 0xe7,
-	0x59, 0x5f, 0xc0, 0x56, 0x6e, 0x31, 0x0b, 0x31, 0x8f, 0x01, 0xe5, 0x77, 0xb8, 0xe0, 0x75, 0x8d,
-	0x66, 0x42, 0xa7, 0x93, 0x2f, 0xfb, 0x91, 0xf8, 0x9f, 0xde, 0xa1, 0x06, 0x98, 0xef, 0x62, 0x1e,
-	0x71, 0x41, 0xe3, 0xce, 0x88, 0x88, 0xc1, 0x90, 0xbd, 0x2f, 0x64, 0x79, 0x0e, 0x3b, 0xf3, 0xfa,
-	0x22, 0xa0, 0xdf, 0x8d, 0xc5, 0xfc, 0x42, 0xac, 0x1e, 0x3c, 0x8e, 0xb4, 0x1e, 0x0b, 0x6d, 0xd0,
-	0x70, 0xc7, 0xb7, 0xc1, 0x2d, 0x1c, 0x50, 0x8b, 0xe6, 0x2a, 0x09, 0x66, 0x7a, 0x05, 0xf7, 0xc7,
-	0x9c, 0xd7, 0xdf, 0x85, 0xb9, 0xa8, 0x2f, 0xc6, 0xcc, 0xf4, 0xf7, 0xc6, 0x5c, 0x38, 0xa0, 0x36,
-	0x5f, 0xb1, 0x8e, 0xe0, 0xd1, 0x19, 0x8d, 0xb8, 0x18, 0xca, 0x42, 0xba, 0xcf, 0xa0, 0xaa, 0x65,
-	0x45, 0x50, 0x3f, 0x4f, 0xc3, 0x0a, 0x51, 0x5e, 0x41, 0x29, 0x4c, 0x65, 0x1a, 0x60, 0xff, 0x36,
-	0x80, 0x2c, 0x2d, 0xd3, 0x5b, 0x16, 0x54, 0xdc, 0xcb, 0x3b, 0x66, 0x3d, 0x84, 0x72, 0xa2, 0x29,
-	0x7e, 0x6b, 0x2a, 0xa9, 0xa4, 0x60, 0xca, 0x73, 0xa8, 0x4e, 0xf8, 0x68, 0xcc, 0x24, 0x89, 0xaf,
-	0x30, 0xbd, 0x9c, 0x0e, 0x7b, 0x74, 0xdb, 0xb0, 0x3f, 0x64, 0x6a, 0x15, 0xbd, 0x39, 0xc9, 0x3f,
-	0x5a, 0x2e, 0x6c, 0xb4, 0x08, 0x0b, 0xc5, 0x80, 0x7c, 0xa0, 0xe8, 0x2b, 0x30, 0x35, 0x90, 0xfa,
-	0x32, 0x88, 0x49, 0x20, 0x31, 0x09, 0xc3, 0x98, 0x8a, 0xf4, 0xb7, 0x6a, 0xc3, 0xdf, 0xd6, 0xfd,
-	0xa6, 0x6e, 0xdb, 0x69, 0xf7, 0xcb, 0x3f, 0x57, 0x60, 0xad, 0xcb, 0xa3, 0x61, 0x80, 0xca, 0x50,
-	0xea, 0x79, 0x6f, 0xbd, 0xf6, 0x8f, 0x5e, 0xed, 0x23, 0xb4, 0x0b, 0x9f, 0x38, 0xae, 0xdd, 0x6c,
-	0x7b, 0xd8, 0x39, 0x6f, 0x37, 0xdf, 0x62, 0xdb, 0xf3, 0xda, 0x3d, 0xaf, 0xe9, 0xd6, 0x0c, 0x64,
-	0xc2, 0xc7, 0x33, 0x2d, 0xdf, 0xfd, 0xbe, 0xe7, 0x76, 0xba, 0xb5, 0x07, 0xe8, 0x73, 0x78, 0xb6,
-	0xac, 0x83, 0x9d, 0x9f, 0x70, 0xe7, 0xbc, 0xdd, 0xc5, 0x5e, 0xef, 0x3b, 0xc7, 0xf5, 0x6b, 0x2b,
-	0x0b, 0xe9, 0xbe, 0xdb, 0x79, 0xd7, 0xf6, 0x3a, 0x6e, 0x6d, 0x15, 0x1d, 0xc0, 0x13, 0xc7, 0xee,
-	0x36, 0x5b, 0xee, 0x19, 0x5e, 0x7a, 0xca, 0x1a, 0x3a, 0x84, 0xa7, 0xb7, 0x28, 0x74, 0xc8, 0x43,
-	0xb4, 0x0d, 0xa8, 0xd9, 0xb2, 0x5f, 0x7b, 0xb8, 0xe5, 0xda, 0x67, 0x53, 0x6b, 0x09, 0xed, 0xc0,
-	0xd6, 0x4c, 0x5d, 0x1b, 0xd6, 0x51, 0x1d, 0xf6, 0x74, 0x56, 0xa7, 0x6b, 0x77, 0x5d, 0xdc, 0xb2,
-	0x3b, 0xad, 0x1b, 0xe6, 0x8d, 0x1c, 0x73, 0xda, 0xcf, 0x22, 0x21, 0x87, 0x92, 0x75, 0x74, 0x68,
-	0x39, 0x31, 0xd9, 0xdd, 0xae, 0x9b, 0xd4, 0x5f, 0xb7, 0xbd, 0x9b, 0xb8, 0x4a, 0x32, 0x47, 0xbe,
-	0x93, 0xa5, 0x6d, 0xce, 0x5b, 0xa6, 0x61, 0x55, 0xa7, 0xf2, 0xf7, 0x75, 0xdd, 0xf8, 0xe7, 0xba,
-	0x6e, 0xfc, 0x7b, 0x5d, 0x37, 0xfa, 0x0f, 0xd5, 0x97, 0xe1, 0xcb, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xec, 0x05, 0xc3, 0xc5, 0x78, 0x0a, 0x00, 0x00,
+	// 1302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4d, 0x6e, 0xdb, 0x46,
+	0x18, 0x2d, 0x2d, 0xcb, 0xb6, 0x3e, 0xc9, 0x8a, 0x32, 0x4e, 0x1c, 0x3a, 0x3f, 0x76, 0xcc, 0x34,
+	0x88, 0x5b, 0x20, 0x32, 0xe2, 0x2c, 0xda, 0x2c, 0x8a, 0x42, 0x92, 0x89, 0x2a, 0x89, 0x4b, 0xa5,
+	0x94, 0x94, 0xa2, 0xe8, 0x82, 0x1d, 0x53, 0x63, 0x8b, 0x88, 0x4c, 0xb2, 0x9c, 0xb1, 0x61, 0x67,
+	0x9f, 0x33, 0x14, 0x3d, 0x42, 0xaf, 0xd0, 0x75, 0x17, 0x5d, 0xf6, 0x08, 0x85, 0xcf, 0xd1, 0x45,
+	0x31, 0x3f, 0xa4, 0x28, 0x51, 0xa2, 0x0d, 0x34, 0x40, 0x77, 0x9e, 0xf7, 0xbd, 0xf7, 0xe6, 0x7b,
+	0xa3, 0xe1, 0xcc, 0x18, 0x8c, 0x30, 0x0a, 0x58, 0xb0, 0x7b, 0x48, 0xb0, 0x1b, 0xf8, 0xbb, 0xe1,
+	0x5e, 0xb8, 0x7b, 0xf6, 0x6c, 0xf7, 0x84, 0x50, 0x8a, 0x8f, 0x09, 0xad, 0x8b, 0x22, 0x5a, 0x27,
+	0x6c, 0x48, 0x22, 0x72, 0x7a, 0x52, 0x97, 0xb4, 0x7a, 0xb8, 0x17, 0xd6, 0xcf, 0x9e, 0xdd, 0xdd,
+	0x9a, 0xa5, 0x65, 0x17, 0x61, 0x2c, 0x34, 0xbe, 0x81, 0x15, 0xd3, 0x3f, 0x23, 0xa3, 0x20, 0x24,
+	0x68, 0x1b, 0x2a, 0x34, 0xc4, 0xbe, 0xe3, 0x06, 0x3e, 0x23, 0xe7, 0x4c, 0xd7, 0x1e, 0x6a, 0x3b,
+	0x15, 0xbb, 0xcc, 0xb1, 0x96, 0x84, 0x90, 0x0e, 0xcb, 0x21, 0xbe, 0x18, 0x05, 0x78, 0xa0, 0x2f,
+	0x88, 0x6a, 0x3c, 0x34, 0x5e, 0xc1, 0x5a, 0x53, 0xcc, 0xd2, 0x1c, 0x05, 0xee, 0xbb, 0x86, 0xef,
+	0x07, 0xa7, 0xbe, 0x4b, 0x10, 0x82, 0xc5, 0x21, 0xa6, 0x43, 0xe5, 0x25, 0xfe, 0x46, 0x5b, 0x50,
+	0xa6, 0xa3, 0x80, 0x39, 0xfe, 0xe9, 0xc9, 0x21, 0x89, 0x84, 0xd1, 0xa2, 0x0d, 0x1c, 0xb2, 0x04,
+	0x62, 0xec, 0x00, 0x4a, 0x79, 0xd9, 0xe4, 0xe7, 0x53, 0x42, 0xd9, 0x2c, 0x2b, 0xa3, 0x01, 0x9b,
+	0x59, 0x66, 0xf3, 0xa2, 0x9b, 0x78, 0x4d, 0x4f, 0xa6, 0x65, 0x26, 0xfb, 0x45, 0x9b, 0xe8, 0xdc,
+	0x26, 0x34, 0x0c, 0x7c, 0x4a, 0xd0, 0x0b, 0x28, 0x1e, 0x72, 0x40, 0x48, 0xca, 0x7b, 0x8f, 0xea,
+	0xb3, 0x97, 0xb8, 0x9e, 0xd6, 0x4a, 0x05, 0x32, 0xa1, 0x8c, 0x19, 0x23, 0x94, 0x61, 0xe6, 0x05,
+	0xbe, 0xbe, 0x90, 0x6f, 0xd0, 0x18, 0x53, 0xed, 0xb4, 0xce, 0xe8, 0xc3, 0x46, 0x13, 0x33, 0x77,
+	0x48, 0x06, 0x33, 0x56, 0xe3, 0x01, 0x00, 0x65, 0x38, 0x62, 0x0e, 0x8f, 0xa2, 0x62, 0x95, 0x04,
+	0xc2, 0xc3, 0xa3, 0x0d, 0x58, 0x21, 0xfe, 0x40, 0x16, 0xe5, 0x02, 0x2f, 0x13, 0x7f, 0xc0, 0x4b,
+	0xc6, 0x10, 0xee, 0xce, 0xb2, 0x55, 0xb1, 0x5f, 0x41, 0xf5, 0x50, 0x56, 0x1d, 0x11, 0x86, 0xea,
+	0xda, 0xc3, 0xc2, 0x75, 0xf3, 0xaf, 0x2a, 0xa9, 0x18, 0x51, 0x03, 0x41, 0xad, 0x35, 0xc4, 0x9e,
+	0xdf, 0x26, 0x78, 0xa0, 0xfa, 0x36, 0x7e, 0xd7, 0xe0, 0x66, 0x0a, 0x54, 0xb3, 0x3e, 0x86, 0xaa,
+	0x8b, 0xfd, 0xc0, 0xf7, 0x5c, 0x3c, 0x4a, 0x27, 0x5a, 0x4d, 0x50, 0x91, 0xea, 0x2b, 0xb8, 0x97,
+	0xa2, 0x31, 0xcc, 0x88, 0x13, 0x05, 0x01, 0x73, 0xf8, 0x5e, 0x78, 0xbe, 0xa7, 0xb6, 0xa4, 0x3e,
+	0xd6, 0x70, 0x86, 0x1d, 0x04, 0xac, 0x2d, 0xea, 0xe8, 0x6b, 0xb8, 0x7f, 0xe4, 0xf9, 0x78, 0xe4,
+	0xbd, 0x27, 0x83, 0xac, 0x9c, 0xea, 0x05, 0xa1, 0xdf, 0x48, 0x38, 0x53, 0x7a, 0x6a, 0x3c, 0x85,
+	0x3b, 0x32, 0xae, 0xa8, 0x70, 0x34, 0x6f, 0xa3, 0x1b, 0x7d, 0x40, 0x29, 0x7a, 0xfc, 0xcb, 0x5d,
+	0xd5, 0x85, 0x76, 0x55, 0x17, 0x6e, 0xbc, 0x61, 0x95, 0xad, 0x5a, 0xc3, 0x03, 0xb8, 0x31, 0xe5,
+	0x7b, 0xbd, 0xad, 0x2b, 0x5d, 0xaa, 0x93, 0xf3, 0x19, 0x9f, 0xc1, 0x5a, 0x6a, 0x63, 0xe6, 0xc6,
+	0xdc, 0x01, 0x94, 0xde, 0xc3, 0x39, 0x9f, 0x6b, 0x38, 0x61, 0x9a, 0x74, 0x3e, 0x83, 0xfa, 0xb1,
+	0xbe, 0xa1, 0x3a, 0xe8, 0x6f, 0xa2, 0x20, 0x0c, 0x28, 0x89, 0xba, 0x23, 0x4c, 0x87, 0x9e, 0x7f,
+	0x9c, 0x9b, 0xe5, 0x29, 0xdc, 0x99, 0xe6, 0xe7, 0x05, 0xfa, 0xa0, 0x65, 0xfd, 0x73, 0x63, 0xf5,
+	0xe1, 0x66, 0xa8, 0xf8, 0x0e, 0x55, 0x02, 0x15, 0x6e, 0x67, 0x5e, 0xb8, 0xcc, 0x04, 0xb5, 0x70,
+	0x0a, 0xe1, 0x31, 0xe5, 0x12, 0x5c, 0x3f, 0xe6, 0x34, 0xff, 0xaa, 0x98, 0x59, 0x7e, 0x7e, 0xcc,
+	0x98, 0x7f, 0xed, 0x98, 0x99, 0x09, 0x6a, 0xd3, 0x88, 0xf1, 0x18, 0x6e, 0xec, 0x93, 0x30, 0xa0,
+	0x1e, 0xcb, 0x4d, 0xf7, 0x29, 0x54, 0x15, 0x2d, 0x2f, 0xd4, 0x4f, 0x89, 0x59, 0x6e, 0x94, 0x17,
+	0xb0, 0x3c, 0x90, 0x34, 0x15, 0x60, 0x6b, 0x5e, 0x80, 0xd8, 0x2d, 0xe6, 0x1b, 0x06, 0x54, 0xcc,
+	0xf3, 0x2b, 0x7a, 0xdd, 0x86, 0xb2, 0x79, 0x9e, 0xdf, 0x68, 0x28, 0x6d, 0x72, 0xbb, 0x3c, 0x80,
+	0xea, 0x59, 0x30, 0x3a, 0xf5, 0x19, 0x8e, 0x2e, 0x1c, 0x72, 0x9e, 0x34, 0xfb, 0x78, 0x5e, 0xb3,
+	0x6f, 0x63, 0xb6, 0xb0, 0x5e, 0x3d, 0x4b, 0x0f, 0x8d, 0x3f, 0x0a, 0x50, 0x6a, 0x63, 0x7f, 0x40,
+	0x87, 0xf8, 0x1d, 0x41, 0x5f, 0x82, 0xae, 0x12, 0x89, 0xa7, 0x41, 0x84, 0x5d, 0xe6, 0xe0, 0xc1,
+	0x20, 0x22, 0x54, 0x1e, 0x56, 0x25, 0x7b, 0x5d, 0xd5, 0x5b, 0xaa, 0xdc, 0x90, 0x55, 0x7e, 0xf9,
+	0xba, 0x81, 0x7f, 0xe4, 0x1d, 0x8b, 0xc3, 0x4d, 0x9d, 0xcf, 0x20, 0x21, 0x7e, 0x9a, 0xf1, 0x27,
+	0xc7, 0x51, 0x10, 0xbd, 0x73, 0xce, 0x48, 0x44, 0xf9, 0x67, 0x5e, 0x10, 0xa7, 0x7e, 0x99, 0x63,
+	0x6f, 0x25, 0xc4, 0x29, 0xc7, 0xc4, 0x27, 0xd4, 0xa3, 0x0e, 0xf3, 0x4e, 0x88, 0xbe, 0x28, 0x29,
+	0x0a, 0xeb, 0x79, 0x27, 0x04, 0xed, 0xc1, 0xed, 0xf1, 0xc9, 0x97, 0xbe, 0x10, 0x8a, 0x62, 0xc2,
+	0xb5, 0xa4, 0x98, 0xba, 0x0b, 0x9e, 0xa4, 0x4f, 0x4b, 0x12, 0x06, 0xee, 0x50, 0x5f, 0x12, 0xce,
+	0xe3, 0x83, 0xd0, 0xe4, 0x28, 0xbf, 0x73, 0x72, 0x8e, 0x6b, 0x7d, 0x59, 0xde, 0x39, 0xf3, 0x4e,
+	0x6b, 0xb4, 0x03, 0xb5, 0x21, 0xc1, 0x93, 0x6d, 0xad, 0x08, 0x4d, 0x95, 0xe3, 0x29, 0xe6, 0x3d,
+	0x28, 0x09, 0xa6, 0xb8, 0xfe, 0x4a, 0xa2, 0x97, 0x15, 0x0e, 0x88, 0x9b, 0xef, 0x39, 0xac, 0xcb,
+	0x62, 0xa6, 0x01, 0x90, 0x19, 0x05, 0x73, 0x72, 0x6e, 0xe3, 0x47, 0xd0, 0xd3, 0xd7, 0x15, 0xc1,
+	0x03, 0x12, 0x7d, 0xb4, 0x5b, 0xe8, 0xc3, 0x02, 0x6c, 0xcc, 0x70, 0x57, 0x7b, 0xf4, 0xbf, 0xda,
+	0xf3, 0xc0, 0x52, 0xc6, 0x22, 0x32, 0xeb, 0x96, 0x5f, 0x13, 0xd5, 0x5e, 0x44, 0xd2, 0x8b, 0xfd,
+	0x02, 0x8a, 0x02, 0xd6, 0x0b, 0xf9, 0xd7, 0x45, 0xfa, 0xe2, 0x93, 0x0a, 0xf4, 0x05, 0x14, 0x47,
+	0x1e, 0x65, 0x54, 0x5f, 0x14, 0xcf, 0x9d, 0xed, 0x79, 0x52, 0x21, 0x3a, 0xf0, 0x28, 0xb3, 0x25,
+	0xdf, 0x70, 0xa1, 0x94, 0x60, 0xe8, 0x16, 0x14, 0x8f, 0x3c, 0x32, 0x1a, 0xa8, 0xef, 0x42, 0x0e,
+	0xd0, 0x3a, 0x2c, 0x8d, 0x88, 0x7f, 0xcc, 0x86, 0xea, 0x29, 0xa6, 0x46, 0x7c, 0x6f, 0x64, 0xd2,
+	0xc9, 0x37, 0x48, 0x95, 0x4d, 0x04, 0x33, 0x7e, 0xd5, 0x26, 0x5e, 0x1e, 0xad, 0xe1, 0xa9, 0x9f,
+	0xbc, 0x04, 0xe7, 0xaf, 0x94, 0x36, 0x7f, 0xa5, 0x92, 0x46, 0x17, 0xd2, 0x8d, 0xde, 0x12, 0xeb,
+	0x17, 0x31, 0xf5, 0x1d, 0xca, 0x01, 0x7f, 0x6a, 0x46, 0xd8, 0x3f, 0x26, 0x0e, 0xf5, 0xde, 0xc7,
+	0xdf, 0x5f, 0x49, 0x20, 0x5d, 0xef, 0x3d, 0x31, 0xfe, 0xd1, 0x40, 0xcf, 0xf6, 0xa6, 0xf6, 0xc1,
+	0xff, 0xdc, 0x1c, 0x6a, 0xc3, 0x6a, 0x88, 0x23, 0xe6, 0xc5, 0xef, 0x45, 0xbd, 0x78, 0xfd, 0x9d,
+	0x51, 0x51, 0x4a, 0x31, 0xe2, 0x3f, 0xe2, 0x61, 0x84, 0x7d, 0x71, 0x4e, 0x14, 0x76, 0x2a, 0xb6,
+	0x1a, 0x7d, 0xfe, 0xdb, 0x22, 0x14, 0x7b, 0x41, 0xe8, 0xb9, 0xa8, 0x0c, 0xcb, 0x7d, 0xeb, 0xb5,
+	0xd5, 0xf9, 0xde, 0xaa, 0x7d, 0x82, 0x36, 0xe0, 0x76, 0xd3, 0x6c, 0xb4, 0x3a, 0x96, 0xd3, 0x3c,
+	0xe8, 0xb4, 0x5e, 0x3b, 0x0d, 0xcb, 0xea, 0xf4, 0xad, 0x96, 0x59, 0xd3, 0x90, 0x0e, 0xb7, 0x26,
+	0x4a, 0xb6, 0xf9, 0x5d, 0xdf, 0xec, 0xf6, 0x6a, 0x0b, 0xe8, 0x09, 0x3c, 0x9a, 0x55, 0x71, 0x9a,
+	0x3f, 0x38, 0xdd, 0x83, 0x4e, 0xcf, 0xb1, 0xfa, 0xdf, 0x36, 0x4d, 0xbb, 0x56, 0xc8, 0xb8, 0xdb,
+	0x66, 0xf7, 0x4d, 0xc7, 0xea, 0x9a, 0xb5, 0x45, 0xf4, 0x10, 0xee, 0x37, 0x1b, 0xbd, 0x56, 0xdb,
+	0xdc, 0x77, 0x66, 0xce, 0x52, 0x44, 0xdb, 0xf0, 0x60, 0x0e, 0x43, 0x99, 0x2c, 0xa1, 0x75, 0x40,
+	0xad, 0x76, 0xe3, 0xa5, 0xe5, 0xb4, 0xcd, 0xc6, 0x7e, 0x22, 0x5d, 0x46, 0x77, 0x60, 0x6d, 0x02,
+	0x57, 0x82, 0x15, 0xb4, 0x09, 0x77, 0x95, 0x57, 0xb7, 0xd7, 0xe8, 0x99, 0x4e, 0xbb, 0xd1, 0x6d,
+	0x8f, 0x33, 0x97, 0x52, 0x99, 0x65, 0x3d, 0xb6, 0x84, 0x54, 0x94, 0xb8, 0xa2, 0x4c, 0xcb, 0x5c,
+	0xd4, 0xe8, 0xf5, 0x4c, 0x8e, 0xbf, 0xec, 0x58, 0x63, 0xbb, 0x0a, 0xef, 0x23, 0x5d, 0x89, 0xdd,
+	0x56, 0xa7, 0x25, 0x89, 0x59, 0x15, 0x6d, 0xc1, 0xbd, 0xc9, 0x0e, 0xcd, 0xc6, 0xbe, 0x69, 0x27,
+	0xd2, 0x1b, 0x62, 0xe1, 0x66, 0x12, 0x94, 0x45, 0x2d, 0x13, 0xb2, 0xd5, 0xee, 0x5b, 0xe3, 0x85,
+	0xbd, 0x99, 0x99, 0x22, 0xae, 0x2b, 0x03, 0xd4, 0xac, 0xfc, 0x79, 0xb9, 0xa9, 0xfd, 0x75, 0xb9,
+	0xa9, 0xfd, 0x7d, 0xb9, 0xa9, 0x1d, 0x2e, 0x89, 0x7f, 0xc1, 0x9f, 0xff, 0x3b, 0x00, 0x1f, 0x4a,
+	0x83, 0x67, 0xe1, 0x0f, 0x00, 0x00,
 }
 
 func (m *Envelope) Marshal() (dAtA []byte, err error) {
@@ -2421,7 +2877,281 @@ func (m *Handshake) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMessages(dAtA, i, uint64(len(m.DepositContractAddress)))
 		i += copy(dAtA[i:], m.DepositContractAddress)
 	}
-	if m.XXX_unrecognized != nil {
+	if len(m.ConfigHash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ConfigHash)))
+		i += copy(dAtA[i:], m.ConfigHash)
+	}
+	if m.ForkVersion != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.ForkVersion))
+	}
+	if m.GenesisTime != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.GenesisTime))
+	}
+	if len(m.FinalizedRootHash32) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FinalizedRootHash32)))
+		i += copy(dAtA[i:], m.FinalizedRootHash32)
+	}
+	if m.FinalizedEpoch != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.FinalizedEpoch))
+	}
+	if len(m.FinalizedStateRootHash32) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FinalizedStateRootHash32)))
+		i += copy(dAtA[i:], m.FinalizedStateRootHash32)
+	}
+	if len(m.HeadRootHash32) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.HeadRootHash32)))
+		i += copy(dAtA[i:], m.HeadRootHash32)
+	}
+	if m.HeadSlot != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.HeadSlot))
+	}
+	if len(m.HeadStateRootHash32) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.HeadStateRootHash32)))
+		i += copy(dAtA[i:], m.HeadStateRootHash32)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BeaconStateHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.FinalizedStateRootHash32S) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FinalizedStateRootHash32S)))
+		i += copy(dAtA[i:], m.FinalizedStateRootHash32S)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BeaconStateHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.FinalizedStateRootHash32S) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FinalizedStateRootHash32S)))
+		i += copy(dAtA[i:], m.FinalizedStateRootHash32S)
+	}
+	if len(m.StateTreeRootHash32) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.StateTreeRootHash32)))
+		i += copy(dAtA[i:], m.StateTreeRootHash32)
+	}
+	if m.State != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.State.Size()))
+		n9, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if len(m.Lists) > 0 {
+		for _, msg := range m.Lists {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMessages(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StateList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Field) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Length))
+	}
+	if len(m.TreeRootHash32) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.TreeRootHash32)))
+		i += copy(dAtA[i:], m.TreeRootHash32)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BeaconStateChunkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateChunkRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateTreeRootHash32) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.StateTreeRootHash32)))
+		i += copy(dAtA[i:], m.StateTreeRootHash32)
+	}
+	if len(m.Field) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	if m.Start != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Start))
+	}
+	if m.RangeSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.RangeSize))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BeaconStateChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateTreeRootHash32) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.StateTreeRootHash32)))
+		i += copy(dAtA[i:], m.StateTreeRootHash32)
+	}
+	if len(m.Field) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	if m.Start != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Start))
+	}
+	if m.RangeSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.RangeSize))
+	}
+	if m.PartialState != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.PartialState.Size()))
+		n10, err := m.PartialState.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.Branch) > 0 {
+		for _, b := range m.Branch {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintMessages(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
@@ -2915,37 +3645,200 @@ func (m *Handshake) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
+	l = len(m.ConfigHash)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ForkVersion != 0 {
+		n += 1 + sovMessages(uint64(m.ForkVersion))
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovMessages(uint64(m.GenesisTime))
+	}
+	l = len(m.FinalizedRootHash32)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovMessages(uint64(m.FinalizedEpoch))
+	}
+	l = len(m.FinalizedStateRootHash32)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.HeadRootHash32)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovMessages(uint64(m.HeadSlot))
+	}
+	l = len(m.HeadStateRootHash32)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func sovMessages(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *BeaconStateHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FinalizedStateRootHash32S)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
-func sozMessages(x uint64) (n int) {
-	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *BeaconStateHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FinalizedStateRootHash32S)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.StateTreeRootHash32)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if len(m.Lists) > 0 {
+		for _, e := range m.Lists {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *Envelope) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessages
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+
+func (m *StateList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Length != 0 {
+		n += 1 + sovMessages(uint64(m.Length))
+	}
+	l = len(m.TreeRootHash32)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateChunkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateTreeRootHash32)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovMessages(uint64(m.Start))
+	}
+	if m.RangeSize != 0 {
+		n += 1 + sovMessages(uint64(m.RangeSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateTreeRootHash32)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovMessages(uint64(m.Start))
+	}
+	if m.RangeSize != 0 {
+		n += 1 + sovMessages(uint64(m.RangeSize))
+	}
+	if m.PartialState != nil {
+		l = m.PartialState.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if len(m.Branch) > 0 {
+		for _, b := range m.Branch {
+			l = len(b)
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMessages(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMessages(x uint64) (n int) {
+	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Envelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -5652,6 +6545,1055 @@ func (m *Handshake) Unmarshal(dAtA []byte) error {
 			}
 			m.DepositContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigHash = append(m.ConfigHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConfigHash == nil {
+				m.ConfigHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkVersion", wireType)
+			}
+			m.ForkVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForkVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			m.GenesisTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedRootHash32", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedRootHash32 = append(m.FinalizedRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedRootHash32 == nil {
+				m.FinalizedRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedStateRootHash32", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedStateRootHash32 = append(m.FinalizedStateRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedStateRootHash32 == nil {
+				m.FinalizedStateRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadRootHash32", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeadRootHash32 = append(m.HeadRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeadRootHash32 == nil {
+				m.HeadRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadStateRootHash32", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeadStateRootHash32 = append(m.HeadStateRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeadStateRootHash32 == nil {
+				m.HeadStateRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateHeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateHeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedStateRootHash32S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedStateRootHash32S = append(m.FinalizedStateRootHash32S[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedStateRootHash32S == nil {
+				m.FinalizedStateRootHash32S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedStateRootHash32S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedStateRootHash32S = append(m.FinalizedStateRootHash32S[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedStateRootHash32S == nil {
+				m.FinalizedStateRootHash32S = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateTreeRootHash32", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateTreeRootHash32 = append(m.StateTreeRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateTreeRootHash32 == nil {
+				m.StateTreeRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &BeaconState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lists = append(m.Lists, &StateList{})
+			if err := m.Lists[len(m.Lists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeRootHash32", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreeRootHash32 = append(m.TreeRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.TreeRootHash32 == nil {
+				m.TreeRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateChunkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateChunkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateTreeRootHash32", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateTreeRootHash32 = append(m.StateTreeRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateTreeRootHash32 == nil {
+				m.StateTreeRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeSize", wireType)
+			}
+			m.RangeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateChunkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateChunkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateTreeRootHash32", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateTreeRootHash32 = append(m.StateTreeRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateTreeRootHash32 == nil {
+				m.StateTreeRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeSize", wireType)
+			}
+			m.RangeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartialState == nil {
+				m.PartialState = &BeaconState{}
+			}
+			if err := m.PartialState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = append(m.Branch, make([]byte, postIndex-iNdEx))
+			copy(m.Branch[len(m.Branch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
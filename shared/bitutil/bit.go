@@ -83,3 +83,25 @@ func fillBit(target byte, index uint64) byte {
 	bitShift := 7 - index
 	return target ^ (1 << bitShift)
 }
+
+// OrBitfields returns the bitwise OR of two bitfields of the same length.
+func OrBitfields(b1 []byte, b2 []byte) ([]byte, error) {
+	if len(b1) != len(b2) {
+		return nil, fmt.Errorf("bitfield lengths do not match: %d, %d", len(b1), len(b2))
+	}
+	result := make([]byte, len(b1))
+	for i := range b1 {
+		result[i] = b1[i] | b2[i]
+	}
+	return result, nil
+}
+
+// BitfieldsOverlap returns true if any bit is set in both bitfields.
+func BitfieldsOverlap(b1 []byte, b2 []byte) bool {
+	for i := 0; i < len(b1) && i < len(b2); i++ {
+		if b1[i]&b2[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -109,3 +109,46 @@ func TestSetBitfield_LargerCommitteesThanIndex(t *testing.T) {
 
 	}
 }
+
+func TestOrBitfields(t *testing.T) {
+	tests := []struct {
+		a []byte
+		b []byte
+		c []byte
+	}{
+		{a: []byte{0x80}, b: []byte{0x01}, c: []byte{0x81}},
+		{a: []byte{0x0F, 0x00}, b: []byte{0xF0, 0x01}, c: []byte{0xFF, 0x01}},
+		{a: []byte{0x18}, b: []byte{0x18}, c: []byte{0x18}},
+	}
+	for _, tt := range tests {
+		result, err := OrBitfields(tt.a, tt.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(result, tt.c) {
+			t.Errorf("Expected %08b | %08b to be %08b, received %08b", tt.a, tt.b, tt.c, result)
+		}
+	}
+
+	if _, err := OrBitfields([]byte{0x01}, []byte{0x01, 0x00}); err == nil {
+		t.Error("Expected OR of bitfields with different lengths to fail")
+	}
+}
+
+func TestBitfieldsOverlap(t *testing.T) {
+	tests := []struct {
+		a []byte
+		b []byte
+		c bool
+	}{
+		{a: []byte{0x80}, b: []byte{0x01}, c: false},
+		{a: []byte{0x81}, b: []byte{0x01}, c: true},
+		{a: []byte{0x00, 0x10}, b: []byte{0xFF, 0x10}, c: true},
+		{a: []byte{0x00, 0x10}, b: []byte{0xFF, 0x01}, c: false},
+	}
+	for _, tt := range tests {
+		if overlap := BitfieldsOverlap(tt.a, tt.b); overlap != tt.c {
+			t.Errorf("Expected overlap of %08b and %08b to be %v", tt.a, tt.b, tt.c)
+		}
+	}
+}
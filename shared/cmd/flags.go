@@ -12,6 +12,11 @@ var (
 		Usage: "Logging verbosity (debug, info=default, warn, error, fatal, panic)",
 		Value: "info",
 	}
+	// ChainConfigFileFlag defines the YAML file of the beacon chain config to run with.
+	ChainConfigFileFlag = cli.StringFlag{
+		Name:  "chain-config-file",
+		Usage: "The YAML file of the beacon chain config to run with, instead of the demo or phase 0 config",
+	}
 	// DataDirFlag defines a path on disk.
 	DataDirFlag = DirectoryFlag{
 		Name:  "datadir",
@@ -0,0 +1,44 @@
+package keystore
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// curveOrder is the order of the BLS12-381 curve, secret keys are scalars below it.
+var curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// InteropSecretKey derives the secret key of the interop validator with the given
+// index, from the hash of its index encoded as 32 little-endian bytes, modulo the
+// curve order. Every node and validator client of an interop chain derives the
+// same keys, so the keys never need to be shared.
+func InteropSecretKey(index uint64) (*bls.SecretKey, error) {
+	enc := make([]byte, 32)
+	binary.LittleEndian.PutUint64(enc, index)
+	scalar := new(big.Int).Mod(new(big.Int).SetBytes(Keccak256(enc)), curveOrder)
+	secret := make([]byte, 32)
+	scalarBytes := scalar.Bytes()
+	copy(secret[32-len(scalarBytes):], scalarBytes)
+	return bls.SecretKeyFromBytes(secret)
+}
+
+// InteropKeys derives the keys of count interop validators, starting from the
+// validator with the given index, in index order.
+func InteropKeys(startIndex uint64, count uint64) ([]*Key, error) {
+	keys := make([]*Key, count)
+	for i := uint64(0); i < count; i++ {
+		secretKey, err := InteropSecretKey(startIndex + i)
+		if err != nil {
+			return nil, fmt.Errorf("could not derive key of interop validator %d: %v", startIndex+i, err)
+		}
+		key, err := newKeyFromBLS(secretKey)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
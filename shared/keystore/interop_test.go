@@ -0,0 +1,27 @@
+package keystore
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestInteropKeys_Deterministic(t *testing.T) {
+	keys, err := InteropKeys(0, 4)
+	if err != nil {
+		t.Fatalf("Could not derive interop keys: %v", err)
+	}
+	others, err := InteropKeys(2, 2)
+	if err != nil {
+		t.Fatalf("Could not derive interop keys: %v", err)
+	}
+	for i, key := range others {
+		if !bytes.Equal(key.SecretKey.Marshal(), keys[i+2].SecretKey.Marshal()) {
+			t.Errorf("Expected the key of validator %d to be derived from its index only", i+2)
+		}
+	}
+	for i := 1; i < len(keys); i++ {
+		if bytes.Equal(keys[i].PublicKey.Marshal(), keys[i-1].PublicKey.Marshal()) {
+			t.Errorf("Expected validators %d and %d to have different keys", i-1, i)
+		}
+	}
+}
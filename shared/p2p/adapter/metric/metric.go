@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
@@ -35,8 +36,20 @@ var (
 		},
 		[]string{"message"},
 	)
+	peerScore = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "p2p_peer_score",
+			Help: "Score of the peers which sent messages.",
+		},
+		[]string{"peer"},
+	)
 )
 
+// PeerScorer provides the score of peers, such as the p2p server.
+type PeerScorer interface {
+	PeerScore(pid peer.ID) int
+}
+
 // New create and initialize a metric adapter for the p2p service.
 func New() p2p.Adapter {
 	return func(next p2p.Handler) p2p.Handler {
@@ -51,3 +64,14 @@ func New() p2p.Adapter {
 		}
 	}
 }
+
+// NewPeerScores creates an adapter exporting the score of the peers sending
+// messages, updated each time a peer sends a message.
+func NewPeerScores(scorer PeerScorer) p2p.Adapter {
+	return func(next p2p.Handler) p2p.Handler {
+		return func(msg p2p.Message) {
+			next(msg)
+			peerScore.WithLabelValues(msg.Peer.Pretty()).Set(float64(scorer.PeerScore(msg.Peer)))
+		}
+	}
+}
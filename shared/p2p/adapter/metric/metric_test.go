@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	peer "github.com/libp2p/go-libp2p-peer"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
@@ -42,6 +43,28 @@ func TestMessageMetrics_OK(t *testing.T) {
 	testMetricExists(t, metrics, fmt.Sprintf("p2p_message_sent_latency_seconds_bucket{message=\"%T\",le=\"0.01\"} 1", data))
 }
 
+type mockScorer struct{}
+
+func (m *mockScorer) PeerScore(pid peer.ID) int {
+	return -7
+}
+
+func TestPeerScoreMetrics_OK(t *testing.T) {
+	service := prometheus.NewPrometheusService(addr, nil)
+	go service.Start()
+	defer service.Stop()
+
+	pid, err := peer.IDB58Decode("QmTtFWBbtFn8bcZcBbgfRWf4fAtD1XgtosL4GYYfF4rZPN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewPeerScores(&mockScorer{})(func(p2p.Message) {})
+	h(p2p.Message{Ctx: context.Background(), Data: &pb.Attestation{}, Peer: pid})
+
+	metrics := getMetrics(t)
+	testMetricExists(t, metrics, fmt.Sprintf("p2p_peer_score{peer=\"%s\"} -7", pid.Pretty()))
+}
+
 func getMetrics(t *testing.T) []string {
 	resp, err := http.Get(fmt.Sprintf("http://%s/metrics", addr))
 	if err != nil {
@@ -1,22 +1,27 @@
 package p2p
 
 import (
+	"context"
+
 	ggio "github.com/gogo/protobuf/io"
 	host "github.com/libp2p/go-libp2p-host"
 	inet "github.com/libp2p/go-libp2p-net"
-	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
 // setHandshakeHandler to respond to requests for p2p handshake messages.
-func setHandshakeHandler(host host.Host, contractAddress string) {
+func setHandshakeHandler(host host.Host, hs *handshaker) {
 	host.SetStreamHandler(handshakeProtocol, func(stream inet.Stream) {
 		defer stream.Close()
 		log.Debug("Handling handshake stream")
 		w := ggio.NewDelimitedWriter(stream)
 		defer w.Close()
 
-		hs := &pb.Handshake{DepositContractAddress: contractAddress}
-		if err := w.WriteMsg(hs); err != nil {
+		local, err := hs.localHandshake(context.Background())
+		if err != nil {
+			log.WithError(err).Error("Failed to build handshake response")
+			return
+		}
+		if err := w.WriteMsg(local); err != nil {
 			log.WithError(err).Error("Failed to write handshake response")
 		}
 	})
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 )
 
@@ -20,3 +22,23 @@ type Broadcaster interface {
 type Subscriber interface {
 	Subscribe(msg proto.Message, channel chan Message) event.Subscription
 }
+
+// StatusHandler provides the chain status of the node for the p2p handshake
+// and checks the chain status peers send in theirs. Peers whose status is
+// rejected are disconnected.
+type StatusHandler interface {
+	LocalStatus(ctx context.Context) (*pb.Handshake, error)
+	ValidatePeerStatus(ctx context.Context, status *pb.Handshake) error
+}
+
+// PeerReporter represents a subset of the p2p.Server. It adjusts the score of a
+// peer for the outcome of handling a message the peer sent, by one of the Score
+// constants. Peers whose score drops too low are disconnected and banned.
+type PeerReporter interface {
+	ReportPeer(pid peer.ID, delta int)
+}
+
+// TopicValidator checks a message received on a topic from a peer before the
+// message is processed or forwarded to other peers. It runs for every message, so
+// it must only make cheap checks.
+type TopicValidator func(ctx context.Context, msg proto.Message, pid peer.ID) bool
@@ -12,7 +12,9 @@ import (
 type Message struct {
 	// Ctx message context.
 	Ctx context.Context
-	// Peer represents the sender of the message.
+	// Peer represents the sender of the message. For gossip, it is the peer the
+	// message was forwarded by, as the originator announced by a message can be
+	// forged.
 	Peer peer.ID
 	// Data can be any type of message found in sharding/p2p/proto package.
 	Data proto.Message
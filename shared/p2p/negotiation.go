@@ -1,9 +1,13 @@
 package p2p
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"sync"
 
 	ggio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
 	host "github.com/libp2p/go-libp2p-host"
 	inet "github.com/libp2p/go-libp2p-net"
 	peer "github.com/libp2p/go-libp2p-peer"
@@ -13,12 +17,83 @@ import (
 
 const handshakeProtocol = prysmProtocolPrefix + "/handshake"
 
+// handshaker builds the handshake of the local node, checks the handshakes of
+// peers and keeps the chain status of the peers which passed the checks.
+type handshaker struct {
+	// network is the deposit contract address and config hash of the node,
+	// which never change while it runs.
+	network       *pb.Handshake
+	lock          sync.RWMutex
+	statusHandler StatusHandler
+	statuses      *PeerStatuses
+}
+
+func newHandshaker(network *pb.Handshake) *handshaker {
+	return &handshaker{
+		network:  network,
+		statuses: NewPeerStatuses(),
+	}
+}
+
+func (hs *handshaker) setStatusHandler(handler StatusHandler) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	hs.statusHandler = handler
+}
+
+func (hs *handshaker) getStatusHandler() StatusHandler {
+	hs.lock.RLock()
+	defer hs.lock.RUnlock()
+	return hs.statusHandler
+}
+
+// localHandshake returns the handshake of the node, carrying its current chain
+// status if a status handler is set.
+func (hs *handshaker) localHandshake(ctx context.Context) (*pb.Handshake, error) {
+	local := &pb.Handshake{}
+	if handler := hs.getStatusHandler(); handler != nil {
+		status, err := handler.LocalStatus(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get local chain status: %v", err)
+		}
+		local = proto.Clone(status).(*pb.Handshake)
+	}
+	local.DepositContractAddress = hs.network.DepositContractAddress
+	local.ConfigHash = hs.network.ConfigHash
+	return local, nil
+}
+
+// checkHandshake returns an error if the peer of the handshake is not on the same
+// chain as the node.
+func (hs *handshaker) checkHandshake(ctx context.Context, resp *pb.Handshake) error {
+	if resp.DepositContractAddress != hs.network.DepositContractAddress {
+		return fmt.Errorf(
+			"peer is on deposit contract %s, expected %s",
+			resp.DepositContractAddress,
+			hs.network.DepositContractAddress,
+		)
+	}
+	if !bytes.Equal(resp.ConfigHash, hs.network.ConfigHash) {
+		return fmt.Errorf(
+			"peer runs chain config %#x, expected %#x",
+			resp.ConfigHash,
+			hs.network.ConfigHash,
+		)
+	}
+	if handler := hs.getStatusHandler(); handler != nil {
+		return handler.ValidatePeerStatus(ctx, resp)
+	}
+	return nil
+}
+
 // setupPeerNegotiation adds a "Connected" event handler which checks a peer's
-// handshake to ensure the peer is on the same blockchain. This currently
-// checks only the deposit contract address. Some peer IDs may be excluded.
-// For example, a relay or bootnode will not support the handshake protocol,
-// but we would not want to disconnect from those well known peer IDs.
-func setupPeerNegotiation(h host.Host, contractAddress string, exclusions []peer.ID) {
+// handshake to ensure the peer is on the same blockchain. This checks the
+// deposit contract address, the hash of the beacon chain config and, through
+// the status handler, the chain status of the peer. The status of each peer
+// which passes the checks is kept until it disconnects. Some peer IDs may be
+// excluded. For example, a relay or bootnode will not support the handshake
+// protocol, but we would not want to disconnect from those well known peer IDs.
+func setupPeerNegotiation(h host.Host, hs *handshaker, exclusions []peer.ID) {
 	h.Network().Notify(&inet.NotifyBundle{
 		ConnectedF: func(net inet.Network, conn inet.Conn) {
 			// Must be handled in a goroutine as this callback cannot be blocking.
@@ -34,31 +109,12 @@ func setupPeerNegotiation(h host.Host, contractAddress string, exclusions []peer
 					"Checking connection to peer",
 				)
 
-				s, err := h.NewStream(
-					context.Background(),
-					conn.RemotePeer(),
-					handshakeProtocol,
-				)
+				resp, err := negotiate(h, hs, conn.RemotePeer())
 				if err != nil {
 					log.WithError(err).WithFields(logrus.Fields{
 						"peer":    conn.RemotePeer(),
 						"address": conn.RemoteMultiaddr(),
-					}).Warn("Failed to open stream with newly connected peer")
-
-					log.Warn("Temporarily disabled -- not disconnecting peer. See https://github.com/prysmaticlabs/prysm/issues/2408")
-					//	if err := h.Network().ClosePeer(conn.RemotePeer()); err != nil {
-					//		log.WithError(err).Error("failed to disconnect peer")
-					//	}
-					return
-				}
-				defer s.Close()
-
-				w := ggio.NewDelimitedWriter(s)
-				defer w.Close()
-
-				hs := &pb.Handshake{DepositContractAddress: contractAddress}
-				if err := w.WriteMsg(hs); err != nil {
-					log.WithError(err).Error("Failed to write handshake to peer")
+					}).Warn("Disconnecting from peer which failed the handshake")
 
 					if err := h.Network().ClosePeer(conn.RemotePeer()); err != nil {
 						log.WithError(err).Error("failed to disconnect peer")
@@ -66,30 +122,51 @@ func setupPeerNegotiation(h host.Host, contractAddress string, exclusions []peer
 					return
 				}
 
-				r := ggio.NewDelimitedReader(s, maxMessageSize)
-				resp := &pb.Handshake{}
-				if err := r.ReadMsg(resp); err != nil {
-					log.WithError(err).Error("Failed to read message")
-
-					if err := h.Network().ClosePeer(conn.RemotePeer()); err != nil {
-						log.WithError(err).Error("failed to disconnect peer")
-					}
-					return
-				}
-
-				log.WithField("msg", resp).Debug("Handshake received")
-
-				if resp.DepositContractAddress != contractAddress {
-					log.WithFields(logrus.Fields{
-						"peerContract":     resp.DepositContractAddress,
-						"expectedContract": contractAddress,
-					}).Warn("Disconnecting from peer on different contract")
-
-					if err := h.Network().ClosePeer(conn.RemotePeer()); err != nil {
-						log.WithError(err).Error("failed to disconnect peer")
-					}
+				// The peer may have disconnected while the handshake was
+				// in flight, in which case it must not be kept.
+				if h.Network().Connectedness(conn.RemotePeer()) == inet.Connected {
+					hs.statuses.Set(conn.RemotePeer(), resp)
 				}
 			}()
 		},
+		DisconnectedF: func(net inet.Network, conn inet.Conn) {
+			// A peer may be connected over several connections.
+			if net.Connectedness(conn.RemotePeer()) != inet.Connected {
+				hs.statuses.Remove(conn.RemotePeer())
+			}
+		},
 	})
 }
+
+// negotiate exchanges handshakes with the peer and returns the handshake of the
+// peer if it is on the same chain as the node.
+func negotiate(h host.Host, hs *handshaker, pid peer.ID) (*pb.Handshake, error) {
+	ctx := context.Background()
+	s, err := h.NewStream(ctx, pid, handshakeProtocol)
+	if err != nil {
+		return nil, fmt.Errorf("could not open handshake stream: %v", err)
+	}
+	defer s.Close()
+
+	local, err := hs.localHandshake(ctx)
+	if err != nil {
+		return nil, err
+	}
+	w := ggio.NewDelimitedWriter(s)
+	defer w.Close()
+	if err := w.WriteMsg(local); err != nil {
+		return nil, fmt.Errorf("could not write handshake: %v", err)
+	}
+
+	r := ggio.NewDelimitedReader(s, maxMessageSize)
+	resp := &pb.Handshake{}
+	if err := r.ReadMsg(resp); err != nil {
+		return nil, fmt.Errorf("could not read handshake: %v", err)
+	}
+	log.WithField("msg", resp).Debug("Handshake received")
+
+	if err := hs.checkHandshake(ctx, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -10,19 +11,35 @@ import (
 	peer "github.com/libp2p/go-libp2p-peer"
 	pstore "github.com/libp2p/go-libp2p-peerstore"
 	swarmt "github.com/libp2p/go-libp2p-swarm/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
+type mockStatusHandler struct {
+	status    *pb.Handshake
+	rejectErr error
+}
+
+func (m *mockStatusHandler) LocalStatus(ctx context.Context) (*pb.Handshake, error) {
+	return m.status, nil
+}
+
+func (m *mockStatusHandler) ValidatePeerStatus(ctx context.Context, status *pb.Handshake) error {
+	return m.rejectErr
+}
+
 func TestNegotiation_AcceptsValidPeer(t *testing.T) {
 	ctx := context.Background()
 	hostA := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
 	hostB := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
 
-	address := "same"
-	setHandshakeHandler(hostA, address)
-	setHandshakeHandler(hostB, address)
+	hsA := newHandshaker(&pb.Handshake{DepositContractAddress: "same", ConfigHash: []byte{'A'}})
+	hsB := newHandshaker(&pb.Handshake{DepositContractAddress: "same", ConfigHash: []byte{'A'}})
+	hsB.setStatusHandler(&mockStatusHandler{status: &pb.Handshake{HeadSlot: 5}})
+	setHandshakeHandler(hostA, hsA)
+	setHandshakeHandler(hostB, hsB)
 
-	setupPeerNegotiation(hostA, address, []peer.ID{})
-	setupPeerNegotiation(hostB, address, []peer.ID{})
+	setupPeerNegotiation(hostA, hsA, []peer.ID{})
+	setupPeerNegotiation(hostB, hsB, []peer.ID{})
 
 	if err := hostA.Connect(ctx, pstore.PeerInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}); err != nil {
 		t.Fatal(err)
@@ -33,6 +50,21 @@ func TestNegotiation_AcceptsValidPeer(t *testing.T) {
 	if hostA.Network().Connectedness(hostB.ID()) != libp2pnet.Connected {
 		t.Error("hosts are not connected")
 	}
+	status, ok := hsA.statuses.Get(hostB.ID())
+	if !ok {
+		t.Fatal("Expected the status of the peer to be kept")
+	}
+	if status.HeadSlot != 5 {
+		t.Errorf("Expected the peer head slot to be 5, received %d", status.HeadSlot)
+	}
+
+	if err := hostA.Network().ClosePeer(hostB.ID()); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if _, ok := hsA.statuses.Get(hostB.ID()); ok {
+		t.Error("Expected the status of the disconnected peer to be removed")
+	}
 }
 
 func TestNegotiation_DisconnectsDifferentDepositContract(t *testing.T) {
@@ -40,11 +72,65 @@ func TestNegotiation_DisconnectsDifferentDepositContract(t *testing.T) {
 	hostA := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
 	hostB := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
 
-	setHandshakeHandler(hostA, "hostA")
-	setHandshakeHandler(hostB, "hostB")
+	hsA := newHandshaker(&pb.Handshake{DepositContractAddress: "hostA"})
+	hsB := newHandshaker(&pb.Handshake{DepositContractAddress: "hostB"})
+	setHandshakeHandler(hostA, hsA)
+	setHandshakeHandler(hostB, hsB)
+
+	setupPeerNegotiation(hostA, hsA, []peer.ID{})
+	setupPeerNegotiation(hostB, hsB, []peer.ID{})
+
+	if err := hostA.Connect(ctx, pstore.PeerInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Allow short delay for async negotiation.
+	time.Sleep(200 * time.Millisecond)
+	if hostA.Network().Connectedness(hostB.ID()) == libp2pnet.Connected {
+		t.Error("hosts are connected, but should not be connected")
+	}
+}
+
+func TestNegotiation_DisconnectsDifferentChainConfig(t *testing.T) {
+	ctx := context.Background()
+	hostA := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	hostB := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+
+	hsA := newHandshaker(&pb.Handshake{DepositContractAddress: "same", ConfigHash: []byte{'A'}})
+	hsB := newHandshaker(&pb.Handshake{DepositContractAddress: "same", ConfigHash: []byte{'B'}})
+	setHandshakeHandler(hostA, hsA)
+	setHandshakeHandler(hostB, hsB)
+
+	setupPeerNegotiation(hostA, hsA, []peer.ID{})
+	setupPeerNegotiation(hostB, hsB, []peer.ID{})
+
+	if err := hostA.Connect(ctx, pstore.PeerInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Allow short delay for async negotiation.
+	time.Sleep(200 * time.Millisecond)
+	if hostA.Network().Connectedness(hostB.ID()) == libp2pnet.Connected {
+		t.Error("hosts are connected, but should not be connected")
+	}
+}
+
+func TestNegotiation_DisconnectsRejectedStatus(t *testing.T) {
+	ctx := context.Background()
+	hostA := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	hostB := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+
+	hsA := newHandshaker(&pb.Handshake{DepositContractAddress: "same"})
+	hsA.setStatusHandler(&mockStatusHandler{
+		status:    &pb.Handshake{},
+		rejectErr: errors.New("conflicting finalized checkpoint"),
+	})
+	hsB := newHandshaker(&pb.Handshake{DepositContractAddress: "same"})
+	setHandshakeHandler(hostA, hsA)
+	setHandshakeHandler(hostB, hsB)
 
-	setupPeerNegotiation(hostA, "hostA", []peer.ID{})
-	setupPeerNegotiation(hostB, "hostB", []peer.ID{})
+	setupPeerNegotiation(hostA, hsA, []peer.ID{})
+	setupPeerNegotiation(hostB, hsB, []peer.ID{})
 
 	if err := hostA.Connect(ctx, pstore.PeerInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}); err != nil {
 		t.Fatal(err)
@@ -55,4 +141,7 @@ func TestNegotiation_DisconnectsDifferentDepositContract(t *testing.T) {
 	if hostA.Network().Connectedness(hostB.ID()) == libp2pnet.Connected {
 		t.Error("hosts are connected, but should not be connected")
 	}
+	if _, ok := hsA.statuses.Get(hostB.ID()); ok {
+		t.Error("Expected the status of the rejected peer not to be kept")
+	}
 }
@@ -0,0 +1,275 @@
+package p2p
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	host "github.com/libp2p/go-libp2p-host"
+	inet "github.com/libp2p/go-libp2p-net"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Score adjustments reported for the outcome of handling a message from a peer.
+// A peer whose score drops to BanScoreThreshold is disconnected and banned.
+const (
+	ScoreValidMessage       = 1
+	ScoreRateLimited        = -5
+	ScoreUnfulfilledRequest = -10
+	ScoreInvalidAttestation = -10
+	ScoreInvalidBlock       = -20
+	ScoreMalformedMessage   = -25
+)
+
+const (
+	// BanScoreThreshold is the score at which a peer is banned.
+	BanScoreThreshold = -100
+	// maxPeerScore caps the score a peer can build up, so a long well behaved
+	// history does not allow a burst of misbehaviour to go unpunished.
+	maxPeerScore = 100
+	// DefaultBanDuration is how long a peer stays banned unless configured
+	// otherwise.
+	DefaultBanDuration = time.Hour
+	// scoreDecayPeriod is how long it takes a negative score to recover by one
+	// point, so past misbehaviour is forgiven over time.
+	scoreDecayPeriod = time.Minute
+)
+
+var bannedPeersMetric = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "p2p_banned_peers",
+	Help: "The number of currently banned peers",
+})
+
+// PeerBan describes a banned peer.
+type PeerBan struct {
+	Peer   peer.ID
+	Score  int
+	Expiry time.Time
+}
+
+// peerScore is the score of a peer, along with the time up to which the decay of
+// a negative score was applied.
+type peerScore struct {
+	value   int
+	updated time.Time
+}
+
+// peerScores keeps the score of each peer and the peers banned for misbehaving.
+// Negative scores are kept when a peer disconnects, so a peer cannot clear its
+// score by reconnecting, and decay towards zero over time. A nil table bans
+// nobody.
+type peerScores struct {
+	lock        sync.Mutex
+	scores      map[peer.ID]peerScore
+	bans        map[peer.ID]PeerBan
+	banDuration time.Duration
+	now         func() time.Time
+}
+
+func newPeerScores(banDuration time.Duration) *peerScores {
+	if banDuration == 0 {
+		banDuration = DefaultBanDuration
+	}
+	return &peerScores{
+		scores:      make(map[peer.ID]peerScore),
+		bans:        make(map[peer.ID]PeerBan),
+		banDuration: banDuration,
+		now:         time.Now,
+	}
+}
+
+// adjust adds delta to the score of the peer, clamped to the maximum score, and
+// returns true if the peer got banned by the adjustment.
+func (p *peerScores) adjust(pid peer.ID, delta int) bool {
+	if p == nil {
+		return false
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.bannedLocked(pid) {
+		return false
+	}
+	score := p.decayedLocked(pid) + delta
+	if score > maxPeerScore {
+		score = maxPeerScore
+	}
+	if score > BanScoreThreshold {
+		updated := p.now()
+		if current, ok := p.scores[pid]; ok {
+			updated = current.updated
+		}
+		p.scores[pid] = peerScore{value: score, updated: updated}
+		return false
+	}
+	for banned := range p.bans {
+		// Lifts the expired bans, so bans of peers which never come back do not
+		// pile up.
+		p.bannedLocked(banned)
+	}
+	p.bans[pid] = PeerBan{Peer: pid, Score: score, Expiry: p.now().Add(p.banDuration)}
+	delete(p.scores, pid)
+	bannedPeersMetric.Set(float64(len(p.bans)))
+	return true
+}
+
+// score returns the current score of the peer, or the score it was banned with.
+func (p *peerScores) score(pid peer.ID) int {
+	if p == nil {
+		return 0
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if ban, ok := p.bans[pid]; ok {
+		return ban.Score
+	}
+	return p.decayedLocked(pid)
+}
+
+// disconnected forgets the positive score of a peer once it disconnected, while
+// a negative score is kept until it decayed. The scores which fully decayed are
+// dropped, so the scores of peers which never come back do not pile up.
+func (p *peerScores) disconnected(pid peer.ID) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.decayedLocked(pid) > 0 {
+		delete(p.scores, pid)
+	}
+	for other := range p.scores {
+		p.decayedLocked(other)
+	}
+}
+
+// decayedLocked applies the decay of the score of the peer since it was last
+// updated, and returns the decayed score. A score which decayed to zero is
+// dropped. The caller must hold the lock.
+func (p *peerScores) decayedLocked(pid peer.ID) int {
+	score, ok := p.scores[pid]
+	if !ok {
+		return 0
+	}
+	now := p.now()
+	if score.value >= 0 {
+		score.updated = now
+	} else {
+		periods := now.Sub(score.updated) / scoreDecayPeriod
+		if int64(periods) >= int64(-score.value) {
+			score.value = 0
+		} else {
+			score.value += int(periods)
+		}
+		score.updated = score.updated.Add(periods * scoreDecayPeriod)
+	}
+	if score.value == 0 {
+		delete(p.scores, pid)
+		return 0
+	}
+	p.scores[pid] = score
+	return score.value
+}
+
+// isBanned returns true if the peer is banned.
+func (p *peerScores) isBanned(pid peer.ID) bool {
+	if p == nil {
+		return false
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.bannedLocked(pid)
+}
+
+// bannedLocked returns true if the peer is banned, lifting the ban of the peer if
+// it expired. The caller must hold the lock.
+func (p *peerScores) bannedLocked(pid peer.ID) bool {
+	ban, ok := p.bans[pid]
+	if !ok {
+		return false
+	}
+	if p.now().Before(ban.Expiry) {
+		return true
+	}
+	p.unbanLocked(pid)
+	return false
+}
+
+// unbanLocked lifts the ban of the peer, which starts over with a neutral score.
+// The caller must hold the lock.
+func (p *peerScores) unbanLocked(pid peer.ID) {
+	delete(p.bans, pid)
+	delete(p.scores, pid)
+	bannedPeersMetric.Set(float64(len(p.bans)))
+}
+
+// banList returns the peers currently banned, sorted by peer ID.
+func (p *peerScores) banList() []PeerBan {
+	if p == nil {
+		return nil
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	bans := make([]PeerBan, 0, len(p.bans))
+	for pid, ban := range p.bans {
+		if !p.bannedLocked(pid) {
+			continue
+		}
+		bans = append(bans, ban)
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].Peer < bans[j].Peer
+	})
+	return bans
+}
+
+// clearBans lifts the bans of the given peers, or of every peer if none is given,
+// and returns the number of bans lifted.
+func (p *peerScores) clearBans(pids []peer.ID) int {
+	if p == nil {
+		return 0
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if len(pids) == 0 {
+		for pid := range p.bans {
+			pids = append(pids, pid)
+		}
+	}
+	cleared := 0
+	for _, pid := range pids {
+		if _, ok := p.bans[pid]; ok {
+			p.unbanLocked(pid)
+			cleared++
+		}
+	}
+	return cleared
+}
+
+// setupPeerBans adds event handlers which disconnect banned peers as soon as they
+// connect, and drop the positive score and rate limit buckets of peers once they
+// disconnect. Bans and negative scores are kept across connections, so a peer
+// cannot clear its ban or its score by reconnecting.
+func setupPeerBans(h host.Host, scores *peerScores, limiter *rateLimiter) {
+	h.Network().Notify(&inet.NotifyBundle{
+		ConnectedF: func(net inet.Network, conn inet.Conn) {
+			if !scores.isBanned(conn.RemotePeer()) {
+				return
+			}
+			// Must be handled in a goroutine as this callback cannot be blocking.
+			go func() {
+				log.WithField("peer", conn.RemotePeer()).Debug("Disconnecting banned peer")
+				if err := net.ClosePeer(conn.RemotePeer()); err != nil {
+					log.WithError(err).Error("failed to disconnect peer")
+				}
+			}()
+		},
+		DisconnectedF: func(net inet.Network, conn inet.Conn) {
+			if net.Connectedness(conn.RemotePeer()) != inet.Connected {
+				scores.disconnected(conn.RemotePeer())
+				limiter.removePeer(conn.RemotePeer())
+			}
+		},
+	})
+}
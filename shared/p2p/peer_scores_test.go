@@ -0,0 +1,141 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bhost "github.com/libp2p/go-libp2p-blankhost"
+	libp2pnet "github.com/libp2p/go-libp2p-net"
+	peer "github.com/libp2p/go-libp2p-peer"
+	pstore "github.com/libp2p/go-libp2p-peerstore"
+	swarmt "github.com/libp2p/go-libp2p-swarm/testing"
+)
+
+func TestPeerScores_BansBelowThreshold(t *testing.T) {
+	scores := newPeerScores(time.Minute)
+	now := time.Unix(1000, 0)
+	scores.now = func() time.Time { return now }
+
+	for i := 0; i < 200; i++ {
+		scores.adjust("good", ScoreValidMessage)
+	}
+	if scores.score("good") != maxPeerScore {
+		t.Errorf("Expected score to be capped at %d, got %d", maxPeerScore, scores.score("good"))
+	}
+
+	banned := false
+	for i := 0; i < 4; i++ {
+		banned = scores.adjust("bad", ScoreMalformedMessage)
+	}
+	if !banned || !scores.isBanned("bad") {
+		t.Fatal("Expected peer to be banned once its score reached the threshold")
+	}
+	if scores.adjust("bad", ScoreMalformedMessage) {
+		t.Error("Expected a banned peer not to be banned again")
+	}
+	bans := scores.banList()
+	if len(bans) != 1 || bans[0].Peer != "bad" || !bans[0].Expiry.Equal(now.Add(time.Minute)) {
+		t.Errorf("Unexpected ban list %v", bans)
+	}
+
+	now = now.Add(time.Minute)
+	if scores.isBanned("bad") {
+		t.Error("Expected the ban to expire")
+	}
+	if scores.score("bad") != 0 {
+		t.Errorf("Expected an unbanned peer to start over with score 0, got %d", scores.score("bad"))
+	}
+}
+
+func TestPeerScores_KeepsNegativeScoresOfDisconnectedPeers(t *testing.T) {
+	scores := newPeerScores(0)
+	now := time.Unix(1000, 0)
+	scores.now = func() time.Time { return now }
+	scores.adjust("good", ScoreValidMessage)
+	scores.adjust("bad", ScoreInvalidBlock)
+	scores.adjust("banned", BanScoreThreshold)
+
+	scores.disconnected("good")
+	scores.disconnected("bad")
+	if scores.score("good") != 0 {
+		t.Errorf("Expected the positive score of a disconnected peer to be dropped, got %d", scores.score("good"))
+	}
+	if scores.score("bad") != ScoreInvalidBlock {
+		t.Errorf("Expected the negative score of a disconnected peer to be kept, got %d", scores.score("bad"))
+	}
+	if scores.score("banned") != BanScoreThreshold {
+		t.Errorf("Expected the banned peer to keep its ban score, got %d", scores.score("banned"))
+	}
+
+	now = now.Add(5*scoreDecayPeriod + scoreDecayPeriod/2)
+	if scores.score("bad") != ScoreInvalidBlock+5 {
+		t.Errorf("Expected the score to decay to %d, got %d", ScoreInvalidBlock+5, scores.score("bad"))
+	}
+	now = now.Add(scoreDecayPeriod / 2)
+	if scores.score("bad") != ScoreInvalidBlock+6 {
+		t.Errorf("Expected the score to decay to %d, got %d", ScoreInvalidBlock+6, scores.score("bad"))
+	}
+
+	now = now.Add(-ScoreInvalidBlock * scoreDecayPeriod)
+	scores.disconnected("other")
+	if len(scores.scores) != 0 {
+		t.Errorf("Expected the decayed scores to be dropped, got %v", scores.scores)
+	}
+}
+
+func TestPeerScores_ClearBans(t *testing.T) {
+	scores := newPeerScores(0)
+	for _, pid := range []peer.ID{"a", "b", "c"} {
+		scores.adjust(pid, BanScoreThreshold)
+	}
+	if cleared := scores.clearBans([]peer.ID{"a", "unknown"}); cleared != 1 {
+		t.Errorf("Expected 1 ban cleared, got %d", cleared)
+	}
+	if scores.isBanned("a") || !scores.isBanned("b") {
+		t.Error("Expected only the ban of peer a to be cleared")
+	}
+	if cleared := scores.clearBans(nil); cleared != 2 {
+		t.Errorf("Expected 2 bans cleared, got %d", cleared)
+	}
+	if len(scores.banList()) != 0 {
+		t.Errorf("Expected no bans left, got %v", scores.banList())
+	}
+}
+
+func TestReportPeer_DisconnectsBannedPeer(t *testing.T) {
+	ctx := context.Background()
+	hostA := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	hostB := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	s := &Server{host: hostA, scores: newPeerScores(0), rateLimiter: newRateLimiter(nil)}
+	setupPeerBans(hostA, s.scores, s.rateLimiter)
+
+	if err := hostA.Connect(ctx, pstore.PeerInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}); err != nil {
+		t.Fatal(err)
+	}
+	s.ReportPeer(hostB.ID(), ScoreInvalidBlock)
+	if hostA.Network().Connectedness(hostB.ID()) != libp2pnet.Connected {
+		t.Fatal("Expected peer to stay connected above the ban threshold")
+	}
+
+	s.ReportPeer(hostB.ID(), BanScoreThreshold)
+	if hostA.Network().Connectedness(hostB.ID()) == libp2pnet.Connected {
+		t.Fatal("Expected banned peer to be disconnected")
+	}
+
+	// A banned peer is disconnected again when it reconnects.
+	if err := hostB.Connect(ctx, pstore.PeerInfo{ID: hostA.ID(), Addrs: hostA.Addrs()}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if hostA.Network().Connectedness(hostB.ID()) == libp2pnet.Connected {
+		t.Error("Expected banned peer to be disconnected on reconnection")
+	}
+
+	if cleared := s.ClearPeerBans(hostB.ID()); cleared != 1 {
+		t.Errorf("Expected 1 ban cleared, got %d", cleared)
+	}
+	if len(s.PeerBans()) != 0 {
+		t.Errorf("Expected no bans left, got %v", s.PeerBans())
+	}
+}
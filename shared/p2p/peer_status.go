@@ -0,0 +1,99 @@
+package p2p
+
+import (
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// PeerStatuses is the table of the chain status each connected peer sent in its
+// handshake, with the head refreshed as peers report new chain heads. A peer is
+// removed from the table once it disconnects.
+type PeerStatuses struct {
+	lock     sync.RWMutex
+	statuses map[peer.ID]*pb.Handshake
+}
+
+// NewPeerStatuses creates an empty peer status table.
+func NewPeerStatuses() *PeerStatuses {
+	return &PeerStatuses{
+		statuses: make(map[peer.ID]*pb.Handshake),
+	}
+}
+
+// Set records the status of the peer.
+func (p *PeerStatuses) Set(pid peer.ID, status *pb.Handshake) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.statuses[pid] = status
+}
+
+// Get returns the status of the peer, if any.
+func (p *PeerStatuses) Get(pid peer.ID) (*pb.Handshake, bool) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	status, ok := p.statuses[pid]
+	return status, ok
+}
+
+// UpdateHead records a new head reported by a peer which completed its
+// handshake. The finalized checkpoint is kept as validated in the handshake.
+func (p *PeerStatuses) UpdateHead(pid peer.ID, headSlot uint64, headStateRoot []byte) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	status, ok := p.statuses[pid]
+	if !ok {
+		return
+	}
+	// The status is replaced rather than modified, as copies of the table share
+	// the statuses.
+	updated := proto.Clone(status).(*pb.Handshake)
+	updated.HeadSlot = headSlot
+	updated.HeadStateRootHash32 = headStateRoot
+	updated.HeadRootHash32 = nil
+	p.statuses[pid] = updated
+}
+
+// Remove deletes the status of the peer.
+func (p *PeerStatuses) Remove(pid peer.ID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.statuses, pid)
+}
+
+// Len returns the number of peers with a status.
+func (p *PeerStatuses) Len() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return len(p.statuses)
+}
+
+// All returns a copy of the table.
+func (p *PeerStatuses) All() map[peer.ID]*pb.Handshake {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	statuses := make(map[peer.ID]*pb.Handshake, len(p.statuses))
+	for pid, status := range p.statuses {
+		statuses[pid] = status
+	}
+	return statuses
+}
+
+// BestPeer returns the peer with the highest head slot, along with its status.
+// Ties are broken by the peer ID so the choice does not depend on map order.
+func (p *PeerStatuses) BestPeer() (peer.ID, *pb.Handshake) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	var bestPeer peer.ID
+	var bestStatus *pb.Handshake
+	for pid, status := range p.statuses {
+		if bestStatus == nil || status.HeadSlot > bestStatus.HeadSlot ||
+			(status.HeadSlot == bestStatus.HeadSlot && pid < bestPeer) {
+			bestPeer = pid
+			bestStatus = status
+		}
+	}
+	return bestPeer, bestStatus
+}
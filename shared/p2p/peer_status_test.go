@@ -0,0 +1,50 @@
+package p2p
+
+import (
+	"testing"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestPeerStatuses_BestPeer(t *testing.T) {
+	statuses := NewPeerStatuses()
+	if pid, status := statuses.BestPeer(); pid != "" || status != nil {
+		t.Errorf("Expected no best peer in an empty table, received %s", pid)
+	}
+
+	statuses.Set(peer.ID("a"), &pb.Handshake{HeadSlot: 10})
+	statuses.Set(peer.ID("b"), &pb.Handshake{HeadSlot: 20})
+	statuses.Set(peer.ID("c"), &pb.Handshake{HeadSlot: 20})
+	statuses.Set(peer.ID("d"), &pb.Handshake{HeadSlot: 15})
+	if pid, status := statuses.BestPeer(); pid != peer.ID("b") || status.HeadSlot != 20 {
+		t.Errorf("Expected peer b at slot 20 to be the best peer, received %s", pid)
+	}
+
+	statuses.Remove(peer.ID("b"))
+	if pid, _ := statuses.BestPeer(); pid != peer.ID("c") {
+		t.Errorf("Expected peer c to be the best peer, received %s", pid)
+	}
+	if statuses.Len() != 3 || len(statuses.All()) != 3 {
+		t.Errorf("Expected 3 peers in the table, received %d", statuses.Len())
+	}
+}
+
+func TestPeerStatuses_UpdateHead(t *testing.T) {
+	statuses := NewPeerStatuses()
+	statuses.Set(peer.ID("a"), &pb.Handshake{HeadSlot: 10, FinalizedEpoch: 1})
+	handshake, _ := statuses.Get(peer.ID("a"))
+
+	statuses.UpdateHead(peer.ID("a"), 30, []byte{'r'})
+	statuses.UpdateHead(peer.ID("b"), 40, []byte{'r'})
+	status, _ := statuses.Get(peer.ID("a"))
+	if status.HeadSlot != 30 || status.FinalizedEpoch != 1 {
+		t.Errorf("Expected the head of peer a to be updated, received %v", status)
+	}
+	if handshake.HeadSlot != 10 {
+		t.Error("Expected the status from the handshake not to be modified")
+	}
+	if _, ok := statuses.Get(peer.ID("b")); ok {
+		t.Error("Expected no status for a peer which did not complete its handshake")
+	}
+}
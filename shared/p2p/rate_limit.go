@@ -0,0 +1,94 @@
+package p2p
+
+import (
+	"sync"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// RateLimit is a token bucket limit on the messages a peer may send on a topic.
+// A peer may send Burst messages at once, and the bucket refills at Rate
+// messages per second.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// DefaultRateLimit applies to the topics without a configured rate limit.
+var DefaultRateLimit = RateLimit{Rate: 50, Burst: 100}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter keeps a token bucket per peer and topic. A nil limiter allows every
+// message.
+type rateLimiter struct {
+	lock    sync.Mutex
+	limits  map[string]RateLimit
+	buckets map[string]map[peer.ID]*tokenBucket
+	now     func() time.Time
+}
+
+func newRateLimiter(limits map[string]RateLimit) *rateLimiter {
+	l := make(map[string]RateLimit, len(limits))
+	for topic, limit := range limits {
+		l[topic] = limit
+	}
+	return &rateLimiter{
+		limits:  l,
+		buckets: make(map[string]map[peer.ID]*tokenBucket),
+		now:     time.Now,
+	}
+}
+
+// allow takes a token from the bucket of the peer on the topic and returns false
+// if the bucket is empty.
+func (r *rateLimiter) allow(topic string, pid peer.ID) bool {
+	if r == nil {
+		return true
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	limit, ok := r.limits[topic]
+	if !ok {
+		limit = DefaultRateLimit
+	}
+	peers, ok := r.buckets[topic]
+	if !ok {
+		peers = make(map[peer.ID]*tokenBucket)
+		r.buckets[topic] = peers
+	}
+	now := r.now()
+	bucket, ok := peers[pid]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(limit.Burst), last: now}
+		peers[pid] = bucket
+	}
+
+	bucket.tokens += now.Sub(bucket.last).Seconds() * limit.Rate
+	if bucket.tokens > float64(limit.Burst) {
+		bucket.tokens = float64(limit.Burst)
+	}
+	bucket.last = now
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// removePeer drops the buckets of the peer.
+func (r *rateLimiter) removePeer(pid peer.ID) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, peers := range r.buckets {
+		delete(peers, pid)
+	}
+}
@@ -0,0 +1,48 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_TokenBucket(t *testing.T) {
+	limiter := newRateLimiter(map[string]RateLimit{
+		"slow": {Rate: 1, Burst: 2},
+	})
+	now := time.Unix(1000, 0)
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if !limiter.allow("slow", "a") {
+			t.Fatalf("Expected message %d within the burst to be allowed", i)
+		}
+	}
+	if limiter.allow("slow", "a") {
+		t.Error("Expected message over the burst to be rejected")
+	}
+	if !limiter.allow("slow", "b") {
+		t.Error("Expected another peer to have its own bucket")
+	}
+
+	now = now.Add(time.Second)
+	if !limiter.allow("slow", "a") {
+		t.Error("Expected the bucket to refill over time")
+	}
+	if limiter.allow("slow", "a") {
+		t.Error("Expected the refilled bucket to hold a single token")
+	}
+
+	for i := 0; i < DefaultRateLimit.Burst; i++ {
+		if !limiter.allow("other", "a") {
+			t.Fatalf("Expected message %d within the default burst to be allowed", i)
+		}
+	}
+	if limiter.allow("other", "a") {
+		t.Error("Expected the default rate limit to apply to topics without a limit")
+	}
+
+	limiter.removePeer("a")
+	if !limiter.allow("slow", "a") {
+		t.Error("Expected a removed peer to start with a full bucket")
+	}
+}
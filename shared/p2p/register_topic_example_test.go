@@ -36,7 +36,7 @@ func ExampleServer_RegisterTopic() {
 	var topic string
 	var message proto.Message
 
-	s.RegisterTopic(topic, message, adapters...)
+	s.RegisterTopic(topic, message, nil, adapters...)
 
 	ch := make(chan p2p.Message)
 	sub := s.Subscribe(message, ch)
@@ -12,6 +12,7 @@ import (
 
 	ggio "github.com/gogo/protobuf/io"
 	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
 	ds "github.com/ipfs/go-datastore"
 	dsync "github.com/ipfs/go-datastore/sync"
 	"github.com/libp2p/go-libp2p"
@@ -34,6 +35,10 @@ import (
 
 const prysmProtocolPrefix = "/prysm/0.0.0"
 
+// maxGossipForwarders is the number of gossip messages whose forwarding peer is
+// remembered between their validation and their delivery to the topic handler.
+const maxGossipForwarders = 4096
+
 // We accommodate p2p message sizes as large as ~17Mb as we are transmitting
 // full beacon states over the wire for our current implementation.
 const maxMessageSize = 1 << 24
@@ -57,6 +62,10 @@ type Server struct {
 	bootstrapNode string
 	relayNodeAddr string
 	noDiscovery   bool
+	handshaker    *handshaker
+	scores        *peerScores
+	rateLimiter   *rateLimiter
+	forwarders    *lru.Cache
 }
 
 // ServerConfig for peer to peer networking.
@@ -67,6 +76,13 @@ type ServerConfig struct {
 	HostAddress            string
 	Port                   int
 	DepositContractAddress string
+	ChainConfigHash        []byte
+	// BanDuration is how long a misbehaving peer is banned, DefaultBanDuration
+	// if zero.
+	BanDuration time.Duration
+	// RateLimits are the limits on the messages each peer may send per topic.
+	// Topics without a limit use DefaultRateLimit.
+	RateLimits map[string]RateLimit
 }
 
 // NewServer creates a new p2p server instance.
@@ -125,12 +141,25 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 		}
 		info, err := peerInfoFromAddr(addr)
 		if err != nil {
+			cancel()
 			return nil, err
 		}
 		exclusions = append(exclusions, info.ID)
 	}
-	setupPeerNegotiation(h, cfg.DepositContractAddress, exclusions)
-	setHandshakeHandler(h, cfg.DepositContractAddress)
+	hs := newHandshaker(&pb.Handshake{
+		DepositContractAddress: cfg.DepositContractAddress,
+		ConfigHash:             cfg.ChainConfigHash,
+	})
+	setupPeerNegotiation(h, hs, exclusions)
+	setHandshakeHandler(h, hs)
+	scores := newPeerScores(cfg.BanDuration)
+	limiter := newRateLimiter(cfg.RateLimits)
+	setupPeerBans(h, scores, limiter)
+	forwarders, err := newGossipForwarders()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
 
 	return &Server{
 		ctx:           ctx,
@@ -144,9 +173,21 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 		bootstrapNode: cfg.BootstrapNodeAddr,
 		relayNodeAddr: cfg.RelayNodeAddr,
 		noDiscovery:   cfg.NoDiscovery,
+		handshaker:    hs,
+		scores:        scores,
+		rateLimiter:   limiter,
+		forwarders:    forwarders,
 	}, nil
 }
 
+func newGossipForwarders() (*lru.Cache, error) {
+	forwarders, err := lru.New(maxGossipForwarders)
+	if err != nil {
+		return nil, fmt.Errorf("could not create gossip forwarders cache: %v", err)
+	}
+	return forwarders, nil
+}
+
 func checkAvailablePort(port int) bool {
 	ip, err := iputils.ExternalIPv4()
 	if err != nil {
@@ -213,13 +254,60 @@ func (s *Server) Status() error {
 	return nil
 }
 
-// RegisterTopic with a message and the adapter stack for the given topic. The
-// message type provided will be feed selector for emitting messages received
-// on a given topic.
+// SetStatusHandler sets the handler providing the chain status sent in handshakes
+// and checking the chain status received from peers. It must be set before the
+// server starts, as handshakes are otherwise made without chain status.
+func (s *Server) SetStatusHandler(handler StatusHandler) {
+	s.handshaker.setStatusHandler(handler)
+}
+
+// PeerStatuses returns the table of the chain status of the connected peers, as
+// sent in their handshake.
+func (s *Server) PeerStatuses() *PeerStatuses {
+	return s.handshaker.statuses
+}
+
+// ReportPeer adds delta to the score of the peer, as reported for the outcome of
+// handling a message it sent. A peer whose score drops to BanScoreThreshold is
+// disconnected and banned.
+func (s *Server) ReportPeer(pid peer.ID, delta int) {
+	if !s.scores.adjust(pid, delta) {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"peer":  pid.Pretty(),
+		"score": s.scores.score(pid),
+	}).Warn("Banning misbehaving peer")
+	if err := s.host.Network().ClosePeer(pid); err != nil {
+		log.WithError(err).Error("failed to disconnect peer")
+	}
+}
+
+// PeerScore returns the score of the peer.
+func (s *Server) PeerScore(pid peer.ID) int {
+	return s.scores.score(pid)
+}
+
+// PeerBans returns the peers currently banned.
+func (s *Server) PeerBans() []PeerBan {
+	return s.scores.banList()
+}
+
+// ClearPeerBans lifts the bans of the given peers, or of every banned peer if
+// none is given, and returns the number of bans lifted.
+func (s *Server) ClearPeerBans(pids ...peer.ID) int {
+	return s.scores.clearBans(pids)
+}
+
+// RegisterTopic with a message, an optional validator and the adapter stack for
+// the given topic. The message type provided will be feed selector for emitting
+// messages received on a given topic.
 //
 // The topics can originate from multiple sources. In other words, messages on
-// TopicA may come from direct peer communication or a pub/sub channel.
-func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...Adapter) {
+// TopicA may come from direct peer communication or a pub/sub channel. The
+// validator checks the messages received on the pub/sub channel, which are
+// dropped and never forwarded to other peers if they fail the check.
+func (s *Server) RegisterTopic(topic string, message proto.Message, validator TopicValidator, adapters ...Adapter) {
 	log.WithFields(logrus.Fields{
 		"topic": topic,
 	}).Debug("Subscribing to topic")
@@ -227,6 +315,13 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 	msgType := messageType(message)
 	s.topicMapping[msgType] = topic
 
+	// Every gossip topic is validated, as the validator records the peer which
+	// forwarded each message.
+	if err := s.gsub.RegisterTopicValidator(topic, s.gossipValidator(message, validator)); err != nil {
+		log.Errorf("Failed to register topic validator: %v", err)
+		return
+	}
+
 	sub, err := s.gsub.Subscribe(topic)
 	if err != nil {
 		log.Errorf("Failed to subscribe to topic: %v", err)
@@ -241,6 +336,18 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 	}
 
 	handler := func(msg *pb.Envelope, peerID peer.ID) {
+		if s.scores.isBanned(peerID) {
+			log.WithField("peer", peerID.Pretty()).Debug("Dropping message from banned peer")
+			return
+		}
+		if !s.rateLimiter.allow(topic, peerID) {
+			log.WithFields(logrus.Fields{
+				"peer":  peerID.Pretty(),
+				"topic": topic,
+			}).Debug("Dropping message from peer over the rate limit")
+			s.ReportPeer(peerID, ScoreRateLimited)
+			return
+		}
 		log.WithField("topic", topic).Debug("Processing incoming message")
 		var h Handler = func(pMsg Message) {
 			s.emit(pMsg, feed)
@@ -262,7 +369,9 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 
 		data := proto.Clone(message)
 		if err := proto.Unmarshal(msg.Payload, data); err != nil {
-			log.Error("Could not unmarshal payload")
+			log.WithError(err).WithField("peer", peerID.Pretty()).Error("Could not unmarshal payload")
+			s.ReportPeer(peerID, ScoreMalformedMessage)
+			return
 		}
 		pMsg := Message{Ctx: ctx, Data: data, Peer: peerID}
 		for _, adapter := range adapters {
@@ -325,17 +434,71 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 				continue
 			}
 
+			// The sender announced by a message can be forged, so the message is
+			// attributed to the peer it was received from.
+			pid, ok := s.gossipForwarder(msg)
+			if !ok {
+				log.WithField("topic", topic).Debug("Dropping gossip message from unknown peer")
+				continue
+			}
+
 			d := &pb.Envelope{}
 			if err := proto.Unmarshal(msg.Data, d); err != nil {
 				log.WithError(err).Error("Failed to decode data")
+				s.ReportPeer(pid, ScoreMalformedMessage)
 				continue
 			}
 
-			handler(d, msg.GetFrom())
+			handler(d, pid)
 		}
 	}()
 }
 
+// gossipValidator wraps the validator of a topic into a pub/sub validator, which
+// decodes the messages received from peers for the validator, and records the
+// peer which forwarded each valid message. Messages published by the node
+// itself are not checked.
+func (s *Server) gossipValidator(message proto.Message, validator TopicValidator) pubsub.Validator {
+	return func(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+		if pid == s.host.ID() {
+			return true
+		}
+		envelope := &pb.Envelope{}
+		if err := proto.Unmarshal(msg.Data, envelope); err != nil {
+			log.WithError(err).Error("Failed to decode data")
+			s.ReportPeer(pid, ScoreMalformedMessage)
+			return false
+		}
+		data := proto.Clone(message)
+		if err := proto.Unmarshal(envelope.Payload, data); err != nil {
+			log.WithError(err).Error("Failed to decode data")
+			s.ReportPeer(pid, ScoreMalformedMessage)
+			return false
+		}
+		if validator != nil && !validator(ctx, data, pid) {
+			return false
+		}
+		s.forwarders.Add(gossipID(msg), pid)
+		return true
+	}
+}
+
+// gossipForwarder returns the peer which forwarded a validated gossip message.
+func (s *Server) gossipForwarder(msg *pubsub.Message) (peer.ID, bool) {
+	id := gossipID(msg)
+	pid, ok := s.forwarders.Get(id)
+	if !ok {
+		return "", false
+	}
+	s.forwarders.Remove(id)
+	return pid.(peer.ID), true
+}
+
+// gossipID identifies a gossip message the way pub/sub does.
+func gossipID(msg *pubsub.Message) string {
+	return string(msg.GetFrom()) + string(msg.GetSeqno())
+}
+
 // Attempts to convert some proto.Message to a string in a panic safe method.
 func attemptToConvertPbToString(b []byte, msg proto.Message) string {
 	defer func() {
@@ -423,7 +586,7 @@ func (s *Server) Send(ctx context.Context, msg proto.Message, peerID peer.ID) er
 // or if the server is unable to publish the message over gossipsub.
 //
 //   msg := make(chan p2p.Message, 100) // Choose a reasonable buffer size!
-//   ps.RegisterTopic("message_topic_here", msg)
+//   ps.RegisterTopic("message_topic_here", msg, nil)
 //   ps.Broadcast(msg)
 func (s *Server) Broadcast(ctx context.Context, msg proto.Message) {
 	defer func() {
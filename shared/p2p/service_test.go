@@ -12,10 +12,13 @@ import (
 	ggio "github.com/gogo/protobuf/io"
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
+	lru "github.com/hashicorp/golang-lru"
 	bhost "github.com/libp2p/go-libp2p-blankhost"
+	peer "github.com/libp2p/go-libp2p-peer"
 	pstore "github.com/libp2p/go-libp2p-peerstore"
 	protocol "github.com/libp2p/go-libp2p-protocol"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	swarmt "github.com/libp2p/go-libp2p-swarm/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	shardpb "github.com/prysmaticlabs/prysm/proto/sharding/p2p/v1"
@@ -115,6 +118,7 @@ func TestSubscribeToTopic_onPubSub_OK(t *testing.T) {
 		feeds:        make(map[reflect.Type]Feed),
 		mutex:        &sync.Mutex{},
 		topicMapping: make(map[reflect.Type]string),
+		forwarders:   testForwarders(t),
 	}
 
 	feed := s.Feed(&shardpb.CollationBodyRequest{})
@@ -142,6 +146,7 @@ func TestSubscribeToTopic_directMessaging_OK(t *testing.T) {
 		feeds:        make(map[reflect.Type]Feed),
 		mutex:        &sync.Mutex{},
 		topicMapping: make(map[reflect.Type]string),
+		forwarders:   testForwarders(t),
 	}
 
 	feed := s.Feed(&shardpb.CollationBodyRequest{})
@@ -150,7 +155,7 @@ func TestSubscribeToTopic_directMessaging_OK(t *testing.T) {
 	defer sub.Unsubscribe()
 	topic := shardpb.Topic_COLLATION_BODY_REQUEST
 
-	s.RegisterTopic(topic.String(), &shardpb.CollationBodyRequest{})
+	s.RegisterTopic(topic.String(), &shardpb.CollationBodyRequest{}, nil)
 	pbMsg := &shardpb.CollationBodyRequest{ShardId: 5}
 
 	done := make(chan bool)
@@ -202,6 +207,7 @@ func TestSubscribe_OK(t *testing.T) {
 		feeds:        make(map[reflect.Type]Feed),
 		mutex:        &sync.Mutex{},
 		topicMapping: make(map[reflect.Type]string),
+		forwarders:   testForwarders(t),
 	}
 
 	ch := make(chan Message)
@@ -214,7 +220,7 @@ func TestSubscribe_OK(t *testing.T) {
 func testSubscribe(ctx context.Context, t *testing.T, s Server, gsub *pubsub.PubSub, ch chan Message) {
 	topic := shardpb.Topic_COLLATION_BODY_REQUEST
 
-	s.RegisterTopic(topic.String(), &shardpb.CollationBodyRequest{})
+	s.RegisterTopic(topic.String(), &shardpb.CollationBodyRequest{}, nil)
 
 	// Short delay to let goroutine add subscription.
 	time.Sleep(time.Millisecond * 10)
@@ -272,9 +278,10 @@ func TestRegisterTopic_InvalidProtobufs(t *testing.T) {
 		feeds:        make(map[reflect.Type]Feed),
 		mutex:        &sync.Mutex{},
 		topicMapping: make(map[reflect.Type]string),
+		forwarders:   testForwarders(t),
 	}
 
-	s.RegisterTopic(topic.String(), &shardpb.CollationBodyRequest{})
+	s.RegisterTopic(topic.String(), &shardpb.CollationBodyRequest{}, nil)
 	ch := make(chan Message)
 	sub := s.Subscribe(&shardpb.CollationBodyRequest{}, ch)
 	defer sub.Unsubscribe()
@@ -295,6 +302,80 @@ func TestRegisterTopic_InvalidProtobufs(t *testing.T) {
 	}
 }
 
+func TestRegisterTopic_ValidatorDropsInvalidMessages(t *testing.T) {
+	topic := shardpb.Topic_COLLATION_BODY_REQUEST
+	ctx, cancel := context.WithTimeout(context.TODO(), 1*time.Second)
+	defer cancel()
+	h := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	h2 := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+
+	gsub, err := pubsub.NewFloodSub(ctx, h2)
+	if err != nil {
+		t.Errorf("Failed to create floodsub: %v", err)
+	}
+
+	s := Server{
+		ctx:          ctx,
+		gsub:         gsub,
+		host:         h,
+		feeds:        make(map[reflect.Type]Feed),
+		mutex:        &sync.Mutex{},
+		topicMapping: make(map[reflect.Type]string),
+		forwarders:   testForwarders(t),
+	}
+
+	validator := func(ctx context.Context, msg proto.Message, pid peer.ID) bool {
+		return msg.(*shardpb.CollationBodyRequest).ShardId != 5
+	}
+	s.RegisterTopic(topic.String(), &shardpb.CollationBodyRequest{}, validator)
+	ch := make(chan Message)
+	sub := s.Subscribe(&shardpb.CollationBodyRequest{}, ch)
+	defer sub.Unsubscribe()
+
+	// Short delay to let goroutine add subscription.
+	time.Sleep(time.Millisecond * 10)
+
+	for _, shardID := range []uint64{5, 6} {
+		pbMsg := &shardpb.CollationBodyRequest{ShardId: shardID}
+		if err = gsub.Publish(topic.String(), createEnvelopeBytes(t, pbMsg)); err != nil {
+			t.Errorf("Failed to publish message: %v", err)
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		t.Error("Context timed out before a message was received!")
+	case msg := <-ch:
+		if shardID := msg.Data.(*shardpb.CollationBodyRequest).ShardId; shardID != 6 {
+			t.Errorf("Expected the message failing validation to be dropped, received shard %d", shardID)
+		}
+	}
+}
+
+func TestGossipValidator_RecordsForwardingPeer(t *testing.T) {
+	ctx := context.Background()
+	h := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	s := Server{host: h, forwarders: testForwarders(t)}
+	validate := s.gossipValidator(&shardpb.CollationBodyRequest{}, nil)
+
+	// The message claims to come from another peer than the one forwarding it.
+	msg := &pubsub.Message{Message: &pubsubpb.Message{
+		From:  []byte("forged"),
+		Data:  createEnvelopeBytes(t, &shardpb.CollationBodyRequest{ShardId: 5}),
+		Seqno: []byte{1},
+	}}
+	if !validate(ctx, "forwarder", msg) {
+		t.Fatal("Expected the message to pass validation")
+	}
+	pid, ok := s.gossipForwarder(msg)
+	if !ok || pid != "forwarder" {
+		t.Errorf("Expected the message to be attributed to the forwarding peer, received %q", pid)
+	}
+	if _, ok := s.gossipForwarder(msg); ok {
+		t.Error("Expected the forwarding peer to be forgotten once the message is delivered")
+	}
+}
+
 func TestRegisterTopic_WithoutAdapters(t *testing.T) {
 	s, err := NewServer(&ServerConfig{})
 	if err != nil {
@@ -303,7 +384,7 @@ func TestRegisterTopic_WithoutAdapters(t *testing.T) {
 	topic := testTopic
 	testMessage := &testpb.TestMessage{Foo: bar}
 
-	s.RegisterTopic(topic, testMessage)
+	s.RegisterTopic(topic, testMessage, nil)
 
 	ch := make(chan Message)
 	sub := s.Subscribe(testMessage, ch)
@@ -355,7 +436,7 @@ func TestRegisterTopic_WithAdapters(t *testing.T) {
 		testAdapter,
 	}
 
-	s.RegisterTopic(topic, testMessage, adapters...)
+	s.RegisterTopic(topic, testMessage, nil, adapters...)
 
 	ch := make(chan Message)
 	sub := s.Subscribe(testMessage, ch)
@@ -402,7 +483,7 @@ func TestRegisterTopic_HandlesPanic(t *testing.T) {
 		}
 	}
 
-	s.RegisterTopic(topic, testMessage, panicAdapter)
+	s.RegisterTopic(topic, testMessage, nil, panicAdapter)
 
 	ch := make(chan Message)
 	sub := s.Subscribe(testMessage, ch)
@@ -443,7 +524,7 @@ func simulateIncomingMessage(t *testing.T, s *Server, topic string, msg proto.Me
 	ctx := context.Background()
 	h := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
 
-	setHandshakeHandler(h, "")
+	setHandshakeHandler(h, newHandshaker(&pb.Handshake{}))
 
 	gsub, err := pubsub.NewFloodSub(ctx, h)
 	if err != nil {
@@ -495,3 +576,11 @@ func logContains(t *testing.T, hook *logTest.Hook, message string, level logrus.
 	}
 	t.Errorf("Expected log to contain level=%s and msg=\"%s\" inside log entries: %s", level, message, logs)
 }
+
+func testForwarders(t *testing.T) *lru.Cache {
+	forwarders, err := newGossipForwarders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return forwarders
+}
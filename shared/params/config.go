@@ -4,91 +4,89 @@ package params
 
 import (
 	"math/big"
-	"time"
 )
 
 // BeaconChainConfig contains constant configs for node to participate in beacon chain.
 type BeaconChainConfig struct {
 	// Misc constants.
-	ShardCount                   uint64 // ShardCount is the number of shard chains in Ethereum 2.0.
-	TargetCommitteeSize          uint64 // TargetCommitteeSize is the number of validators in a committee when the chain is healthy.
-	MaxBalanceChurnQuotient      uint64 // MaxBalanceChurnQuotient is used to determine how many validators can rotate per epoch.
-	BeaconChainShardNumber       uint64 // BeaconChainShardNumber is the shard number of the beacon chain.
-	MaxIndicesPerSlashableVote   uint64 // MaxIndicesPerSlashableVote is used to determine how many validators can be slashed per vote.
-	LatestBlockRootsLength       uint64 // LatestBlockRootsLength is the number of block roots kept in the beacon state.
-	LatestRandaoMixesLength      uint64 // LatestRandaoMixesLength is the number of randao mixes kept in the beacon state.
-	LatestSlashedExitLength      uint64 // LatestSlashedExitLength is used to track penalized exit balances per time interval.
-	LatestActiveIndexRootsLength uint64 // LatestIndexRootsLength is the number of index roots kept in beacon state, used by light client.
-	MaxExitDequeuesPerEpoch      uint64 // MaxWithdrawalsPerEpoch is the max withdrawals can happen for a single epoch.
-	ValidatorPrivkeyFileName     string // ValidatorPrivKeyFileName specifies the string name of a validator private key file.
-	WithdrawalPrivkeyFileName    string // WithdrawalPrivKeyFileName specifies the string name of a withdrawal private key file.
-	BLSPubkeyLength              int    // BLSPubkeyLength defines the expected length of BLS public keys in bytes.
-	DefaultBufferSize            int    // DefaultBufferSize for channels across the Prysm repository.
-	HashCacheSize                int64  // HashCacheSize defines the size of object hashes that are cached.
+	ShardCount                   uint64 `yaml:"shard_count"`                      // ShardCount is the number of shard chains in Ethereum 2.0.
+	TargetCommitteeSize          uint64 `yaml:"target_committee_size"`            // TargetCommitteeSize is the number of validators in a committee when the chain is healthy.
+	MaxBalanceChurnQuotient      uint64 `yaml:"max_balance_churn_quotient"`       // MaxBalanceChurnQuotient is used to determine how many validators can rotate per epoch.
+	BeaconChainShardNumber       uint64 `yaml:"beacon_chain_shard_number"`        // BeaconChainShardNumber is the shard number of the beacon chain.
+	MaxIndicesPerSlashableVote   uint64 `yaml:"max_indices_per_slashable_vote"`   // MaxIndicesPerSlashableVote is used to determine how many validators can be slashed per vote.
+	LatestBlockRootsLength       uint64 `yaml:"latest_block_roots_length"`        // LatestBlockRootsLength is the number of block roots kept in the beacon state.
+	LatestRandaoMixesLength      uint64 `yaml:"latest_randao_mixes_length"`       // LatestRandaoMixesLength is the number of randao mixes kept in the beacon state.
+	LatestSlashedExitLength      uint64 `yaml:"latest_slashed_exit_length"`       // LatestSlashedExitLength is used to track penalized exit balances per time interval.
+	LatestActiveIndexRootsLength uint64 `yaml:"latest_active_index_roots_length"` // LatestIndexRootsLength is the number of index roots kept in beacon state, used by light client.
+	MaxExitDequeuesPerEpoch      uint64 `yaml:"max_exit_dequeues_per_epoch"`      // MaxWithdrawalsPerEpoch is the max withdrawals can happen for a single epoch.
+	ValidatorPrivkeyFileName     string `yaml:"validator_privkey_file_name"`      // ValidatorPrivKeyFileName specifies the string name of a validator private key file.
+	WithdrawalPrivkeyFileName    string `yaml:"withdrawal_privkey_file_name"`     // WithdrawalPrivKeyFileName specifies the string name of a withdrawal private key file.
+	BLSPubkeyLength              int    `yaml:"bls_pubkey_length"`                // BLSPubkeyLength defines the expected length of BLS public keys in bytes.
+	DefaultBufferSize            int    `yaml:"default_buffer_size"`              // DefaultBufferSize for channels across the Prysm repository.
+	HashCacheSize                int64  `yaml:"hash_cache_size"`                  // HashCacheSize defines the size of object hashes that are cached.
 
 	// BLS domain values.
-	DomainDeposit     uint64 // DomainDeposit defines the BLS signature domain for deposit verification.
-	DomainAttestation uint64 // DomainAttestation defines the BLS signature domain for attestation verification.
-	DomainProposal    uint64 // DomainProposal defines the BLS signature domain for proposal verification.
-	DomainExit        uint64 // DomainExit defines the BLS signature domain for exit verification.
-	DomainRandao      uint64 // DomainRandao defines the BLS signature domain for randao verification.
-	DomainTransfer    uint64 // DomainTransfer defines the BLS signature domain for transfer verification.
+	DomainDeposit     uint64 `yaml:"domain_deposit"`     // DomainDeposit defines the BLS signature domain for deposit verification.
+	DomainAttestation uint64 `yaml:"domain_attestation"` // DomainAttestation defines the BLS signature domain for attestation verification.
+	DomainProposal    uint64 `yaml:"domain_proposal"`    // DomainProposal defines the BLS signature domain for proposal verification.
+	DomainExit        uint64 `yaml:"domain_exit"`        // DomainExit defines the BLS signature domain for exit verification.
+	DomainRandao      uint64 `yaml:"domain_randao"`      // DomainRandao defines the BLS signature domain for randao verification.
+	DomainTransfer    uint64 `yaml:"domain_transfer"`    // DomainTransfer defines the BLS signature domain for transfer verification.
 
 	// Deposit contract constants.
-	DepositContractAddress   []byte // DepositContractAddress is the address of the deposit contract in PoW chain.
-	DepositContractTreeDepth uint64 // Depth of the Merkle trie of deposits in the validator deposit contract on the PoW chain.
+	DepositContractAddress   []byte `yaml:"deposit_contract_address"`    // DepositContractAddress is the address of the deposit contract in PoW chain.
+	DepositContractTreeDepth uint64 `yaml:"deposit_contract_tree_depth"` // Depth of the Merkle trie of deposits in the validator deposit contract on the PoW chain.
 
 	// Gwei Values
-	MinDepositAmount           uint64 // MinDepositAmount is the maximal amount of Gwei a validator can send to the deposit contract at once.
-	MaxDepositAmount           uint64 // MaxDepositAmount is the maximal amount of Gwei a validator can send to the deposit contract at once.
-	EjectionBalance            uint64 // EjectionBalance is the minimal GWei a validator needs to have before ejected.
-	ForkChoiceBalanceIncrement uint64 // ForkChoiceBalanceIncrement is used to track block score based on balances for fork choice.
+	MinDepositAmount           uint64 `yaml:"min_deposit_amount"`            // MinDepositAmount is the maximal amount of Gwei a validator can send to the deposit contract at once.
+	MaxDepositAmount           uint64 `yaml:"max_deposit_amount"`            // MaxDepositAmount is the maximal amount of Gwei a validator can send to the deposit contract at once.
+	EjectionBalance            uint64 `yaml:"ejection_balance"`              // EjectionBalance is the minimal GWei a validator needs to have before ejected.
+	ForkChoiceBalanceIncrement uint64 `yaml:"fork_choice_balance_increment"` // ForkChoiceBalanceIncrement is used to track block score based on balances for fork choice.
 
 	// Initial value constants.
-	GenesisForkVersion      uint64   // GenesisForkVersion is used to track fork version between state transitions.
-	GenesisSlot             uint64   // GenesisSlot is used to initialize the genesis state fields.
-	GenesisEpoch            uint64   // GenesisEpoch is used to initialize epoch.
-	GenesisStartShard       uint64   // GenesisStartShard is the first shard to assign validators.
-	ZeroHash                [32]byte // ZeroHash is used to represent a zeroed out 32 byte array.
-	EmptySignature          [96]byte // EmptySignature is used to represent a zeroed out BLS Signature.
-	BLSWithdrawalPrefixByte byte     // BLSWithdrawalPrefixByte is used for BLS withdrawal and it's the first byte.
+	GenesisForkVersion      uint64   `yaml:"genesis_fork_version"`       // GenesisForkVersion is used to track fork version between state transitions.
+	GenesisSlot             uint64   `yaml:"genesis_slot"`               // GenesisSlot is used to initialize the genesis state fields.
+	GenesisEpoch            uint64   `yaml:"genesis_epoch"`              // GenesisEpoch is used to initialize epoch.
+	GenesisStartShard       uint64   `yaml:"genesis_start_shard"`        // GenesisStartShard is the first shard to assign validators.
+	ZeroHash                [32]byte `yaml:"-"`                          // ZeroHash is used to represent a zeroed out 32 byte array.
+	EmptySignature          [96]byte `yaml:"-"`                          // EmptySignature is used to represent a zeroed out BLS Signature.
+	BLSWithdrawalPrefixByte byte     `yaml:"bls_withdrawal_prefix_byte"` // BLSWithdrawalPrefixByte is used for BLS withdrawal and it's the first byte.
 
 	// Time parameters constants.
-	SecondsPerSlot               uint64 // SecondsPerSlot is how many seconds are in a single slot.
-	MinAttestationInclusionDelay uint64 // MinAttestationInclusionDelay defines how long validator has to wait to include attestation for beacon block.
-	SlotsPerEpoch                uint64 // SlotsPerEpoch is the number of slots in an epoch.
-	MinSeedLookahead             uint64 // SeedLookahead is the duration of randao look ahead seed.
-	ActivationExitDelay          uint64 // EntryExitDelay is the duration a validator has to wait for entry and exit in epoch.
-	EpochsPerEth1VotingPeriod    uint64 //  defines how often the merkle root of deposit receipts get updated in beacon node.
-	Eth1FollowDistance           uint64 // Eth1FollowDistance is the number of eth1.0 blocks to wait before considering a new deposit for voting. This only applies after the chain as been started.
-	MinValidatorWithdrawalDelay  uint64 // MinValidatorWithdrawalEpochs is the shortest amount of time a validator can get the deposit out.
-	FarFutureEpoch               uint64 // FarFutureEpoch represents a epoch extremely far away in the future used as the default penalization slot for validators.
+	SecondsPerSlot               uint64 `yaml:"seconds_per_slot"`                // SecondsPerSlot is how many seconds are in a single slot.
+	MinAttestationInclusionDelay uint64 `yaml:"min_attestation_inclusion_delay"` // MinAttestationInclusionDelay defines how long validator has to wait to include attestation for beacon block.
+	SlotsPerEpoch                uint64 `yaml:"slots_per_epoch"`                 // SlotsPerEpoch is the number of slots in an epoch.
+	MinSeedLookahead             uint64 `yaml:"min_seed_lookahead"`              // SeedLookahead is the duration of randao look ahead seed.
+	ActivationExitDelay          uint64 `yaml:"activation_exit_delay"`           // EntryExitDelay is the duration a validator has to wait for entry and exit in epoch.
+	EpochsPerEth1VotingPeriod    uint64 `yaml:"epochs_per_eth1_voting_period"`   //  defines how often the merkle root of deposit receipts get updated in beacon node.
+	Eth1FollowDistance           uint64 `yaml:"eth1_follow_distance"`            // Eth1FollowDistance is the number of eth1.0 blocks to wait before considering a new deposit for voting. This only applies after the chain as been started.
+	MinValidatorWithdrawalDelay  uint64 `yaml:"min_validator_withdrawal_delay"`  // MinValidatorWithdrawalEpochs is the shortest amount of time a validator can get the deposit out.
+	FarFutureEpoch               uint64 `yaml:"far_future_epoch"`                // FarFutureEpoch represents a epoch extremely far away in the future used as the default penalization slot for validators.
 
 	// Reward and penalty quotients constants.
-	BaseRewardQuotient                 uint64 // BaseRewardQuotient is used to calculate validator per-slot interest rate.
-	WhistlerBlowerRewardQuotient       uint64 // WhistlerBlowerRewardQuotient is used to calculate whistler blower reward.
-	AttestationInclusionRewardQuotient uint64 // IncluderRewardQuotient defines the reward quotient of proposer for including attestations..
-	InactivityPenaltyQuotient          uint64 // InactivityPenaltyQuotient defines how much validator leaks out balances for offline.
-	GweiPerEth                         uint64 // GweiPerEth is the amount of gwei corresponding to 1 eth.
+	BaseRewardQuotient                 uint64 `yaml:"base_reward_quotient"`                  // BaseRewardQuotient is used to calculate validator per-slot interest rate.
+	WhistlerBlowerRewardQuotient       uint64 `yaml:"whistler_blower_reward_quotient"`       // WhistlerBlowerRewardQuotient is used to calculate whistler blower reward.
+	AttestationInclusionRewardQuotient uint64 `yaml:"attestation_inclusion_reward_quotient"` // IncluderRewardQuotient defines the reward quotient of proposer for including attestations..
+	InactivityPenaltyQuotient          uint64 `yaml:"inactivity_penalty_quotient"`           // InactivityPenaltyQuotient defines how much validator leaks out balances for offline.
+	GweiPerEth                         uint64 `yaml:"gwei_per_eth"`                          // GweiPerEth is the amount of gwei corresponding to 1 eth.
 
 	// Max operations per block constants.
-	MaxVoluntaryExits    uint64 // MaxVoluntaryExits determines the maximum number of validator exits in a block.
-	MaxDeposits          uint64 // MaxVoluntaryExits determines the maximum number of validator deposits in a block.
-	MaxAttestations      uint64 // MaxAttestations defines the maximum allowed attestations in a beacon block.
-	MaxProposerSlashings uint64 // MaxProposerSlashings defines the maximum number of slashings of proposers possible in a block.
-	MaxAttesterSlashings uint64 // MaxAttesterSlashings defines the maximum number of casper FFG slashings possible in a block.
+	MaxVoluntaryExits    uint64 `yaml:"max_voluntary_exits"`    // MaxVoluntaryExits determines the maximum number of validator exits in a block.
+	MaxDeposits          uint64 `yaml:"max_deposits"`           // MaxVoluntaryExits determines the maximum number of validator deposits in a block.
+	MaxAttestations      uint64 `yaml:"max_attestations"`       // MaxAttestations defines the maximum allowed attestations in a beacon block.
+	MaxProposerSlashings uint64 `yaml:"max_proposer_slashings"` // MaxProposerSlashings defines the maximum number of slashings of proposers possible in a block.
+	MaxAttesterSlashings uint64 `yaml:"max_attester_slashings"` // MaxAttesterSlashings defines the maximum number of casper FFG slashings possible in a block.
 
 	// Prysm constants.
-	DepositsForChainStart   uint64        // DepositsForChainStart defines how many validator deposits needed to kick off beacon chain.
-	RandBytes               uint64        // RandBytes is the number of bytes used as entropy to shuffle validators.
-	SyncPollingInterval     int64         // SyncPollingInterval queries network nodes for sync status.
-	BatchBlockLimit         uint64        // BatchBlockLimit is maximum number of blocks that can be requested for initial sync.
-	SyncEpochLimit          uint64        // SyncEpochLimit is the number of epochs the current node can be behind before it requests for the latest state.
-	MaxNumLog2Validators    uint64        // MaxNumLog2Validators is the Max number of validators in Log2 exists given total ETH supply.
-	LogBlockDelay           int64         // Number of blocks to wait from the current head before processing logs from the deposit contract.
-	RPCSyncCheck            time.Duration // Number of seconds to query the sync service, to find out if the node is synced or not.
-	TestnetContractEndpoint string        // TestnetContractEndpoint to fetch the contract address of the Prysmatic Labs testnet.
-	GoerliBlockTime         uint64        // GoerliBlockTime is the number of seconds on avg a Goerli block is created.
+	DepositsForChainStart   uint64 `yaml:"deposits_for_chain_start"`  // DepositsForChainStart defines how many validator deposits needed to kick off beacon chain.
+	RandBytes               uint64 `yaml:"rand_bytes"`                // RandBytes is the number of bytes used as entropy to shuffle validators.
+	SyncPollingInterval     int64  `yaml:"sync_polling_interval"`     // SyncPollingInterval queries network nodes for sync status.
+	BatchBlockLimit         uint64 `yaml:"batch_block_limit"`         // BatchBlockLimit is maximum number of blocks that can be requested for initial sync.
+	SyncEpochLimit          uint64 `yaml:"sync_epoch_limit"`          // SyncEpochLimit is the number of epochs the current node can be behind before it requests for the latest state.
+	MaxNumLog2Validators    uint64 `yaml:"max_num_log2_validators"`   // MaxNumLog2Validators is the Max number of validators in Log2 exists given total ETH supply.
+	LogBlockDelay           int64  `yaml:"log_block_delay"`           // Number of blocks to wait from the current head before processing logs from the deposit contract.
+	TestnetContractEndpoint string `yaml:"testnet_contract_endpoint"` // TestnetContractEndpoint to fetch the contract address of the Prysmatic Labs testnet.
+	GoerliBlockTime         uint64 `yaml:"goerli_block_time"`         // GoerliBlockTime is the number of seconds on avg a Goerli block is created.
 }
 
 // DepositContractConfig contains the deposits for
@@ -177,8 +175,7 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	RandBytes:             3,
 	BatchBlockLimit:       64 * 4, // Process blocks in batches of 4 epochs of blocks (threshold before casper penalties).
 	MaxNumLog2Validators:  24,
-	LogBlockDelay:         2, //
-	RPCSyncCheck:          1,
+	LogBlockDelay:         2,  //
 	GoerliBlockTime:       14, // 14 seconds on average for a goerli block to be created.
 
 	// Testnet misc values.
@@ -5,9 +5,10 @@ import (
 )
 
 func TestOverrideBeaconConfig(t *testing.T) {
-	cfg := BeaconConfig()
+	defer OverrideBeaconConfig(BeaconConfig())
+	cfg := *BeaconConfig()
 	cfg.ShardCount = 5
-	OverrideBeaconConfig(cfg)
+	OverrideBeaconConfig(&cfg)
 	if c := BeaconConfig(); c.ShardCount != 5 {
 		t.Errorf("Shardcount in BeaconConfig incorrect. Wanted %d, got %d", 5, c.ShardCount)
 	}
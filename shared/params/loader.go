@@ -0,0 +1,162 @@
+package params
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/go-yaml/yaml"
+)
+
+// LoadChainConfigFile reads the beacon chain config from the YAML file at the
+// given path, validates it and uses it for the beacon chain services. The deposit
+// contract config takes the values of the keys of the same name.
+func LoadChainConfigFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	c, err := UnmarshalConfig(data)
+	if err != nil {
+		return err
+	}
+	OverrideBeaconConfig(c)
+	contractConfig = &DepositContractConfig{
+		DepositsForChainStart: new(big.Int).SetUint64(c.DepositsForChainStart),
+		MinDepositAmount:      new(big.Int).SetUint64(c.MinDepositAmount),
+		MaxDepositAmount:      new(big.Int).SetUint64(c.MaxDepositAmount),
+	}
+	return nil
+}
+
+// UnmarshalConfig decodes and validates a beacon chain config from YAML. Every
+// field of the config must be set, so a file never silently mixes in the values
+// of another config, and unknown keys are rejected.
+func UnmarshalConfig(data []byte) (*BeaconChainConfig, error) {
+	keys := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("could not decode config: %v", err)
+	}
+	var missing []string
+	for _, key := range configKeys() {
+		if _, ok := keys[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("config is missing keys: %s", strings.Join(missing, ", "))
+	}
+
+	c := &BeaconChainConfig{}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, fmt.Errorf("could not decode config: %v", err)
+	}
+	if err := validateConfig(c); err != nil {
+		return nil, fmt.Errorf("invalid config: %v", err)
+	}
+	return c, nil
+}
+
+// MarshalConfig encodes the beacon chain config to YAML in the format read by
+// LoadChainConfigFile.
+func MarshalConfig(c *BeaconChainConfig) ([]byte, error) {
+	return yaml.Marshal(c)
+}
+
+// nodeLocalKeys are the YAML keys of the config fields which only tune the
+// behavior of a node, so nodes with different values still agree on the chain.
+var nodeLocalKeys = []string{
+	"validator_privkey_file_name",
+	"withdrawal_privkey_file_name",
+	"default_buffer_size",
+	"hash_cache_size",
+	"sync_polling_interval",
+	"batch_block_limit",
+	"sync_epoch_limit",
+	"log_block_delay",
+	"testnet_contract_endpoint",
+}
+
+// ConfigHash returns the hash of the YAML encoding of the consensus parameters of
+// the beacon chain config in use. Nodes only agree on the chain if they run with
+// the same config hash.
+func ConfigHash() ([32]byte, error) {
+	enc, err := MarshalConfig(BeaconConfig())
+	if err != nil {
+		return [32]byte{}, err
+	}
+	values := make(map[string]interface{})
+	if err := yaml.Unmarshal(enc, &values); err != nil {
+		return [32]byte{}, err
+	}
+	for _, key := range nodeLocalKeys {
+		delete(values, key)
+	}
+	// Map keys are sorted when encoded, so the encoding does not depend on the
+	// order of the config fields.
+	enc, err = yaml.Marshal(values)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(enc), nil
+}
+
+// configKeys returns the YAML keys of every field of the beacon chain config. The
+// zero hash and empty signature are always zero, so they are not part of the YAML.
+func configKeys() []string {
+	typ := reflect.TypeOf(BeaconChainConfig{})
+	var keys []string
+	for i := 0; i < typ.NumField(); i++ {
+		if key := typ.Field(i).Tag.Get("yaml"); key != "-" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// validateConfig checks the invariants the beacon chain services rely on.
+func validateConfig(c *BeaconChainConfig) error {
+	if c.SlotsPerEpoch == 0 || c.SlotsPerEpoch&(c.SlotsPerEpoch-1) != 0 {
+		return fmt.Errorf("slots_per_epoch must be a power of two, got %d", c.SlotsPerEpoch)
+	}
+	if c.GenesisSlot%c.SlotsPerEpoch != 0 {
+		return errors.New("genesis_slot must be the first slot of an epoch")
+	}
+	if c.GenesisEpoch != c.GenesisSlot/c.SlotsPerEpoch {
+		return fmt.Errorf("genesis_epoch must be genesis_slot / slots_per_epoch = %d, got %d", c.GenesisSlot/c.SlotsPerEpoch, c.GenesisEpoch)
+	}
+	nonZero := []struct {
+		key   string
+		value uint64
+	}{
+		{"shard_count", c.ShardCount},
+		{"target_committee_size", c.TargetCommitteeSize},
+		{"seconds_per_slot", c.SecondsPerSlot},
+		{"epochs_per_eth1_voting_period", c.EpochsPerEth1VotingPeriod},
+		{"latest_block_roots_length", c.LatestBlockRootsLength},
+		{"latest_randao_mixes_length", c.LatestRandaoMixesLength},
+		{"latest_slashed_exit_length", c.LatestSlashedExitLength},
+		{"latest_active_index_roots_length", c.LatestActiveIndexRootsLength},
+		{"deposits_for_chain_start", c.DepositsForChainStart},
+		{"max_deposit_amount", c.MaxDepositAmount},
+	}
+	for _, field := range nonZero {
+		if field.value == 0 {
+			return fmt.Errorf("%s must not be zero", field.key)
+		}
+	}
+	if c.MinDepositAmount > c.MaxDepositAmount {
+		return fmt.Errorf("min_deposit_amount %d is more than max_deposit_amount %d", c.MinDepositAmount, c.MaxDepositAmount)
+	}
+	if c.EjectionBalance > c.MaxDepositAmount {
+		return fmt.Errorf("ejection_balance %d is more than max_deposit_amount %d", c.EjectionBalance, c.MaxDepositAmount)
+	}
+	if c.DepositContractTreeDepth == 0 || c.DepositContractTreeDepth > 64 {
+		return fmt.Errorf("deposit_contract_tree_depth must be between 1 and 64, got %d", c.DepositContractTreeDepth)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package params
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalConfig_Presets(t *testing.T) {
+	presets := map[string]*BeaconChainConfig{
+		"presets/mainnet.yaml": defaultBeaconConfig,
+		"presets/demo.yaml":    DemoBeaconConfig(),
+	}
+	for file, expected := range presets {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := UnmarshalConfig(data)
+		if err != nil {
+			t.Fatalf("Could not unmarshal %s: %v", file, err)
+		}
+		enc, err := MarshalConfig(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectedEnc, err := MarshalConfig(expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(enc, expectedEnc) {
+			t.Errorf("Expected %s to match the config in code, received:\n%s", file, enc)
+		}
+	}
+}
+
+func TestUnmarshalConfig_RejectsMissingKeys(t *testing.T) {
+	enc, err := MarshalConfig(DemoBeaconConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc = bytes.Replace(enc, []byte("shard_count: 1\n"), nil, 1)
+	if _, err := UnmarshalConfig(enc); err == nil || !strings.Contains(err.Error(), "shard_count") {
+		t.Errorf("Expected an error about the missing shard_count key, received %v", err)
+	}
+}
+
+func TestUnmarshalConfig_RejectsUnknownKeys(t *testing.T) {
+	enc, err := MarshalConfig(DemoBeaconConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc = append(enc, []byte("shards_count: 1\n")...)
+	if _, err := UnmarshalConfig(enc); err == nil {
+		t.Error("Expected an error about the unknown shards_count key")
+	}
+}
+
+func TestUnmarshalConfig_Validates(t *testing.T) {
+	tests := []struct {
+		modify func(c *BeaconChainConfig)
+		err    string
+	}{
+		{
+			modify: func(c *BeaconChainConfig) {
+				c.SlotsPerEpoch = 6
+				c.GenesisEpoch = c.GenesisSlot / 6
+			},
+			err: "slots_per_epoch must be a power of two",
+		},
+		{
+			modify: func(c *BeaconChainConfig) { c.GenesisEpoch = c.GenesisSlot / 64 },
+			err:    "genesis_epoch must be",
+		},
+		{
+			modify: func(c *BeaconChainConfig) { c.ShardCount = 0 },
+			err:    "shard_count must not be zero",
+		},
+		{
+			modify: func(c *BeaconChainConfig) { c.MinDepositAmount = c.MaxDepositAmount + 1 },
+			err:    "min_deposit_amount",
+		},
+	}
+	for _, tt := range tests {
+		c := DemoBeaconConfig()
+		tt.modify(c)
+		enc, err := MarshalConfig(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := UnmarshalConfig(enc); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Expected error containing %q, received %v", tt.err, err)
+		}
+	}
+}
+
+func TestLoadChainConfigFile_OverridesConfigs(t *testing.T) {
+	defer OverrideBeaconConfig(BeaconConfig())
+	defer func(c *DepositContractConfig) { contractConfig = c }(ContractConfig())
+
+	c := DemoBeaconConfig()
+	c.DepositsForChainStart = 4
+	c.SecondsPerSlot = 2
+	enc, err := MarshalConfig(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := path.Join(os.TempDir(), "chain-config.yaml")
+	if err := ioutil.WriteFile(p, enc, 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(p)
+
+	hash, err := ConfigHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadChainConfigFile(p); err != nil {
+		t.Fatalf("Could not load chain config file: %v", err)
+	}
+	if BeaconConfig().SecondsPerSlot != 2 {
+		t.Errorf("Expected 2 seconds per slot, received %d", BeaconConfig().SecondsPerSlot)
+	}
+	if ContractConfig().DepositsForChainStart.Uint64() != 4 {
+		t.Errorf("Expected the contract to require 4 deposits, received %v", ContractConfig().DepositsForChainStart)
+	}
+	newHash, err := ConfigHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newHash == hash {
+		t.Error("Expected the config hash to change with the config")
+	}
+}
+
+func TestConfigHash_IgnoresNodeLocalFields(t *testing.T) {
+	defer OverrideBeaconConfig(BeaconConfig())
+
+	keys := make(map[string]bool)
+	for _, key := range configKeys() {
+		keys[key] = true
+	}
+	for _, key := range nodeLocalKeys {
+		if !keys[key] {
+			t.Errorf("Node local key %s is not a config key", key)
+		}
+	}
+
+	hash, err := ConfigHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := *BeaconConfig()
+	c.DefaultBufferSize++
+	c.HashCacheSize++
+	c.SyncPollingInterval++
+	c.TestnetContractEndpoint = "http://localhost"
+	c.ValidatorPrivkeyFileName = "/tmp/key"
+	OverrideBeaconConfig(&c)
+	newHash, err := ConfigHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newHash != hash {
+		t.Error("Expected the config hash not to change with node local fields")
+	}
+
+	c.ShardCount++
+	newHash, err = ConfigHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newHash == hash {
+		t.Error("Expected the config hash to change with a consensus parameter")
+	}
+}
@@ -53,7 +53,7 @@ func makeDecoder(typ reflect.Type) (dec decoder, err error) {
 	case kind == reflect.Uint32:
 		return decodeUint32, nil
 	case kind == reflect.Int32:
-		return decodeUint32, nil
+		return decodeInt32, nil
 	case kind == reflect.Uint64:
 		return decodeUint64, nil
 	case kind == reflect.Slice && typ.Elem().Kind() == reflect.Uint8:
@@ -116,6 +116,15 @@ func decodeUint32(r io.Reader, val reflect.Value) (uint32, error) {
 	return 4, nil
 }
 
+func decodeInt32(r io.Reader, val reflect.Value) (uint32, error) {
+	b := make([]byte, 4)
+	if err := readBytes(r, 4, b); err != nil {
+		return 0, err
+	}
+	val.SetInt(int64(int32(binary.LittleEndian.Uint32(b))))
+	return 4, nil
+}
+
 func decodeUint64(r io.Reader, val reflect.Value) (uint32, error) {
 	b := make([]byte, 8)
 	if err := readBytes(r, 8, b); err != nil {
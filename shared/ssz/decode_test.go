@@ -47,6 +47,10 @@ var decodeTests = []decodeTest{
 	{input: "FFFF0000", ptr: new(uint32), value: uint32(65535)},
 	{input: "FFFFFFFF", ptr: new(uint32), value: uint32(4294967295)},
 
+	// int32
+	{input: "01000000", ptr: new(int32), value: int32(1)},
+	{input: "FFFFFFFF", ptr: new(int32), value: int32(-1)},
+
 	// uint64
 	{input: "0000000000000000", ptr: new(uint64), value: uint64(0)},
 	{input: "0100000000000000", ptr: new(uint64), value: uint64(1)},
@@ -0,0 +1,311 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// FieldHash is the hash of a struct field, as concatenated by TreeHash to compute
+// the root of the struct.
+type FieldHash struct {
+	Name string
+	Hash []byte
+}
+
+// FieldHashes returns the hash of each field of a struct, or of a pointer to a
+// struct, in the order TreeHash concatenates them.
+func FieldHashes(val interface{}) ([]FieldHash, error) {
+	if val == nil {
+		return nil, newHashError("untyped nil is not supported", nil)
+	}
+	rval := reflect.ValueOf(val)
+	for rval.Kind() == reflect.Ptr {
+		if rval.IsNil() {
+			return nil, newHashError("nil pointer has no fields", rval.Type())
+		}
+		rval = rval.Elem()
+	}
+	if rval.Kind() != reflect.Struct {
+		return nil, newHashError("value is not a struct", rval.Type())
+	}
+	sszUtilsCacheMutex.Lock()
+	fields, err := structFields(rval.Type())
+	sszUtilsCacheMutex.Unlock()
+	if err != nil {
+		return nil, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	hashes := make([]FieldHash, len(fields))
+	for i, f := range fields {
+		h, err := f.sszUtils.hasher(rval.Field(f.index))
+		if err != nil {
+			return nil, newHashError(fmt.Sprintf("failed to hash field %s: %v", f.name, err), rval.Type())
+		}
+		hashes[i] = FieldHash{Name: f.name, Hash: h}
+	}
+	return hashes, nil
+}
+
+// HashFields returns the tree-hash of a struct from the hashes of its fields.
+func HashFields(fields []FieldHash) [32]byte {
+	concat := make([]byte, 0, len(fields)*hashLengthBytes)
+	for _, f := range fields {
+		concat = append(concat, f.Hash...)
+	}
+	return hashutil.Hash(concat)
+}
+
+// MixInLength returns the tree-hash of a list from the root of the merkle tree
+// over its elements and its length.
+func MixInLength(treeRoot []byte, length uint64) []byte {
+	dataLenEnc := make([]byte, hashLengthBytes)
+	binary.LittleEndian.PutUint64(dataLenEnc, length)
+	result := hashutil.Hash(append(append([]byte{}, treeRoot...), dataLenEnc...))
+	return result[:]
+}
+
+// ElementsPerLeaf returns the number of elements of a list which TreeHash packs
+// in each leaf of the merkle tree over the list.
+func ElementsPerLeaf(list interface{}) (uint64, error) {
+	typ, err := listType(list)
+	if err != nil {
+		return 0, err
+	}
+	elem := reflect.New(typ.Elem()).Elem()
+	if typ.Elem().Kind() == reflect.Ptr {
+		elem = reflect.New(typ.Elem().Elem())
+	}
+	utils, err := cachedSSZUtils(elem.Type())
+	if err != nil {
+		return 0, newHashError(fmt.Sprint(err), typ)
+	}
+	h, err := utils.hasher(elem)
+	if err != nil {
+		return 0, newHashError(fmt.Sprint(err), typ)
+	}
+	return elementsPerLeaf(len(h)), nil
+}
+
+// ListTree is the merkle tree TreeHash builds over the elements of a list. It is
+// used to prove ranges of the list against the root of the tree, so the list can
+// be transferred and verified in parts.
+type ListTree struct {
+	// layers are the nodes of the tree from the leaves up, without the empty
+	// chunks padding layers of odd length.
+	layers       [][][]byte
+	length       uint64
+	elemsPerLeaf uint64
+}
+
+// NewListTree builds the merkle tree over the elements of a non-empty list.
+func NewListTree(list interface{}) (*ListTree, error) {
+	if _, err := listType(list); err != nil {
+		return nil, err
+	}
+	rval := reflect.ValueOf(list)
+	if rval.Len() == 0 {
+		return nil, newHashError("empty list has no merkle tree", rval.Type())
+	}
+	hashes, err := elementHashes(rval)
+	if err != nil {
+		return nil, err
+	}
+	perLeaf := elementsPerLeaf(len(hashes[0]))
+	layers := [][][]byte{leaves(hashes, perLeaf)}
+	for len(layers[len(layers)-1]) > 1 {
+		layers = append(layers, hashLayer(layers[len(layers)-1]))
+	}
+	return &ListTree{
+		layers:       layers,
+		length:       uint64(rval.Len()),
+		elemsPerLeaf: perLeaf,
+	}, nil
+}
+
+// TreeRoot returns the root of the merkle tree, before the length of the list
+// is mixed in.
+func (t *ListTree) TreeRoot() []byte {
+	return t.layers[len(t.layers)-1][0]
+}
+
+// Root returns the tree-hash of the list.
+func (t *ListTree) Root() []byte {
+	return MixInLength(t.TreeRoot(), t.length)
+}
+
+// Branch returns the sibling nodes proving the elements of the range starting at
+// start against the tree root. The range size must be the number of elements per
+// leaf times a power of two, and start a multiple of it. The last range of the
+// list may hold fewer elements.
+func (t *ListTree) Branch(start uint64, size uint64) ([][]byte, error) {
+	height := len(t.layers) - 1
+	depth, err := rangeDepth(start, size, t.length, t.elemsPerLeaf, height)
+	if err != nil {
+		return nil, err
+	}
+	index := (start / t.elemsPerLeaf) >> uint(depth)
+	branch := make([][]byte, 0, height-depth)
+	for level := depth; level < height; level++ {
+		layer := t.layers[level]
+		if sibling := index ^ 1; sibling < uint64(len(layer)) {
+			branch = append(branch, layer[sibling])
+		} else {
+			branch = append(branch, make([]byte, sszChunkSize))
+		}
+		index >>= 1
+	}
+	return branch, nil
+}
+
+// VerifyListRange checks that the elements of a range starting at start, in a list
+// of the given length, are proven by the branch against the root of the merkle
+// tree over the list, as returned by ListTree.Branch and ListTree.TreeRoot.
+func VerifyListRange(elems interface{}, start uint64, size uint64, length uint64, treeRoot []byte, branch [][]byte) error {
+	if _, err := listType(elems); err != nil {
+		return err
+	}
+	perLeaf, err := ElementsPerLeaf(elems)
+	if err != nil {
+		return err
+	}
+	if length == 0 {
+		return errors.New("empty list has no ranges")
+	}
+	height := treeHeight((length + perLeaf - 1) / perLeaf)
+	depth, err := rangeDepth(start, size, length, perLeaf, height)
+	if err != nil {
+		return err
+	}
+	if len(branch) != height-depth {
+		return fmt.Errorf("branch has length %d, wanted %d", len(branch), height-depth)
+	}
+	rval := reflect.ValueOf(elems)
+	want := perLeaf << uint(depth)
+	if start+want > length {
+		want = length - start
+	}
+	if uint64(rval.Len()) != want {
+		return fmt.Errorf("range has %d elements, wanted %d", rval.Len(), want)
+	}
+
+	hashes, err := elementHashes(rval)
+	if err != nil {
+		return err
+	}
+	layer := leaves(hashes, perLeaf)
+	for level := 0; level < depth; level++ {
+		layer = hashLayer(layer)
+	}
+	node := layer[0]
+	index := (start / perLeaf) >> uint(depth)
+	for _, sibling := range branch {
+		var h [32]byte
+		if index%2 == 0 {
+			h = hashutil.Hash(append(append([]byte{}, node...), sibling...))
+		} else {
+			h = hashutil.Hash(append(append([]byte{}, sibling...), node...))
+		}
+		node = h[:]
+		index >>= 1
+	}
+	if !bytes.Equal(node, treeRoot) {
+		return errors.New("range does not match the tree root")
+	}
+	return nil
+}
+
+func listType(list interface{}) (reflect.Type, error) {
+	if list == nil {
+		return nil, newHashError("untyped nil is not supported", nil)
+	}
+	typ := reflect.TypeOf(list)
+	if typ.Kind() != reflect.Slice && typ.Kind() != reflect.Array || typ.Elem().Kind() == reflect.Uint8 {
+		return nil, newHashError("value is not a list", typ)
+	}
+	return typ, nil
+}
+
+func elementHashes(val reflect.Value) ([][]byte, error) {
+	utils, err := cachedSSZUtils(val.Type().Elem())
+	if err != nil {
+		return nil, newHashError(fmt.Sprint(err), val.Type())
+	}
+	hashes := make([][]byte, val.Len())
+	for i := range hashes {
+		if hashes[i], err = utils.hasher(val.Index(i)); err != nil {
+			return nil, newHashError(fmt.Sprintf("failed to hash element of list: %v", err), val.Type())
+		}
+	}
+	return hashes, nil
+}
+
+// elementsPerLeaf mirrors how merkleHash packs element hashes in chunks.
+func elementsPerLeaf(hashLength int) uint64 {
+	if hashLength == 0 || hashLength >= sszChunkSize {
+		return 1
+	}
+	return uint64(sszChunkSize / hashLength)
+}
+
+func leaves(hashes [][]byte, perLeaf uint64) [][]byte {
+	leaves := make([][]byte, 0, (uint64(len(hashes))+perLeaf-1)/perLeaf)
+	for i := uint64(0); i < uint64(len(hashes)); i += perLeaf {
+		j := i + perLeaf
+		if j > uint64(len(hashes)) {
+			j = uint64(len(hashes))
+		}
+		leaf := make([]byte, 0, sszChunkSize)
+		for _, h := range hashes[i:j] {
+			leaf = append(leaf, h...)
+		}
+		leaves = append(leaves, leaf)
+	}
+	return leaves
+}
+
+// hashLayer hashes the pairs of nodes of a layer, padding a layer of odd length
+// with an empty chunk as merkleHash does.
+func hashLayer(layer [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(layer)+1)/2)
+	for i := 0; i < len(layer); i += 2 {
+		right := make([]byte, sszChunkSize)
+		if i+1 < len(layer) {
+			right = layer[i+1]
+		}
+		h := hashutil.Hash(append(append([]byte{}, layer[i]...), right...))
+		next = append(next, h[:])
+	}
+	return next
+}
+
+// treeHeight returns the number of layers hashed above the leaves.
+func treeHeight(numLeaves uint64) int {
+	height := 0
+	for width := uint64(1); width < numLeaves; width <<= 1 {
+		height++
+	}
+	return height
+}
+
+// rangeDepth returns the layer of the root of the subtree holding a range.
+func rangeDepth(start uint64, size uint64, length uint64, perLeaf uint64, height int) (int, error) {
+	if size == 0 || size%perLeaf != 0 || (size/perLeaf)&(size/perLeaf-1) != 0 {
+		return 0, fmt.Errorf("range size %d is not %d elements times a power of two", size, perLeaf)
+	}
+	if start%size != 0 || start >= length {
+		return 0, fmt.Errorf("range start %d is not aligned to the range size %d within length %d", start, size, length)
+	}
+	depth := 0
+	for perLeaf<<uint(depth) < size {
+		depth++
+	}
+	// A range larger than the list is the whole tree.
+	if depth > height {
+		depth = height
+	}
+	return depth, nil
+}
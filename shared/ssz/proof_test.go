@@ -0,0 +1,112 @@
+package ssz
+
+import (
+	"bytes"
+	"testing"
+)
+
+type proofTestElem struct {
+	Pubkey  []byte
+	Balance uint64
+}
+
+type proofTestStruct struct {
+	Slot     uint64
+	Elems    []*proofTestElem
+	Balances []uint64
+	Root     []byte
+}
+
+func TestHashFields_MatchesTreeHash(t *testing.T) {
+	val := &proofTestStruct{
+		Slot:     5,
+		Elems:    []*proofTestElem{{Pubkey: []byte{1}, Balance: 2}},
+		Balances: []uint64{1, 2, 3},
+		Root:     []byte{'a'},
+	}
+	fields, err := FieldHashes(val)
+	if err != nil {
+		t.Fatalf("Could not hash fields: %v", err)
+	}
+	if len(fields) != 4 || fields[1].Name != "Elems" {
+		t.Fatalf("Unexpected fields %v", fields)
+	}
+	want, err := TreeHash(val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if HashFields(fields) != want {
+		t.Errorf("Expected root %#x, received %#x", want, HashFields(fields))
+	}
+}
+
+func TestListTree_ProvesRanges(t *testing.T) {
+	for _, length := range []int{1, 3, 17, 100} {
+		elems := make([]*proofTestElem, length)
+		balances := make([]uint64, length)
+		for i := range elems {
+			elems[i] = &proofTestElem{Pubkey: []byte{byte(i)}, Balance: uint64(i)}
+			balances[i] = uint64(i)
+		}
+		// Struct hashes fill a quarter of a leaf, and balances a sixteenth.
+		checkListRanges(t, elems, 4, func(start, end uint64) interface{} { return elems[start:end] })
+		checkListRanges(t, balances, 16, func(start, end uint64) interface{} { return balances[start:end] })
+	}
+}
+
+func checkListRanges(t *testing.T, list interface{}, perLeaf uint64, slice func(start, end uint64) interface{}) {
+	tree, err := NewListTree(list)
+	if err != nil {
+		t.Fatalf("Could not build list tree: %v", err)
+	}
+	want, err := TreeHash(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tree.Root(), want[:]) {
+		t.Fatalf("Expected list root %#x, received %#x", want, tree.Root())
+	}
+	if n, err := ElementsPerLeaf(list); err != nil || n != perLeaf {
+		t.Fatalf("Expected %d elements per leaf, received %d: %v", perLeaf, n, err)
+	}
+
+	length := tree.length
+	for _, size := range []uint64{perLeaf, 2 * perLeaf, 8 * perLeaf} {
+		for start := uint64(0); start < length; start += size {
+			end := start + size
+			if end > length {
+				end = length
+			}
+			branch, err := tree.Branch(start, size)
+			if err != nil {
+				t.Fatalf("Could not build branch for range %d-%d: %v", start, end, err)
+			}
+			if err := VerifyListRange(slice(start, end), start, size, length, tree.TreeRoot(), branch); err != nil {
+				t.Errorf("Range %d-%d of %d failed verification: %v", start, end, length, err)
+			}
+			if end-start > 1 {
+				if err := VerifyListRange(slice(start, end-1), start, size, length, tree.TreeRoot(), branch); err == nil {
+					t.Errorf("Expected truncated range %d-%d of %d to fail verification", start, end, length)
+				}
+			}
+			if start > 0 {
+				if err := VerifyListRange(slice(start-size, end-size), start, size, length, tree.TreeRoot(), branch); err == nil {
+					t.Errorf("Expected range %d-%d of %d to fail verification at another position", start, end, length)
+				}
+			}
+		}
+	}
+}
+
+func TestListTree_RejectsMisalignedRange(t *testing.T) {
+	tree, err := NewListTree([]uint64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tree.Branch(0, 3); err == nil {
+		t.Error("Expected a range size which is not a whole number of leaves to be rejected")
+	}
+	if _, err := tree.Branch(16, 16); err == nil {
+		t.Error("Expected a range past the end of the list to be rejected")
+	}
+}
@@ -13,6 +13,7 @@ var _ = Validator(&fakeValidator{})
 type fakeValidator struct {
 	DoneCalled                       bool
 	WaitForActivationCalled          bool
+	WaitForActivationErrs            []error
 	WaitForChainStartCalled          bool
 	NextSlotRet                      <-chan uint64
 	NextSlotCalled                   bool
@@ -43,6 +44,11 @@ func (fv *fakeValidator) WaitForChainStart(_ context.Context) error {
 
 func (fv *fakeValidator) WaitForActivation(_ context.Context) error {
 	fv.WaitForActivationCalled = true
+	if len(fv.WaitForActivationErrs) > 0 {
+		err := fv.WaitForActivationErrs[0]
+		fv.WaitForActivationErrs = fv.WaitForActivationErrs[1:]
+		return err
+	}
 	return nil
 }
 
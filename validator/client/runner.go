@@ -12,6 +12,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// syncRetryInterval is how long to wait before asking a syncing beacon node
+// again.
+var syncRetryInterval = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+
 // Validator interface defines the primary methods of a validator client.
 type Validator interface {
 	Done()
@@ -42,11 +46,17 @@ func run(ctx context.Context, v Validator) {
 	if err := v.WaitForChainStart(ctx); err != nil {
 		log.Fatalf("Could not determine if beacon chain started: %v", err)
 	}
-	if err := v.WaitForActivation(ctx); err != nil {
+	if err := retryWhileSyncing(ctx, func() error {
+		return v.WaitForActivation(ctx)
+	}); err != nil {
 		log.Fatalf("Could not wait for validator activation: %v", err)
 	}
-	headSlot, err := v.CanonicalHeadSlot(ctx)
-	if err != nil {
+	var headSlot uint64
+	if err := retryWhileSyncing(ctx, func() error {
+		var err error
+		headSlot, err = v.CanonicalHeadSlot(ctx)
+		return err
+	}); err != nil {
 		log.Fatalf("Could not get current canonical head slot: %v", err)
 	}
 	if err := v.UpdateAssignments(ctx, headSlot); err != nil {
@@ -104,11 +114,30 @@ func run(ctx context.Context, v Validator) {
 	}
 }
 
+// retryWhileSyncing calls fn again as long as it fails because the beacon node is
+// unavailable while syncing, until the context is canceled.
+func retryWhileSyncing(ctx context.Context, fn func() error) error {
+	for {
+		err := fn()
+		if status.Code(err) != codes.Unavailable {
+			return err
+		}
+		log.WithError(err).Warn("Beacon node is syncing, retrying")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(syncRetryInterval):
+		}
+	}
+}
+
 func handleAssignmentError(err error, slot uint64) {
 	if errCode, ok := status.FromError(err); ok && errCode.Code() == codes.NotFound {
 		log.WithField(
 			"epoch", (slot/params.BeaconConfig().SlotsPerEpoch)-params.BeaconConfig().GenesisEpoch,
 		).Warn("Validator not yet assigned to epoch")
+	} else if ok && errCode.Code() == codes.Unavailable {
+		log.WithField("slot", slot-params.BeaconConfig().GenesisSlot).Warn("Beacon node is syncing")
 	} else {
 		log.WithField("error", err).Error("Failed to update assignments")
 	}
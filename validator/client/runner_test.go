@@ -9,6 +9,8 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func cancelledContext() context.Context {
@@ -41,6 +43,24 @@ func TestCancelledContext_WaitsForActivation(t *testing.T) {
 	}
 }
 
+func TestWaitForActivation_RetriesWhileSyncing(t *testing.T) {
+	hook := logTest.NewGlobal()
+	defer func(interval time.Duration) {
+		syncRetryInterval = interval
+	}(syncRetryInterval)
+	syncRetryInterval = time.Millisecond
+	v := &fakeValidator{
+		WaitForActivationErrs: []error{status.Error(codes.Unavailable, "node is syncing")},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	run(ctx, v)
+	testutil.AssertLogsContain(t, hook, "Beacon node is syncing, retrying")
+	if len(v.WaitForActivationErrs) != 0 {
+		t.Error("Expected WaitForActivation() to be retried")
+	}
+}
+
 func TestUpdateAssignments_NextSlot(t *testing.T) {
 	v := &fakeValidator{}
 	ctx, cancel := context.WithCancel(context.Background())
@@ -2,12 +2,14 @@ package client
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -28,6 +30,7 @@ type ValidatorService struct {
 	key                  *keystore.Key
 	keys                 map[string]*keystore.Key
 	logValidatorBalances bool
+	validatorDB          *db.ValidatorDB
 }
 
 // Config for the validator service.
@@ -36,17 +39,17 @@ type Config struct {
 	CertFlag             string
 	KeystorePath         string
 	Password             string
+	InteropStartIndex    uint64
+	InteropNumValidators uint64
 	LogValidatorBalances bool
+	ValidatorDB          *db.ValidatorDB
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
-	validatorFolder := cfg.KeystorePath
-	validatorPrefix := params.BeaconConfig().ValidatorPrivkeyFileName
-	ks := keystore.NewKeystore(cfg.KeystorePath)
-	keys, err := ks.GetKeys(validatorFolder, validatorPrefix, cfg.Password)
+	keys, err := validatorKeys(cfg)
 	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("could not get private key: %v", err)
@@ -64,9 +67,30 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		keys:                 keys,
 		key:                  key,
 		logValidatorBalances: cfg.LogValidatorBalances,
+		validatorDB:          cfg.ValidatorDB,
 	}, nil
 }
 
+// validatorKeys returns the keys of the validators the client runs, by their hex
+// encoded public key. These are the interop keys given by the config if any,
+// otherwise the keys of the keystore.
+func validatorKeys(cfg *Config) (map[string]*keystore.Key, error) {
+	if cfg.InteropNumValidators > 0 {
+		interopKeys, err := keystore.InteropKeys(cfg.InteropStartIndex, cfg.InteropNumValidators)
+		if err != nil {
+			return nil, err
+		}
+		keys := make(map[string]*keystore.Key, len(interopKeys))
+		for _, key := range interopKeys {
+			keys[hex.EncodeToString(key.PublicKey.Marshal())] = key
+		}
+		return keys, nil
+	}
+	validatorPrefix := params.BeaconConfig().ValidatorPrivkeyFileName
+	ks := keystore.NewKeystore(cfg.KeystorePath)
+	return ks.GetKeys(cfg.KeystorePath, validatorPrefix, cfg.Password)
+}
+
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
@@ -103,6 +127,7 @@ func (v *ValidatorService) Start() {
 		keys:                 v.keys,
 		pubkeys:              pubkeys,
 		logValidatorBalances: v.logValidatorBalances,
+		db:                   v.validatorDB,
 	}
 	go run(v.ctx, v.validator)
 }
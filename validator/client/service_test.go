@@ -67,6 +67,29 @@ func TestStop_CancelsContext(t *testing.T) {
 	}
 }
 
+func TestNewValidatorService_InteropKeys(t *testing.T) {
+	vs, err := NewValidatorService(context.Background(), &Config{
+		Endpoint:             "merkle tries",
+		InteropStartIndex:    2,
+		InteropNumValidators: 3,
+	})
+	if err != nil {
+		t.Fatalf("Could not create validator service: %v", err)
+	}
+	interopKeys, err := keystore.InteropKeys(2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vs.keys) != len(interopKeys) {
+		t.Fatalf("Expected %d keys, received %d", len(interopKeys), len(vs.keys))
+	}
+	for _, key := range interopKeys {
+		if _, ok := vs.keys[hex.EncodeToString(key.PublicKey.Marshal())]; !ok {
+			t.Errorf("Expected the validator service to run interop key %#x", key.PublicKey.Marshal())
+		}
+	}
+}
+
 func TestLifecycle(t *testing.T) {
 	hook := logTest.NewGlobal()
 	// Use canceled context so that the run function exits immediately..
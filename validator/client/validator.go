@@ -4,6 +4,7 @@ package client
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -14,10 +15,16 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc/status"
 )
 
+// errNoSlashingProtection is returned instead of signing when the validator has no
+// slashing protection database to check its duties against.
+var errNoSlashingProtection = errors.New("slashing protection database is not initialized")
+
 type validator struct {
 	genesisTime          uint64
 	ticker               *slotutil.SlotTicker
@@ -30,6 +37,7 @@ type validator struct {
 	pubkeys              [][]byte
 	prevBalance          uint64
 	logValidatorBalances bool
+	db                   *db.ValidatorDB
 }
 
 // Done cleans up the validator.
@@ -84,7 +92,7 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 	}
 	stream, err := v.validatorClient.WaitForActivation(ctx, req)
 	if err != nil {
-		return fmt.Errorf("could not setup validator WaitForActivation streaming client: %v", err)
+		return wrapRPCError(err, "could not setup validator WaitForActivation streaming client")
 	}
 	var validatorActivatedRecords [][]byte
 	for {
@@ -98,7 +106,7 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 			return fmt.Errorf("context has been canceled so shutting down the loop: %v", ctx.Err())
 		}
 		if err != nil {
-			return fmt.Errorf("could not receive validator activation from stream: %v", err)
+			return wrapRPCError(err, "could not receive validator activation from stream")
 		}
 		log.Info("Waiting for validator to be activated in the beacon chain")
 		activatedKeys := v.checkAndLogValidatorStatus(res.Statuses)
@@ -118,6 +126,15 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 	return nil
 }
 
+// wrapRPCError prefixes an error with the message, keeping the status code of
+// the errors returned by the beacon node so the caller can tell when to retry.
+func wrapRPCError(err error, msg string) error {
+	if s, ok := status.FromError(err); ok {
+		return status.Errorf(s.Code(), "%s: %s", msg, s.Message())
+	}
+	return fmt.Errorf("%s: %v", msg, err)
+}
+
 func (v *validator) checkAndLogValidatorStatus(validatorStatuses []*pb.ValidatorActivationResponse_Status) [][]byte {
 	var activatedKeys [][]byte
 	for _, status := range validatorStatuses {
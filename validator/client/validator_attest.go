@@ -10,8 +10,10 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -124,6 +126,16 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, idx stri
 	aggregationBitfield := bitutil.SetBitfield(indexInCommittee, committeeLength)
 	attestation.AggregationBitfield = aggregationBitfield
 
+	// Before signing, the attestation is checked against the slashing protection
+	// history of the validator and recorded so it can never be signed twice.
+	if err := v.recordAttestation(pubKey, attData); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"slot":      slot - params.BeaconConfig().GenesisSlot,
+			"validator": truncatedPk,
+		}).Error("Not attesting! Attestation failed slashing protection")
+		return
+	}
+
 	// TODO(#1366): Use BLS to generate an aggregate signature.
 	attestation.AggregateSignature = []byte("signed")
 
@@ -154,6 +166,32 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, idx stri
 	)
 }
 
+// recordAttestation saves the attestation data in the slashing protection database,
+// returning an error if the validator attesting to it could be slashed.
+func (v *validator) recordAttestation(pubKey []byte, data *pbp2p.AttestationData) error {
+	if v.db == nil {
+		return errNoSlashingProtection
+	}
+	root, err := hashutil.HashProto(data)
+	if err != nil {
+		return fmt.Errorf("could not hash attestation data: %v", err)
+	}
+	record := &db.AttestationRecord{
+		SourceEpoch: data.JustifiedEpoch,
+		TargetEpoch: data.Slot / params.BeaconConfig().SlotsPerEpoch,
+		DataRoot:    root,
+	}
+	slashable, err := v.db.SlashableAttestation(pubKey, record)
+	if err != nil {
+		return fmt.Errorf("could not check attestation history: %v", err)
+	}
+	if slashable {
+		return fmt.Errorf("attestation with source epoch %d and target epoch %d is a double or surround vote",
+			record.SourceEpoch-params.BeaconConfig().GenesisEpoch, record.TargetEpoch-params.BeaconConfig().GenesisEpoch)
+	}
+	return v.db.SaveAttestation(pubKey, record)
+}
+
 // waitToSlotMidpoint waits until halfway through the current slot period
 // such that any blocks from this slot have time to reach the beacon node
 // before creating the attestation.
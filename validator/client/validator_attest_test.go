@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -157,6 +158,81 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Attested latest head")
 }
 
+func TestAttestToBlockHead_RefusesSurroundVote(t *testing.T) {
+	hook := logTest.NewGlobal()
+
+	validator, m, finish := setup(t)
+	defer finish()
+
+	// The validator previously voted from source epoch 2 to two epochs past the
+	// target of an attestation at slot 30. That attestation, with source epoch 3,
+	// would be surrounded by it.
+	if err := validator.db.SaveAttestation(validatorKey.PublicKey.Marshal(), &db.AttestationRecord{
+		SourceEpoch: 2,
+		TargetEpoch: 30/params.BeaconConfig().SlotsPerEpoch + 2,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
+			Shard:     5,
+			Committee: []uint64{0, 1},
+		}}}
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
+	).Return(&pb.ValidatorIndexResponse{Index: 1}, nil)
+	m.attesterClient.EXPECT().AttestationDataAtSlot(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.AttestationDataRequest{}),
+	).Return(&pb.AttestationDataResponse{
+		HeadSlot:       30,
+		JustifiedEpoch: 3,
+	}, nil)
+	m.attesterClient.EXPECT().AttestHead(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.Attestation{}),
+	).Times(0)
+
+	validator.AttestToBlockHead(context.Background(), 30, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+	testutil.AssertLogsContain(t, hook, "Not attesting! Attestation failed slashing protection")
+}
+
+func TestAttestToBlockHead_RefusesWithoutSlashingProtection(t *testing.T) {
+	hook := logTest.NewGlobal()
+
+	validator, m, finish := setup(t)
+	defer finish()
+	validator.db = nil
+
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
+			Shard:     5,
+			Committee: []uint64{0, 1},
+		}}}
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
+	).Return(&pb.ValidatorIndexResponse{Index: 1}, nil)
+	m.attesterClient.EXPECT().AttestationDataAtSlot(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.AttestationDataRequest{}),
+	).Return(&pb.AttestationDataResponse{
+		HeadSlot:       30,
+		JustifiedEpoch: 3,
+	}, nil)
+	m.attesterClient.EXPECT().AttestHead(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.Attestation{}),
+	).Times(0)
+
+	validator.AttestToBlockHead(context.Background(), 30, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+	testutil.AssertLogsContain(t, hook, "slashing protection database is not initialized")
+}
+
 func TestAttestToBlockHead_DoesNotAttestBeforeDelay(t *testing.T) {
 	validator, m, finish := setup(t)
 	defer finish()
@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -95,6 +96,13 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, idx string) {
 		return
 	}
 
+	// Fetch pending slashings and exits seen by the beacon node.
+	opsResp, err := v.proposerClient.PendingOperations(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch pending operations from the beacon node")
+		return
+	}
+
 	// 2. Construct block.
 	block := &pbp2p.BeaconBlock{
 		Slot:             slot,
@@ -103,10 +111,10 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, idx string) {
 		Eth1Data:         eth1DataResp.Eth1Data,
 		Body: &pbp2p.BeaconBlockBody{
 			Attestations:      attResp.PendingAttestations,
-			ProposerSlashings: nil, // TODO(1438): Add after operations pool
-			AttesterSlashings: nil, // TODO(1438): Add after operations pool
+			ProposerSlashings: opsResp.ProposerSlashings,
+			AttesterSlashings: opsResp.AttesterSlashings,
 			Deposits:          pDepResp.PendingDeposits,
-			VoluntaryExits:    nil, // TODO(1323): Add validator exits
+			VoluntaryExits:    opsResp.VoluntaryExits,
 		},
 	}
 
@@ -121,11 +129,20 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, idx string) {
 	}
 	block.StateRootHash32 = resp.GetStateRoot()
 
-	// 4. Sign the complete block.
+	// 4. Check the block against the slashing protection history of the validator.
+	if err := v.recordProposal(v.keys[idx].PublicKey.Marshal(), block); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"slot":      slot - params.BeaconConfig().GenesisSlot,
+			"validator": truncatedPk,
+		}).Error("Not proposing! Block failed slashing protection")
+		return
+	}
+
+	// 5. Sign the complete block.
 	// TODO(1366): BLS sign block
 	block.Signature = nil
 
-	// 5. Broadcast to the network via beacon chain node.
+	// 6. Broadcast to the network via beacon chain node.
 	blkResp, err := v.proposerClient.ProposeBlock(ctx, block)
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
@@ -146,3 +163,27 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, idx string) {
 		"validator":       truncatedPk,
 	}).Info("Proposed new beacon block")
 }
+
+// recordProposal saves the block in the slashing protection database, returning an
+// error if a different block was already signed by the validator for the same slot.
+func (v *validator) recordProposal(pubKey []byte, block *pbp2p.BeaconBlock) error {
+	if v.db == nil {
+		return errNoSlashingProtection
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not hash block: %v", err)
+	}
+	slashable, err := v.db.SlashableProposal(pubKey, block.Slot, root)
+	if err != nil {
+		return fmt.Errorf("could not check proposal history: %v", err)
+	}
+	if slashable {
+		return fmt.Errorf("a different block was already proposed at slot %d",
+			block.Slot-params.BeaconConfig().GenesisSlot)
+	}
+	return v.db.SaveProposal(pubKey, &db.ProposalRecord{
+		Slot:      block.Slot,
+		BlockRoot: root,
+	})
+}
@@ -13,6 +13,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/internal"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -39,8 +40,13 @@ func setup(t *testing.T) (*validator, *mocks, func()) {
 		validatorClient: m.validatorClient,
 		keys:            keyMap,
 	}
+	validatorDB, teardown := setupValidatorDB(t)
+	validator.db = validatorDB
 
-	return validator, m, ctrl.Finish
+	return validator, m, func() {
+		ctrl.Finish()
+		teardown()
+	}
 }
 
 func TestProposeBlock_DoesNotProposeGenesisBlock(t *testing.T) {
@@ -124,6 +130,11 @@ func TestProposeBlock_UsePendingDeposits(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -206,6 +217,11 @@ func TestProposeBlock_UsesEth1Data(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -267,6 +283,11 @@ func TestProposeBlock_PendingAttestations_UsesCurrentSlot(t *testing.T) {
 		return &pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil
 	})
 
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -329,6 +350,118 @@ func TestProposeBlock_PendingAttestationsFailure(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Failed to fetch pending attestations")
 }
 
+func TestProposeBlock_PendingOperationsFailure(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{
+		Eth1Data: &pbp2p.Eth1Data{BlockHash32: []byte{'B', 'L', 'O', 'C', 'K'}},
+	}, nil /*err*/)
+
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttestations(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
+	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
+
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(nil, errors.New("failed"))
+
+	validator.ProposeBlock(context.Background(), 55, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+	testutil.AssertLogsContain(t, hook, "Failed to fetch pending operations")
+}
+
+func TestProposeBlock_UsesPendingOperations(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{
+		Eth1Data: &pbp2p.Eth1Data{BlockHash32: []byte{'B', 'L', 'O', 'C', 'K'}},
+	}, nil /*err*/)
+
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttestations(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
+	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
+
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingOperationsResponse{
+		ProposerSlashings: []*pbp2p.ProposerSlashing{{ProposerIndex: 1}},
+		AttesterSlashings: []*pbp2p.AttesterSlashing{{}},
+		VoluntaryExits:    []*pbp2p.VoluntaryExit{{ValidatorIndex: 2}, {ValidatorIndex: 3}},
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().ComputeStateRoot(
+		gomock.Any(), // context
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Return(&pb.StateRootResponse{
+		StateRoot: []byte{'F'},
+	}, nil /*err*/)
+
+	var broadcastedBlock *pbp2p.BeaconBlock
+	m.proposerClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Do(func(_ context.Context, blk *pbp2p.BeaconBlock) {
+		broadcastedBlock = blk
+	}).Return(&pb.ProposeResponse{}, nil /*error*/)
+
+	validator.ProposeBlock(context.Background(), 55, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+
+	body := broadcastedBlock.Body
+	if len(body.ProposerSlashings) != 1 || len(body.AttesterSlashings) != 1 || len(body.VoluntaryExits) != 2 {
+		t.Errorf("Unexpected pending operations included in block body: %v", body)
+	}
+}
+
 func TestProposeBlock_ComputeStateFailure(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, m, finish := setup(t)
@@ -363,6 +496,11 @@ func TestProposeBlock_ComputeStateFailure(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -405,6 +543,11 @@ func TestProposeBlock_UsesComputedState(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
 	var broadcastedBlock *pbp2p.BeaconBlock
 	m.proposerClient.EXPECT().ProposeBlock(
 		gomock.Any(), // ctx
@@ -464,6 +607,11 @@ func TestProposeBlock_BroadcastsABlock(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -478,3 +626,66 @@ func TestProposeBlock_BroadcastsABlock(t *testing.T) {
 
 	validator.ProposeBlock(context.Background(), 55, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
 }
+
+func TestProposeBlock_RefusesSlashableProposal(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+
+	// A different block was already signed for the same slot.
+	if err := validator.db.SaveProposal(validatorKey.PublicKey.Marshal(), &db.ProposalRecord{
+		Slot:      55,
+		BlockRoot: [32]byte{'A'},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttestations(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
+	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
+
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().ComputeStateRoot(
+		gomock.Any(), // context
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Return(&pb.StateRootResponse{
+		StateRoot: []byte{'F'},
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Times(0)
+
+	validator.ProposeBlock(context.Background(), 55, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+	testutil.AssertLogsContain(t, hook, "Not proposing! Block failed slashing protection")
+}
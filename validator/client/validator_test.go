@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"io/ioutil"
+	"os"
+	"path"
 	"strings"
 	"testing"
 	"time"
@@ -16,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/internal"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -38,6 +42,22 @@ func publicKeys(keys map[string]*keystore.Key) [][]byte {
 	return pks
 }
 
+func setupValidatorDB(t *testing.T) (*db.ValidatorDB, func()) {
+	dbPath := path.Join(testutil.TempDir(), fmt.Sprintf("validatordb-%d", time.Now().UnixNano()))
+	validatorDB, err := db.NewDB(dbPath)
+	if err != nil {
+		t.Fatalf("Could not setup slashing protection db: %v", err)
+	}
+	return validatorDB, func() {
+		if err := validatorDB.Close(); err != nil {
+			t.Fatalf("Could not close slashing protection db: %v", err)
+		}
+		if err := os.RemoveAll(dbPath); err != nil {
+			t.Fatalf("Could not remove slashing protection db: %v", err)
+		}
+	}
+}
+
 func generateMockStatusResponse(pubkeys [][]byte) *pb.ValidatorActivationResponse {
 	multipleStatus := make([]*pb.ValidatorActivationResponse_Status, len(pubkeys))
 	for i, key := range pubkeys {
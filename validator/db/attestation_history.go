@@ -0,0 +1,109 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+)
+
+// AttestationRecord describes an attestation signed by a validator by its
+// Casper FFG source and target epochs.
+type AttestationRecord struct {
+	SourceEpoch uint64
+	TargetEpoch uint64
+	DataRoot    [32]byte
+}
+
+// SlashableAttestation checks if signing the given attestation would conflict
+// with an attestation already signed by the public key. An attestation is
+// refused if it is a double vote, a different vote for an already attested
+// target epoch, or if it surrounds or is surrounded by a previous vote.
+func (db *ValidatorDB) SlashableAttestation(pubKey []byte, record *AttestationRecord) (bool, error) {
+	slashable := false
+	err := db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(attestationHistoryBucket).Bucket(pubKey)
+		if bkt == nil {
+			return nil
+		}
+		return bkt.ForEach(func(k, v []byte) error {
+			if isSlashableAttestation(decodeAttestationRecord(k, v), record) {
+				slashable = true
+			}
+			return nil
+		})
+	})
+	return slashable, err
+}
+
+// SaveAttestation records a signed attestation for the public key. It fails
+// if the attestation conflicts with the history of the public key.
+func (db *ValidatorDB) SaveAttestation(pubKey []byte, record *AttestationRecord) error {
+	return db.update(func(tx *bolt.Tx) error {
+		return saveAttestation(tx, pubKey, record)
+	})
+}
+
+// AttestationHistory returns every attestation signed by the public key
+// ordered by target epoch.
+func (db *ValidatorDB) AttestationHistory(pubKey []byte) ([]*AttestationRecord, error) {
+	var records []*AttestationRecord
+	err := db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(attestationHistoryBucket).Bucket(pubKey)
+		if bkt == nil {
+			return nil
+		}
+		return bkt.ForEach(func(k, v []byte) error {
+			records = append(records, decodeAttestationRecord(k, v))
+			return nil
+		})
+	})
+	return records, err
+}
+
+func saveAttestation(tx *bolt.Tx, pubKey []byte, record *AttestationRecord) error {
+	bkt, err := tx.Bucket(attestationHistoryBucket).CreateBucketIfNotExists(pubKey)
+	if err != nil {
+		return err
+	}
+	if err := bkt.ForEach(func(k, v []byte) error {
+		if isSlashableAttestation(decodeAttestationRecord(k, v), record) {
+			return fmt.Errorf("conflicting attestation already recorded for target epoch %d", decodeUint64(k))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return bkt.Put(encodeUint64(record.TargetEpoch), encodeAttestationRecord(record))
+}
+
+// isSlashableAttestation checks whether two attestations signed by the same
+// key would allow the key to be slashed, following the same rules as the
+// attester slashing verification in the state transition.
+func isSlashableAttestation(previous *AttestationRecord, incoming *AttestationRecord) bool {
+	if previous.TargetEpoch == incoming.TargetEpoch {
+		// Signing the exact same data again is not a double vote.
+		return previous.SourceEpoch != incoming.SourceEpoch ||
+			!bytes.Equal(previous.DataRoot[:], incoming.DataRoot[:])
+	}
+	return isSurroundVote(previous, incoming) || isSurroundVote(incoming, previous)
+}
+
+// isSurroundVote checks if attestation 1's source epoch is smaller than attestation 2
+// while simultaneously checking if its target epoch is greater than that of attestation 2.
+func isSurroundVote(record1 *AttestationRecord, record2 *AttestationRecord) bool {
+	return record1.SourceEpoch < record2.SourceEpoch && record2.TargetEpoch < record1.TargetEpoch
+}
+
+func encodeAttestationRecord(record *AttestationRecord) []byte {
+	return append(encodeUint64(record.SourceEpoch), record.DataRoot[:]...)
+}
+
+func decodeAttestationRecord(key []byte, enc []byte) *AttestationRecord {
+	record := &AttestationRecord{
+		TargetEpoch: decodeUint64(key),
+		SourceEpoch: decodeUint64(enc[:8]),
+	}
+	copy(record.DataRoot[:], enc[8:])
+	return record
+}
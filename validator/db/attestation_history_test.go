@@ -0,0 +1,82 @@
+package db
+
+import (
+	"testing"
+)
+
+func TestSlashableAttestation(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte("validator-1")
+
+	previous := &AttestationRecord{SourceEpoch: 3, TargetEpoch: 6, DataRoot: [32]byte{'A'}}
+	if err := db.SaveAttestation(pubKey, previous); err != nil {
+		t.Fatalf("Could not save attestation: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		record    *AttestationRecord
+		slashable bool
+	}{
+		{
+			name:      "same attestation",
+			record:    &AttestationRecord{SourceEpoch: 3, TargetEpoch: 6, DataRoot: [32]byte{'A'}},
+			slashable: false,
+		},
+		{
+			name:      "double vote",
+			record:    &AttestationRecord{SourceEpoch: 3, TargetEpoch: 6, DataRoot: [32]byte{'B'}},
+			slashable: true,
+		},
+		{
+			name:      "surrounds previous vote",
+			record:    &AttestationRecord{SourceEpoch: 2, TargetEpoch: 7, DataRoot: [32]byte{'B'}},
+			slashable: true,
+		},
+		{
+			name:      "surrounded by previous vote",
+			record:    &AttestationRecord{SourceEpoch: 4, TargetEpoch: 5, DataRoot: [32]byte{'B'}},
+			slashable: true,
+		},
+		{
+			name:      "next epoch",
+			record:    &AttestationRecord{SourceEpoch: 6, TargetEpoch: 7, DataRoot: [32]byte{'B'}},
+			slashable: false,
+		},
+		{
+			name:      "same source later target",
+			record:    &AttestationRecord{SourceEpoch: 3, TargetEpoch: 8, DataRoot: [32]byte{'B'}},
+			slashable: false,
+		},
+	}
+	for _, tt := range tests {
+		slashable, err := db.SlashableAttestation(pubKey, tt.record)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if slashable != tt.slashable {
+			t.Errorf("%s: expected slashable=%v, received %v", tt.name, tt.slashable, slashable)
+		}
+	}
+}
+
+func TestSaveAttestation_RefusesSurroundVote(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte("validator-1")
+
+	if err := db.SaveAttestation(pubKey, &AttestationRecord{SourceEpoch: 3, TargetEpoch: 6}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttestation(pubKey, &AttestationRecord{SourceEpoch: 1, TargetEpoch: 8}); err == nil {
+		t.Error("Expected saving a surround vote to fail")
+	}
+	history, err := db.AttestationHistory(pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 {
+		t.Errorf("Expected 1 attestation in history, received %d", len(history))
+	}
+}
@@ -0,0 +1,137 @@
+package db
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// ProtectionHistory is the portable representation of the slashing protection
+// history of every key in the database. It is used to move keys between
+// machines without losing track of what the keys have already signed.
+type ProtectionHistory struct {
+	Validators []*ValidatorHistory `json:"validators"`
+}
+
+// ValidatorHistory is the signing history of a single validator public key.
+type ValidatorHistory struct {
+	PublicKey    string             `json:"pubkey"`
+	Proposals    []*ProposalJSON    `json:"proposals"`
+	Attestations []*AttestationJSON `json:"attestations"`
+}
+
+// ProposalJSON is the interchange representation of a ProposalRecord.
+type ProposalJSON struct {
+	Slot      uint64 `json:"slot"`
+	BlockRoot string `json:"block_root"`
+}
+
+// AttestationJSON is the interchange representation of an AttestationRecord.
+type AttestationJSON struct {
+	SourceEpoch uint64 `json:"source_epoch"`
+	TargetEpoch uint64 `json:"target_epoch"`
+	DataRoot    string `json:"data_root"`
+}
+
+// ExportHistory writes the slashing protection history of every key in the
+// database to w as JSON.
+func (db *ValidatorDB) ExportHistory(w io.Writer) error {
+	history := &ProtectionHistory{Validators: make([]*ValidatorHistory, 0)}
+	byKey := make(map[string]*ValidatorHistory)
+	historyForKey := func(pubKey []byte) *ValidatorHistory {
+		key := fmt.Sprintf("%#x", pubKey)
+		if _, ok := byKey[key]; !ok {
+			byKey[key] = &ValidatorHistory{
+				PublicKey:    key,
+				Proposals:    make([]*ProposalJSON, 0),
+				Attestations: make([]*AttestationJSON, 0),
+			}
+			history.Validators = append(history.Validators, byKey[key])
+		}
+		return byKey[key]
+	}
+	if err := db.view(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(proposalHistoryBucket).ForEach(func(pubKey, _ []byte) error {
+			v := historyForKey(pubKey)
+			return tx.Bucket(proposalHistoryBucket).Bucket(pubKey).ForEach(func(k, enc []byte) error {
+				v.Proposals = append(v.Proposals, &ProposalJSON{
+					Slot:      decodeUint64(k),
+					BlockRoot: fmt.Sprintf("%#x", enc),
+				})
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+		return tx.Bucket(attestationHistoryBucket).ForEach(func(pubKey, _ []byte) error {
+			v := historyForKey(pubKey)
+			return tx.Bucket(attestationHistoryBucket).Bucket(pubKey).ForEach(func(k, enc []byte) error {
+				record := decodeAttestationRecord(k, enc)
+				v.Attestations = append(v.Attestations, &AttestationJSON{
+					SourceEpoch: record.SourceEpoch,
+					TargetEpoch: record.TargetEpoch,
+					DataRoot:    fmt.Sprintf("%#x", record.DataRoot),
+				})
+				return nil
+			})
+		})
+	}); err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(history)
+}
+
+// ImportHistory reads a slashing protection history produced by ExportHistory
+// from r and merges it into the database. The import is atomic: if any record
+// conflicts with the existing history of a key, nothing is imported.
+func (db *ValidatorDB) ImportHistory(r io.Reader) error {
+	history := &ProtectionHistory{}
+	if err := json.NewDecoder(r).Decode(history); err != nil {
+		return fmt.Errorf("could not decode slashing protection history: %v", err)
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		for _, v := range history.Validators {
+			pubKey, err := decodeHex(v.PublicKey)
+			if err != nil {
+				return fmt.Errorf("invalid public key %s: %v", v.PublicKey, err)
+			}
+			for _, p := range v.Proposals {
+				root, err := decodeHex(p.BlockRoot)
+				if err != nil {
+					return fmt.Errorf("invalid block root %s: %v", p.BlockRoot, err)
+				}
+				record := &ProposalRecord{Slot: p.Slot, BlockRoot: bytesutil.ToBytes32(root)}
+				if err := saveProposal(tx, pubKey, record); err != nil {
+					return fmt.Errorf("could not import proposal for %s: %v", v.PublicKey, err)
+				}
+			}
+			for _, a := range v.Attestations {
+				root, err := decodeHex(a.DataRoot)
+				if err != nil {
+					return fmt.Errorf("invalid attestation data root %s: %v", a.DataRoot, err)
+				}
+				record := &AttestationRecord{
+					SourceEpoch: a.SourceEpoch,
+					TargetEpoch: a.TargetEpoch,
+					DataRoot:    bytesutil.ToBytes32(root),
+				}
+				if err := saveAttestation(tx, pubKey, record); err != nil {
+					return fmt.Errorf("could not import attestation for %s: %v", v.PublicKey, err)
+				}
+			}
+		}
+		log.WithField("validators", len(history.Validators)).Info("Imported slashing protection history")
+		return nil
+	})
+}
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
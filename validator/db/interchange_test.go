@@ -0,0 +1,76 @@
+package db
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestExportImportHistory_RoundTrip(t *testing.T) {
+	source := setupDB(t)
+	defer teardownDB(t, source)
+	pubKey := []byte{1, 2, 3}
+
+	proposal := &ProposalRecord{Slot: 5, BlockRoot: [32]byte{'A'}}
+	attestation := &AttestationRecord{SourceEpoch: 1, TargetEpoch: 2, DataRoot: [32]byte{'B'}}
+	if err := source.SaveProposal(pubKey, proposal); err != nil {
+		t.Fatal(err)
+	}
+	if err := source.SaveAttestation(pubKey, attestation); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := source.ExportHistory(buf); err != nil {
+		t.Fatalf("Could not export history: %v", err)
+	}
+
+	target := setupDB(t)
+	defer teardownDB(t, target)
+	if err := target.ImportHistory(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("Could not import history: %v", err)
+	}
+
+	proposals, err := target.ProposalHistory(pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proposals) != 1 || !reflect.DeepEqual(proposals[0], proposal) {
+		t.Errorf("Wanted proposals %v, received %v", []*ProposalRecord{proposal}, proposals)
+	}
+	attestations, err := target.AttestationHistory(pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attestations) != 1 || !reflect.DeepEqual(attestations[0], attestation) {
+		t.Errorf("Wanted attestations %v, received %v", []*AttestationRecord{attestation}, attestations)
+	}
+
+	// Importing the same history twice is a no-op.
+	if err := target.ImportHistory(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Errorf("Could not re-import history: %v", err)
+	}
+}
+
+func TestImportHistory_ConflictIsAtomic(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte{1, 2, 3}
+	if err := db.SaveProposal(pubKey, &ProposalRecord{Slot: 5, BlockRoot: [32]byte{'A'}}); err != nil {
+		t.Fatal(err)
+	}
+
+	conflicting := `{"validators": [{"pubkey": "0x010203",
+		"proposals": [{"slot": 6, "block_root": "0x00"}, {"slot": 5, "block_root": "0x01"}],
+		"attestations": []}]}`
+	if err := db.ImportHistory(bytes.NewBufferString(conflicting)); err == nil {
+		t.Fatal("Expected conflicting import to fail")
+	}
+	proposals, err := db.ProposalHistory(pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proposals) != 1 {
+		t.Errorf("Expected failed import to leave history untouched, received %d proposals", len(proposals))
+	}
+}
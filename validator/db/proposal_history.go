@@ -0,0 +1,73 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+)
+
+// ProposalRecord describes a block proposal signed by a validator.
+type ProposalRecord struct {
+	Slot      uint64
+	BlockRoot [32]byte
+}
+
+// SlashableProposal checks if signing a block with the given root at the
+// given slot would conflict with a proposal already signed by the public key.
+// Re-signing the exact same block is allowed, any other block at an already
+// proposed slot is a double proposal and is refused.
+func (db *ValidatorDB) SlashableProposal(pubKey []byte, slot uint64, blockRoot [32]byte) (bool, error) {
+	slashable := false
+	err := db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(proposalHistoryBucket).Bucket(pubKey)
+		if bkt == nil {
+			return nil
+		}
+		enc := bkt.Get(encodeUint64(slot))
+		if enc == nil {
+			return nil
+		}
+		slashable = !bytes.Equal(enc, blockRoot[:])
+		return nil
+	})
+	return slashable, err
+}
+
+// SaveProposal records a signed block proposal for the public key. It fails
+// if a different block was already recorded for the same slot.
+func (db *ValidatorDB) SaveProposal(pubKey []byte, record *ProposalRecord) error {
+	return db.update(func(tx *bolt.Tx) error {
+		return saveProposal(tx, pubKey, record)
+	})
+}
+
+// ProposalHistory returns every proposal signed by the public key ordered by slot.
+func (db *ValidatorDB) ProposalHistory(pubKey []byte) ([]*ProposalRecord, error) {
+	var records []*ProposalRecord
+	err := db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(proposalHistoryBucket).Bucket(pubKey)
+		if bkt == nil {
+			return nil
+		}
+		return bkt.ForEach(func(k, v []byte) error {
+			record := &ProposalRecord{Slot: decodeUint64(k)}
+			copy(record.BlockRoot[:], v)
+			records = append(records, record)
+			return nil
+		})
+	})
+	return records, err
+}
+
+func saveProposal(tx *bolt.Tx, pubKey []byte, record *ProposalRecord) error {
+	bkt, err := tx.Bucket(proposalHistoryBucket).CreateBucketIfNotExists(pubKey)
+	if err != nil {
+		return err
+	}
+	key := encodeUint64(record.Slot)
+	if enc := bkt.Get(key); enc != nil && !bytes.Equal(enc, record.BlockRoot[:]) {
+		return fmt.Errorf("conflicting proposal already recorded for slot %d", record.Slot)
+	}
+	return bkt.Put(key, record.BlockRoot[:])
+}
@@ -0,0 +1,80 @@
+package db
+
+import (
+	"testing"
+)
+
+func TestSlashableProposal_DoubleProposal(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte("validator-1")
+
+	record := &ProposalRecord{Slot: 10, BlockRoot: [32]byte{'A'}}
+	if err := db.SaveProposal(pubKey, record); err != nil {
+		t.Fatalf("Could not save proposal: %v", err)
+	}
+
+	tests := []struct {
+		slot      uint64
+		root      [32]byte
+		slashable bool
+	}{
+		{slot: 10, root: [32]byte{'A'}, slashable: false},
+		{slot: 10, root: [32]byte{'B'}, slashable: true},
+		{slot: 11, root: [32]byte{'B'}, slashable: false},
+	}
+	for _, tt := range tests {
+		slashable, err := db.SlashableProposal(pubKey, tt.slot, tt.root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if slashable != tt.slashable {
+			t.Errorf("Expected slashable=%v for slot %d, received %v", tt.slashable, tt.slot, slashable)
+		}
+	}
+
+	if err := db.SaveProposal(pubKey, &ProposalRecord{Slot: 10, BlockRoot: [32]byte{'B'}}); err == nil {
+		t.Error("Expected saving a double proposal to fail")
+	}
+}
+
+func TestSlashableProposal_IsolatedPerKey(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.SaveProposal([]byte("validator-1"), &ProposalRecord{Slot: 10, BlockRoot: [32]byte{'A'}}); err != nil {
+		t.Fatalf("Could not save proposal: %v", err)
+	}
+	slashable, err := db.SlashableProposal([]byte("validator-2"), 10, [32]byte{'B'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slashable {
+		t.Error("Expected proposal of a different key not to be slashable")
+	}
+}
+
+func TestProposalHistory_OrderedBySlot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte("validator-1")
+
+	for _, slot := range []uint64{300, 2, 1 << 40} {
+		if err := db.SaveProposal(pubKey, &ProposalRecord{Slot: slot}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	history, err := db.ProposalHistory(pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []uint64{2, 300, 1 << 40}
+	if len(history) != len(want) {
+		t.Fatalf("Expected %d proposals, received %d", len(want), len(history))
+	}
+	for i, record := range history {
+		if record.Slot != want[i] {
+			t.Errorf("Expected slot %d at index %d, received %d", want[i], i, record.Slot)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package db
+
+import (
+	"encoding/binary"
+)
+
+// The schema stores the signing history of each validator public key in its
+// own nested bucket. Proposals are keyed by slot and hold the root of the signed
+// block, attestations are keyed by target epoch and hold the source epoch
+// followed by the root of the signed attestation data.
+//
+// proposal-history-bucket    -> pubkey -> slot         -> block root
+// attestation-history-bucket -> pubkey -> target epoch -> source epoch + data root
+var (
+	proposalHistoryBucket    = []byte("proposal-history-bucket")
+	attestationHistoryBucket = []byte("attestation-history-bucket")
+)
+
+// encodeUint64 encodes a slot or epoch as a big-endian uint64 so bolt
+// iterates over the history of a key in ascending order.
+func encodeUint64(number uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, number)
+	return buf
+}
+
+// decodeUint64 returns a slot or epoch which has been encoded as a
+// big-endian uint64 in the byte array.
+func decodeUint64(bytearray []byte) uint64 {
+	return binary.BigEndian.Uint64(bytearray)
+}
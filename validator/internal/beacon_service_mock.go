@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: BeaconServiceClient,BeaconService_LatestAttestationClient,BeaconService_WaitForChainStartClient)
+// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: BeaconServiceClient,BeaconService_ChainEventsClient,BeaconService_LatestAttestationClient,BeaconService_WaitForChainStartClient)
 
 // Package internal is a generated GoMock package.
 package internal
@@ -59,6 +59,26 @@ func (mr *MockBeaconServiceClientMockRecorder) CanonicalHead(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceClient)(nil).CanonicalHead), varargs...)
 }
 
+// ChainEvents mocks base method
+func (m *MockBeaconServiceClient) ChainEvents(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v10.BeaconService_ChainEventsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChainEvents", varargs...)
+	ret0, _ := ret[0].(v10.BeaconService_ChainEventsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainEvents indicates an expected call of ChainEvents
+func (mr *MockBeaconServiceClientMockRecorder) ChainEvents(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainEvents", reflect.TypeOf((*MockBeaconServiceClient)(nil).ChainEvents), varargs...)
+}
+
 // Eth1Data mocks base method
 func (m *MockBeaconServiceClient) Eth1Data(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.Eth1DataResponse, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +99,26 @@ func (mr *MockBeaconServiceClientMockRecorder) Eth1Data(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1Data", reflect.TypeOf((*MockBeaconServiceClient)(nil).Eth1Data), varargs...)
 }
 
+// FinalizedCheckpoint mocks base method
+func (m *MockBeaconServiceClient) FinalizedCheckpoint(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.CheckpointResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FinalizedCheckpoint", varargs...)
+	ret0, _ := ret[0].(*v10.CheckpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinalizedCheckpoint indicates an expected call of FinalizedCheckpoint
+func (mr *MockBeaconServiceClientMockRecorder) FinalizedCheckpoint(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalizedCheckpoint", reflect.TypeOf((*MockBeaconServiceClient)(nil).FinalizedCheckpoint), varargs...)
+}
+
 // ForkData mocks base method
 func (m *MockBeaconServiceClient) ForkData(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v1.Fork, error) {
 	m.ctrl.T.Helper()
@@ -179,6 +219,129 @@ func (mr *MockBeaconServiceClientMockRecorder) WaitForChainStart(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForChainStart", reflect.TypeOf((*MockBeaconServiceClient)(nil).WaitForChainStart), varargs...)
 }
 
+// MockBeaconService_ChainEventsClient is a mock of BeaconService_ChainEventsClient interface
+type MockBeaconService_ChainEventsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconService_ChainEventsClientMockRecorder
+}
+
+// MockBeaconService_ChainEventsClientMockRecorder is the mock recorder for MockBeaconService_ChainEventsClient
+type MockBeaconService_ChainEventsClientMockRecorder struct {
+	mock *MockBeaconService_ChainEventsClient
+}
+
+// NewMockBeaconService_ChainEventsClient creates a new mock instance
+func NewMockBeaconService_ChainEventsClient(ctrl *gomock.Controller) *MockBeaconService_ChainEventsClient {
+	mock := &MockBeaconService_ChainEventsClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_ChainEventsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconService_ChainEventsClient) EXPECT() *MockBeaconService_ChainEventsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method
+func (m *MockBeaconService_ChainEventsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockBeaconService_ChainEventsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconService_ChainEventsClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockBeaconService_ChainEventsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockBeaconService_ChainEventsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_ChainEventsClient)(nil).Context))
+}
+
+// Header mocks base method
+func (m *MockBeaconService_ChainEventsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockBeaconService_ChainEventsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconService_ChainEventsClient)(nil).Header))
+}
+
+// Recv mocks base method
+func (m *MockBeaconService_ChainEventsClient) Recv() (*v10.ChainEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*v10.ChainEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockBeaconService_ChainEventsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconService_ChainEventsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method
+func (m *MockBeaconService_ChainEventsClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconService_ChainEventsClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_ChainEventsClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockBeaconService_ChainEventsClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconService_ChainEventsClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_ChainEventsClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method
+func (m *MockBeaconService_ChainEventsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockBeaconService_ChainEventsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconService_ChainEventsClient)(nil).Trailer))
+}
+
 // MockBeaconService_LatestAttestationClient is a mock of BeaconService_LatestAttestationClient interface
 type MockBeaconService_LatestAttestationClient struct {
 	ctrl     *gomock.Controller
@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	types "github.com/gogo/protobuf/types"
 	gomock "github.com/golang/mock/gomock"
 	v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	v10 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -77,6 +78,26 @@ func (mr *MockProposerServiceClientMockRecorder) PendingAttestations(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingAttestations", reflect.TypeOf((*MockProposerServiceClient)(nil).PendingAttestations), varargs...)
 }
 
+// PendingOperations mocks base method
+func (m *MockProposerServiceClient) PendingOperations(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.PendingOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PendingOperations", varargs...)
+	ret0, _ := ret[0].(*v10.PendingOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingOperations indicates an expected call of PendingOperations
+func (mr *MockProposerServiceClientMockRecorder) PendingOperations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingOperations", reflect.TypeOf((*MockProposerServiceClient)(nil).PendingOperations), varargs...)
+}
+
 // ProposeBlock mocks base method
 func (m *MockProposerServiceClient) ProposeBlock(arg0 context.Context, arg1 *v1.BeaconBlock, arg2 ...grpc.CallOption) (*v10.ProposeResponse, error) {
 	m.ctrl.T.Helper()
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"path"
 	"runtime"
 	"strings"
 	"syscall"
@@ -13,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/node"
 	"github.com/prysmaticlabs/prysm/validator/types"
 	"github.com/sirupsen/logrus"
@@ -29,7 +31,11 @@ func startNode(ctx *cli.Context) error {
 	if err != nil {
 		logrus.Fatal(err)
 	}
-	if !exists {
+	// Interop validators derive their keys from their index, so they need
+	// neither a keystore account nor its password.
+	if ctx.GlobalUint64(types.InteropNumValidatorsFlag.Name) > 0 {
+		logrus.Info("Using interop validator keys")
+	} else if !exists {
 		// If an account does not exist, we create a new one and start the node.
 		keystoreDirectory, keystorePassword, err = createValidatorAccount(ctx)
 		if err != nil {
@@ -97,6 +103,36 @@ func createValidatorAccount(ctx *cli.Context) (string, string, error) {
 	return keystoreDirectory, keystorePassword, nil
 }
 
+func exportSlashingProtection(ctx *cli.Context) error {
+	validatorDB, err := db.NewDB(path.Join(ctx.String(cmd.DataDirFlag.Name), node.ValidatorDBName))
+	if err != nil {
+		return fmt.Errorf("could not open slashing protection database: %v", err)
+	}
+	defer validatorDB.Close()
+
+	f, err := os.Create(ctx.String(types.SlashingProtectionFileFlag.Name))
+	if err != nil {
+		return fmt.Errorf("could not create slashing protection file: %v", err)
+	}
+	defer f.Close()
+	return validatorDB.ExportHistory(f)
+}
+
+func importSlashingProtection(ctx *cli.Context) error {
+	validatorDB, err := db.NewDB(path.Join(ctx.String(cmd.DataDirFlag.Name), node.ValidatorDBName))
+	if err != nil {
+		return fmt.Errorf("could not open slashing protection database: %v", err)
+	}
+	defer validatorDB.Close()
+
+	f, err := os.Open(ctx.String(types.SlashingProtectionFileFlag.Name))
+	if err != nil {
+		return fmt.Errorf("could not open slashing protection file: %v", err)
+	}
+	defer f.Close()
+	return validatorDB.ImportHistory(f)
+}
+
 func main() {
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -132,15 +168,52 @@ contract in order to activate the validator client`,
 				},
 			},
 		},
+		{
+			Name:     "slashing-protection",
+			Category: "slashing-protection",
+			Usage:    "defines commands for moving the slashing protection history of validator keys between machines",
+			Subcommands: cli.Commands{
+				cli.Command{
+					Name:        "export",
+					Description: `exports the history of every block and attestation signed by the validator client to a file`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						types.SlashingProtectionFileFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						if err := exportSlashingProtection(ctx); err != nil {
+							logrus.Fatalf("Could not export slashing protection history: %v", err)
+						}
+					},
+				},
+				cli.Command{
+					Name: "import",
+					Description: `imports a slashing protection history exported by another validator client,
+refusing to import it if it conflicts with the local history`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						types.SlashingProtectionFileFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						if err := importSlashingProtection(ctx); err != nil {
+							logrus.Fatalf("Could not import slashing protection history: %v", err)
+						}
+					},
+				},
+			},
+		},
 	}
 	app.Flags = []cli.Flag{
 		types.NoCustomConfigFlag,
 		types.BeaconRPCProviderFlag,
 		types.KeystorePathFlag,
 		types.PasswordFlag,
+		types.InteropStartIndexFlag,
+		types.InteropNumValidatorsFlag,
 		types.DisablePenaltyRewardLogFlag,
 		cmd.VerbosityFlag,
 		cmd.DataDirFlag,
+		cmd.ChainConfigFileFlag,
 		cmd.EnableTracingFlag,
 		cmd.TracingEndpointFlag,
 		cmd.TraceSampleFractionFlag,
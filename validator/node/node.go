@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path"
 	"sync"
 	"syscall"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/types"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -26,6 +28,10 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
+// ValidatorDBName is the name of the slashing protection database directory
+// within the data directory of the validator client.
+const ValidatorDBName = "validatordata"
+
 // ValidatorClient defines an instance of a sharding validator that manages
 // the entire lifecycle of services attached to it participating in
 // Ethereum Serenity.
@@ -33,6 +39,7 @@ type ValidatorClient struct {
 	ctx      *cli.Context
 	services *shared.ServiceRegistry // Lifecycle and service store.
 	lock     sync.RWMutex
+	db       *db.ValidatorDB
 	stop     chan struct{} // Channel to wait for termination notifications.
 }
 
@@ -53,14 +60,24 @@ func NewValidatorClient(ctx *cli.Context, password string) (*ValidatorClient, er
 		stop:     make(chan struct{}),
 	}
 
-	// Use custom config values if the --no-custom-config flag is set.
-	if !ctx.GlobalBool(types.NoCustomConfigFlag.Name) {
+	// Use the chain config file if given, otherwise use custom config values
+	// unless the --no-custom-config flag is set.
+	if configFile := ctx.GlobalString(cmd.ChainConfigFileFlag.Name); configFile != "" {
+		if err := params.LoadChainConfigFile(configFile); err != nil {
+			return nil, fmt.Errorf("could not load chain config file: %v", err)
+		}
+		log.WithField("path", configFile).Info("Using chain config file")
+	} else if !ctx.GlobalBool(types.NoCustomConfigFlag.Name) {
 		log.Info("Using custom parameter configuration")
 		params.UseDemoBeaconConfig()
 	}
 
 	featureconfig.ConfigureBeaconFeatures(ctx)
 
+	if err := ValidatorClient.startDB(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := ValidatorClient.registerPrometheusService(ctx); err != nil {
 		return nil, err
 	}
@@ -113,10 +130,25 @@ func (s *ValidatorClient) Close() {
 
 	s.services.StopAll()
 	log.Info("Stopping sharding validator")
+	if err := s.db.Close(); err != nil {
+		log.Errorf("Failed to close database: %v", err)
+	}
 
 	close(s.stop)
 }
 
+func (s *ValidatorClient) startDB(ctx *cli.Context) error {
+	dbPath := path.Join(ctx.GlobalString(cmd.DataDirFlag.Name), ValidatorDBName)
+	validatorDB, err := db.NewDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open slashing protection database: %v", err)
+	}
+
+	log.WithField("path", dbPath).Info("Checking slashing protection db")
+	s.db = validatorDB
+	return nil
+}
+
 func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 	service := prometheus.NewPrometheusService(
 		fmt.Sprintf(":%d", ctx.GlobalInt64(cmd.MonitoringPortFlag.Name)),
@@ -134,7 +166,10 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context, password strin
 		Endpoint:             endpoint,
 		KeystorePath:         keystoreDirectory,
 		Password:             password,
+		InteropStartIndex:    ctx.GlobalUint64(types.InteropStartIndexFlag.Name),
+		InteropNumValidators: ctx.GlobalUint64(types.InteropNumValidatorsFlag.Name),
 		LogValidatorBalances: logValidatorBalances,
+		ValidatorDB:          s.db,
 	})
 	if err != nil {
 		return fmt.Errorf("could not initialize client service: %v", err)
@@ -38,6 +38,21 @@ var (
 		Name:  "password",
 		Usage: "string value of the password for your validator private keys",
 	}
+	// SlashingProtectionFileFlag defines the file used to export or import the slashing protection history.
+	SlashingProtectionFileFlag = cli.StringFlag{
+		Name:  "file",
+		Usage: "path to the slashing protection history file to export to or import from",
+	}
+	// InteropStartIndexFlag defines the index of the first interop validator whose key the client derives.
+	InteropStartIndexFlag = cli.Uint64Flag{
+		Name:  "interop-start-index",
+		Usage: "The index of the first interop validator to run, used with --interop-num-validators",
+	}
+	// InteropNumValidatorsFlag defines the number of interop validators the client runs instead of the keystore keys.
+	InteropNumValidatorsFlag = cli.Uint64Flag{
+		Name:  "interop-num-validators",
+		Usage: "Run this many interop validators, whose keys are derived from their index, instead of the keystore validators",
+	}
 	// DisablePenaltyRewardLogFlag defines the ability to not log reward/penalty information during deployment
 	DisablePenaltyRewardLogFlag = cli.BoolFlag{
 		Name:  "disable-rewards-penalties-logging",
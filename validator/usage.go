@@ -46,6 +46,7 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			cmd.VerbosityFlag,
 			cmd.DataDirFlag,
+			cmd.ChainConfigFileFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingEndpointFlag,
 			cmd.TraceSampleFractionFlag,
@@ -71,6 +72,8 @@ var appHelpFlagGroups = []flagGroup{
 			types.BeaconRPCProviderFlag,
 			types.KeystorePathFlag,
 			types.PasswordFlag,
+			types.InteropStartIndexFlag,
+			types.InteropNumValidatorsFlag,
 			types.DisablePenaltyRewardLogFlag,
 		},
 	},